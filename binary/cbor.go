@@ -406,24 +406,23 @@ func decode(decodedCbor interface{}) (Term, error) {
 					}
 					f = NewRemote(u)
 				case 2, 3, 4, 5:
-					var file string
-					if importLabel == 2 {
-						file = "/"
-					} else if importLabel == 3 {
-						file = "."
+					kind := LocalAbsolute
+					if importLabel == 3 {
+						kind = LocalHere
 					} else if importLabel == 4 {
-						file = ".."
-					} else {
-						file = "~"
+						kind = LocalParent
+					} else if importLabel == 5 {
+						kind = LocalHome
 					}
+					var components []string
 					for i := 4; i < len(val); i++ {
 						component, err := unwrapString(val[i])
 						if err != nil {
 							return nil, err
 						}
-						file = path.Join(file, component)
+						components = append(components, component)
 					}
-					f = Local(file)
+					f = NewLocal(kind, components...)
 				case 6:
 					name, err := unwrapString(val[4])
 					if err != nil {
@@ -0,0 +1,37 @@
+package binary_test
+
+import (
+	"bytes"
+
+	. "github.com/philandstuff/dhall-golang/binary"
+	. "github.com/philandstuff/dhall-golang/core"
+
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+func localImport(path string) Import {
+	return Import{ImportHashed: ImportHashed{Fetchable: Local(path)}}
+}
+
+var _ = DescribeTable("CBOR round-trip of local imports",
+	func(term Term) {
+		var buf bytes.Buffer
+		Expect(EncodeAsCbor(&buf, term)).To(Succeed())
+		actual, err := DecodeAsCbor(&buf)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(Equal(term))
+
+		buf.Reset()
+		Expect(EncodeAsJSON(&buf, term)).To(Succeed())
+		actual, err = DecodeAsJSON(&buf)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(Equal(term))
+	},
+	Entry("./foo/bar", localImport("foo/bar")),
+	Entry("./~/foo", localImport("./~/foo")),
+	Entry("./..foo", localImport("..foo")),
+	Entry("../foo", localImport("../foo")),
+	Entry("~/foo", localImport("~/foo")),
+	Entry("/foo", localImport("/foo")),
+)
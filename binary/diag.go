@@ -0,0 +1,182 @@
+package binary
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// CborDiag renders the CBOR in cbor as CBOR diagnostic notation, as
+// defined in RFC 7049 section 6.  It only understands the subset of
+// CBOR used by Dhall's binary encoding: definite-length items, tags,
+// simple values and floats.
+func CborDiag(cbor []byte) (string, error) {
+	var out strings.Builder
+	rest, err := writeDiag(&out, cbor)
+	if err != nil {
+		return "", err
+	}
+	if len(rest) != 0 {
+		return "", fmt.Errorf("CBOR diag error: %d trailing bytes", len(rest))
+	}
+	return out.String(), nil
+}
+
+var errDiagTruncated = errors.New("CBOR diag error: unexpected end of input")
+
+// readArgument reads the argument of the item whose initial byte has
+// additional information info, returning the remaining input.
+func readArgument(info byte, b []byte) (uint64, []byte, error) {
+	switch {
+	case info < 24:
+		return uint64(info), b, nil
+	case info == 24:
+		if len(b) < 1 {
+			return 0, nil, errDiagTruncated
+		}
+		return uint64(b[0]), b[1:], nil
+	case info == 25:
+		if len(b) < 2 {
+			return 0, nil, errDiagTruncated
+		}
+		return uint64(binary.BigEndian.Uint16(b)), b[2:], nil
+	case info == 26:
+		if len(b) < 4 {
+			return 0, nil, errDiagTruncated
+		}
+		return uint64(binary.BigEndian.Uint32(b)), b[4:], nil
+	case info == 27:
+		if len(b) < 8 {
+			return 0, nil, errDiagTruncated
+		}
+		return binary.BigEndian.Uint64(b), b[8:], nil
+	default:
+		return 0, nil, fmt.Errorf("CBOR diag error: unsupported additional information %d", info)
+	}
+}
+
+func writeDiag(out *strings.Builder, b []byte) ([]byte, error) {
+	if len(b) == 0 {
+		return nil, errDiagTruncated
+	}
+	major, info := b[0]>>5, b[0]&0x1f
+	arg, rest, err := readArgument(info, b[1:])
+	if err != nil {
+		return nil, err
+	}
+	switch major {
+	case 0: // unsigned integer
+		out.WriteString(strconv.FormatUint(arg, 10))
+	case 1: // negative integer
+		if arg == math.MaxUint64 {
+			out.WriteString("-18446744073709551616")
+		} else {
+			out.WriteString("-" + strconv.FormatUint(arg+1, 10))
+		}
+	case 2, 3: // byte string, text string
+		if uint64(len(rest)) < arg {
+			return nil, errDiagTruncated
+		}
+		content := rest[:arg]
+		rest = rest[arg:]
+		if major == 2 {
+			out.WriteString("h'" + hex.EncodeToString(content) + "'")
+		} else {
+			out.WriteString(strconv.Quote(string(content)))
+		}
+	case 4: // array
+		out.WriteRune('[')
+		for i := uint64(0); i < arg; i++ {
+			if i > 0 {
+				out.WriteString(", ")
+			}
+			if rest, err = writeDiag(out, rest); err != nil {
+				return nil, err
+			}
+		}
+		out.WriteRune(']')
+	case 5: // map
+		out.WriteRune('{')
+		for i := uint64(0); i < arg; i++ {
+			if i > 0 {
+				out.WriteString(", ")
+			}
+			if rest, err = writeDiag(out, rest); err != nil {
+				return nil, err
+			}
+			out.WriteString(": ")
+			if rest, err = writeDiag(out, rest); err != nil {
+				return nil, err
+			}
+		}
+		out.WriteRune('}')
+	case 6: // tag
+		out.WriteString(strconv.FormatUint(arg, 10) + "(")
+		if rest, err = writeDiag(out, rest); err != nil {
+			return nil, err
+		}
+		out.WriteRune(')')
+	case 7: // simple values and floats
+		switch info {
+		case 20:
+			out.WriteString("false")
+		case 21:
+			out.WriteString("true")
+		case 22:
+			out.WriteString("null")
+		case 23:
+			out.WriteString("undefined")
+		case 25:
+			out.WriteString(diagFloat(halfToFloat64(uint16(arg))))
+		case 26:
+			out.WriteString(diagFloat(float64(math.Float32frombits(uint32(arg)))))
+		case 27:
+			out.WriteString(diagFloat(math.Float64frombits(arg)))
+		default:
+			out.WriteString(fmt.Sprintf("simple(%d)", arg))
+		}
+	}
+	return rest, nil
+}
+
+func diagFloat(f float64) string {
+	switch {
+	case math.IsNaN(f):
+		return "NaN"
+	case math.IsInf(f, 1):
+		return "Infinity"
+	case math.IsInf(f, -1):
+		return "-Infinity"
+	}
+	s := strconv.FormatFloat(f, 'g', -1, 64)
+	if !strings.ContainsAny(s, ".e") {
+		s += ".0"
+	}
+	return s
+}
+
+// halfToFloat64 converts an IEEE 754 half-precision float to a
+// float64.
+func halfToFloat64(h uint16) float64 {
+	sign := 1.0
+	if h&0x8000 != 0 {
+		sign = -1.0
+	}
+	exp := int(h>>10) & 0x1f
+	mant := float64(h & 0x3ff)
+	switch exp {
+	case 0:
+		return sign * math.Ldexp(mant, -24)
+	case 0x1f:
+		if mant != 0 {
+			return math.NaN()
+		}
+		return math.Inf(int(sign))
+	default:
+		return sign * math.Ldexp(mant+1024, exp-25)
+	}
+}
@@ -0,0 +1,45 @@
+package binary_test
+
+import (
+	"bytes"
+	"math"
+
+	. "github.com/philandstuff/dhall-golang/binary"
+	. "github.com/philandstuff/dhall-golang/core"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = DescribeTable("CborDiag",
+	func(term Term, expected string) {
+		var buf bytes.Buffer
+		Expect(EncodeAsCbor(&buf, term)).To(Succeed())
+		actual, err := CborDiag(buf.Bytes())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(Equal(expected))
+	},
+	Entry("Natural", Natural, `"Natural"`),
+	Entry("3", NaturalLit(3), `[15, 3]`),
+	Entry("-3", IntegerLit(-3), `[16, -3]`),
+	Entry("True", True, `true`),
+	Entry("1.5", DoubleLit(1.5), `1.5`),
+	Entry("0.0", DoubleLit(0), `0.0`),
+	Entry("-0.0", DoubleLit(math.Copysign(0, -1)), `-0.0`),
+	Entry("Infinity", DoubleLit(math.Inf(1)), `Infinity`),
+	Entry("λ(x : Natural) → x",
+		NewLambda("x", Natural, NewVar("x")),
+		`[1, "x", "Natural", ["x", 0]]`),
+	Entry("{ a = 1, b = \"foo\" }",
+		RecordLit{"a": NaturalLit(1), "b": TextLitTerm{Suffix: "foo"}},
+		`[8, {"a": [15, 1], "b": [18, "foo"]}]`),
+	Entry("Some (x@300)",
+		Some{Var{Name: "x", Index: 300}},
+		`[5, null, ["x", 300]]`),
+)
+
+var _ = It("CborDiag rejects truncated input", func() {
+	_, err := CborDiag([]byte{0x82, 0x0f})
+	Expect(err).To(HaveOccurred())
+})
@@ -1,4 +1,5 @@
 /*
-Package binary defines the CBOR representation of Dhall terms.
+Package binary defines the CBOR representation of Dhall terms, and a
+JSON representation of their syntax trees for tooling.
 */
 package binary
@@ -0,0 +1,609 @@
+package binary
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/url"
+	"strconv"
+
+	. "github.com/philandstuff/dhall-golang/core"
+)
+
+// A JSONTerm wraps a Term so that its abstract syntax tree can be
+// marshalled to and from JSON with encoding/json.  This is unrelated
+// to dhall-to-json: it records the structure of the expression, not
+// its value, so that tools can inspect parsed Dhall without
+// understanding CBOR.
+//
+// Each node is a JSON object with a "kind" naming the Go type of the
+// node (such as "Lambda", "RecordLit" or "NaturalLit") and one member
+// per child or attribute.
+type JSONTerm struct{ Term Term }
+
+var _ json.Marshaler = JSONTerm{}
+var _ json.Unmarshaler = &JSONTerm{}
+
+// MarshalJSON implements json.Marshaler.
+func (j JSONTerm) MarshalJSON() ([]byte, error) {
+	node, err := jsonNode(j.Term)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(node)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (j *JSONTerm) UnmarshalJSON(b []byte) error {
+	var raw interface{}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	if err := dec.Decode(&raw); err != nil {
+		return err
+	}
+	term, err := decodeJSON(raw)
+	if err != nil {
+		return err
+	}
+	j.Term = term
+	return nil
+}
+
+// EncodeAsJSON encodes the syntax tree of a Term as JSON and writes it
+// to the io.Writer
+func EncodeAsJSON(w io.Writer, e Term) error {
+	return json.NewEncoder(w).Encode(JSONTerm{e})
+}
+
+// DecodeAsJSON decodes a syntax tree written by EncodeAsJSON from the
+// io.Reader and returns the resulting Term
+func DecodeAsJSON(r io.Reader) (Term, error) {
+	var j JSONTerm
+	err := json.NewDecoder(r).Decode(&j)
+	return j.Term, err
+}
+
+type jsonObject map[string]interface{}
+
+func jsonNode(t Term) (interface{}, error) {
+	if t == nil {
+		return nil, nil
+	}
+	var err error
+	// child records the first error from converting a subterm
+	child := func(t Term) interface{} {
+		node, childErr := jsonNode(t)
+		if err == nil {
+			err = childErr
+		}
+		return node
+	}
+	children := func(m map[string]Term) jsonObject {
+		out := make(jsonObject, len(m))
+		for k, v := range m {
+			out[k] = child(v)
+		}
+		return out
+	}
+	var node jsonObject
+	switch val := t.(type) {
+	case Universe:
+		node = jsonObject{"kind": "Universe", "name": val.String()}
+	case Builtin:
+		node = jsonObject{"kind": "Builtin", "name": string(val)}
+	case Var:
+		node = jsonObject{"kind": "Var", "name": val.Name, "index": val.Index}
+	case LambdaTerm:
+		node = jsonObject{"kind": "Lambda", "label": val.Label, "type": child(val.Type), "body": child(val.Body)}
+	case PiTerm:
+		node = jsonObject{"kind": "Pi", "label": val.Label, "type": child(val.Type), "body": child(val.Body)}
+	case AppTerm:
+		node = jsonObject{"kind": "App", "fn": child(val.Fn), "arg": child(val.Arg)}
+	case OpTerm:
+		node = jsonObject{"kind": "Op", "opcode": val.OpCode, "l": child(val.L), "r": child(val.R)}
+	case Let:
+		bindings := make([]interface{}, len(val.Bindings))
+		for i, binding := range val.Bindings {
+			bindings[i] = jsonObject{
+				"variable":   binding.Variable,
+				"annotation": child(binding.Annotation),
+				"value":      child(binding.Value),
+			}
+		}
+		node = jsonObject{"kind": "Let", "bindings": bindings, "body": child(val.Body)}
+	case Annot:
+		node = jsonObject{"kind": "Annot", "expr": child(val.Expr), "annotation": child(val.Annotation)}
+	case NaturalLit:
+		node = jsonObject{"kind": "NaturalLit", "value": uint(val)}
+	case IntegerLit:
+		node = jsonObject{"kind": "IntegerLit", "value": int(val)}
+	case DoubleLit:
+		f := float64(val)
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			// JSON numbers can't express these
+			node = jsonObject{"kind": "DoubleLit", "value": strconv.FormatFloat(f, 'g', -1, 64)}
+		} else {
+			node = jsonObject{"kind": "DoubleLit", "value": f}
+		}
+	case BoolLit:
+		node = jsonObject{"kind": "BoolLit", "value": bool(val)}
+	case TextLitTerm:
+		chunks := make([]interface{}, len(val.Chunks))
+		for i, chunk := range val.Chunks {
+			chunks[i] = jsonObject{"prefix": chunk.Prefix, "expr": child(chunk.Expr)}
+		}
+		node = jsonObject{"kind": "TextLit", "chunks": chunks, "suffix": val.Suffix}
+	case IfTerm:
+		node = jsonObject{"kind": "If", "cond": child(val.Cond), "then": child(val.T), "else": child(val.F)}
+	case EmptyList:
+		node = jsonObject{"kind": "EmptyList", "type": child(val.Type)}
+	case NonEmptyList:
+		items := make([]interface{}, len(val))
+		for i, item := range val {
+			items[i] = child(item)
+		}
+		node = jsonObject{"kind": "NonEmptyList", "items": items}
+	case Some:
+		node = jsonObject{"kind": "Some", "val": child(val.Val)}
+	case RecordType:
+		node = jsonObject{"kind": "RecordType", "fields": children(val)}
+	case RecordLit:
+		node = jsonObject{"kind": "RecordLit", "fields": children(val)}
+	case UnionType:
+		node = jsonObject{"kind": "UnionType", "alternatives": children(val)}
+	case ToMap:
+		node = jsonObject{"kind": "ToMap", "record": child(val.Record), "type": child(val.Type)}
+	case Field:
+		node = jsonObject{"kind": "Field", "record": child(val.Record), "field": val.FieldName}
+	case Project:
+		node = jsonObject{"kind": "Project", "record": child(val.Record), "fields": val.FieldNames}
+	case ProjectType:
+		node = jsonObject{"kind": "ProjectType", "record": child(val.Record), "selector": child(val.Selector)}
+	case Merge:
+		node = jsonObject{"kind": "Merge", "handler": child(val.Handler), "union": child(val.Union), "annotation": child(val.Annotation)}
+	case Assert:
+		node = jsonObject{"kind": "Assert", "annotation": child(val.Annotation)}
+	case Import:
+		node = jsonObject{"kind": "Import", "mode": int(val.ImportMode)}
+		if val.Hash != nil {
+			node["hash"] = hex.EncodeToString(val.Hash)
+		}
+		switch f := val.Fetchable.(type) {
+		case EnvVar:
+			node["env"] = string(f)
+		case Local:
+			node["local"] = string(f)
+		case Remote:
+			node["remote"] = f.String()
+		case Missing:
+			node["missing"] = true
+		default:
+			return nil, fmt.Errorf("can't encode import %v as JSON", val)
+		}
+	default:
+		return nil, fmt.Errorf("can't encode %T as JSON", t)
+	}
+	return node, err
+}
+
+func jsonMember(node map[string]interface{}, name string) (interface{}, error) {
+	member, ok := node[name]
+	if !ok {
+		return nil, fmt.Errorf("JSON decode error: %v node missing %q", node["kind"], name)
+	}
+	return member, nil
+}
+
+func jsonString(node map[string]interface{}, name string) (string, error) {
+	member, err := jsonMember(node, name)
+	if err != nil {
+		return "", err
+	}
+	return unwrapString(member)
+}
+
+func jsonInt(node map[string]interface{}, name string) (int, error) {
+	member, err := jsonMember(node, name)
+	if err != nil {
+		return 0, err
+	}
+	if n, ok := member.(json.Number); ok {
+		i, err := strconv.ParseInt(string(n), 10, 0)
+		return int(i), err
+	}
+	return 0, fmt.Errorf("couldn't interpret %v as int", member)
+}
+
+// jsonChild decodes the named subterm of node; a null subterm
+// decodes to nil
+func jsonChild(node map[string]interface{}, name string) (Term, error) {
+	member, err := jsonMember(node, name)
+	if err != nil || member == nil {
+		return nil, err
+	}
+	return decodeJSON(member)
+}
+
+func jsonChildren(node map[string]interface{}, name string) (map[string]Term, error) {
+	member, err := jsonMember(node, name)
+	if err != nil {
+		return nil, err
+	}
+	m, ok := member.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("couldn't interpret %v as map[string]interface{}", member)
+	}
+	decodedM := make(map[string]Term, len(m))
+	for k := range m {
+		decodedM[k], err = jsonChild(m, k)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return decodedM, nil
+}
+
+func jsonArray(node map[string]interface{}, name string) ([]interface{}, error) {
+	member, err := jsonMember(node, name)
+	if err != nil {
+		return nil, err
+	}
+	if member == nil {
+		return nil, nil
+	}
+	array, ok := member.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("couldn't interpret %v as array", member)
+	}
+	return array, nil
+}
+
+func decodeJSON(raw interface{}) (Term, error) {
+	node, ok := raw.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("JSON decode error: expected an object, got %v", raw)
+	}
+	kind, err := jsonString(node, "kind")
+	if err != nil {
+		return nil, err
+	}
+	// scratch variables for the subterms and attributes of node
+	var (
+		a, b, c Term
+		name    string
+		n       int
+	)
+	switch kind {
+	case "Universe":
+		name, err = jsonString(node, "name")
+		if err != nil {
+			return nil, err
+		}
+		switch name {
+		case "Type":
+			return Type, nil
+		case "Kind":
+			return Kind, nil
+		case "Sort":
+			return Sort, nil
+		}
+		return nil, fmt.Errorf("JSON decode error: unknown universe %s", name)
+	case "Builtin":
+		name, err = jsonString(node, "name")
+		if err != nil {
+			return nil, err
+		}
+		if builtin, ok := nameToBuiltin[name]; ok {
+			if _, isBuiltin := builtin.(Builtin); isBuiltin {
+				return builtin, nil
+			}
+		}
+		return nil, fmt.Errorf("unrecognized builtin %s", name)
+	case "Var":
+		if name, err = jsonString(node, "name"); err != nil {
+			return nil, err
+		}
+		if n, err = jsonInt(node, "index"); err != nil {
+			return nil, err
+		}
+		return Var{Name: name, Index: n}, nil
+	case "Lambda", "Pi":
+		if name, err = jsonString(node, "label"); err != nil {
+			return nil, err
+		}
+		if a, err = jsonChild(node, "type"); err != nil {
+			return nil, err
+		}
+		if b, err = jsonChild(node, "body"); err != nil {
+			return nil, err
+		}
+		if kind == "Lambda" {
+			return LambdaTerm{Label: name, Type: a, Body: b}, nil
+		}
+		return PiTerm{Label: name, Type: a, Body: b}, nil
+	case "App":
+		if a, err = jsonChild(node, "fn"); err != nil {
+			return nil, err
+		}
+		if b, err = jsonChild(node, "arg"); err != nil {
+			return nil, err
+		}
+		return AppTerm{Fn: a, Arg: b}, nil
+	case "Op":
+		if n, err = jsonInt(node, "opcode"); err != nil {
+			return nil, err
+		}
+		if a, err = jsonChild(node, "l"); err != nil {
+			return nil, err
+		}
+		if b, err = jsonChild(node, "r"); err != nil {
+			return nil, err
+		}
+		return OpTerm{OpCode: n, L: a, R: b}, nil
+	case "Let":
+		rawBindings, err := jsonArray(node, "bindings")
+		if err != nil {
+			return nil, err
+		}
+		bindings := make([]Binding, len(rawBindings))
+		for i, rawBinding := range rawBindings {
+			binding, ok := rawBinding.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("JSON decode error: malformed let binding %v", rawBinding)
+			}
+			if bindings[i].Variable, err = jsonString(binding, "variable"); err != nil {
+				return nil, err
+			}
+			if bindings[i].Annotation, err = jsonChild(binding, "annotation"); err != nil {
+				return nil, err
+			}
+			if bindings[i].Value, err = jsonChild(binding, "value"); err != nil {
+				return nil, err
+			}
+		}
+		if a, err = jsonChild(node, "body"); err != nil {
+			return nil, err
+		}
+		return Let{Bindings: bindings, Body: a}, nil
+	case "Annot":
+		if a, err = jsonChild(node, "expr"); err != nil {
+			return nil, err
+		}
+		if b, err = jsonChild(node, "annotation"); err != nil {
+			return nil, err
+		}
+		return Annot{Expr: a, Annotation: b}, nil
+	case "NaturalLit":
+		if n, err = jsonInt(node, "value"); err != nil {
+			return nil, err
+		}
+		if n < 0 {
+			return nil, fmt.Errorf("JSON decode error: negative Natural %d", n)
+		}
+		return NaturalLit(n), nil
+	case "IntegerLit":
+		if n, err = jsonInt(node, "value"); err != nil {
+			return nil, err
+		}
+		return IntegerLit(n), nil
+	case "DoubleLit":
+		value, err := jsonMember(node, "value")
+		if err != nil {
+			return nil, err
+		}
+		var f float64
+		switch v := value.(type) {
+		case json.Number:
+			f, err = v.Float64()
+		case string:
+			f, err = strconv.ParseFloat(v, 64)
+		default:
+			err = fmt.Errorf("couldn't interpret %v as Double", value)
+		}
+		return DoubleLit(f), err
+	case "BoolLit":
+		value, err := jsonMember(node, "value")
+		if err != nil {
+			return nil, err
+		}
+		if b, ok := value.(bool); ok {
+			return BoolLit(b), nil
+		}
+		return nil, fmt.Errorf("couldn't interpret %v as bool", value)
+	case "TextLit":
+		rawChunks, err := jsonArray(node, "chunks")
+		if err != nil {
+			return nil, err
+		}
+		var text TextLitTerm
+		for _, rawChunk := range rawChunks {
+			chunk, ok := rawChunk.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("JSON decode error: malformed text chunk %v", rawChunk)
+			}
+			if name, err = jsonString(chunk, "prefix"); err != nil {
+				return nil, err
+			}
+			if a, err = jsonChild(chunk, "expr"); err != nil {
+				return nil, err
+			}
+			text.Chunks = append(text.Chunks, Chunk{Prefix: name, Expr: a})
+		}
+		if text.Suffix, err = jsonString(node, "suffix"); err != nil {
+			return nil, err
+		}
+		return text, nil
+	case "If":
+		if a, err = jsonChild(node, "cond"); err != nil {
+			return nil, err
+		}
+		if b, err = jsonChild(node, "then"); err != nil {
+			return nil, err
+		}
+		if c, err = jsonChild(node, "else"); err != nil {
+			return nil, err
+		}
+		return IfTerm{Cond: a, T: b, F: c}, nil
+	case "EmptyList":
+		if a, err = jsonChild(node, "type"); err != nil {
+			return nil, err
+		}
+		return EmptyList{Type: a}, nil
+	case "NonEmptyList":
+		rawItems, err := jsonArray(node, "items")
+		if err != nil {
+			return nil, err
+		}
+		if len(rawItems) == 0 {
+			return nil, errors.New("JSON decode error: NonEmptyList has no items")
+		}
+		items := make(NonEmptyList, len(rawItems))
+		for i, rawItem := range rawItems {
+			if items[i], err = decodeJSON(rawItem); err != nil {
+				return nil, err
+			}
+		}
+		return items, nil
+	case "Some":
+		if a, err = jsonChild(node, "val"); err != nil {
+			return nil, err
+		}
+		return Some{Val: a}, nil
+	case "RecordType", "RecordLit":
+		m, err := jsonChildren(node, "fields")
+		if err != nil {
+			return nil, err
+		}
+		if kind == "RecordType" {
+			return RecordType(m), nil
+		}
+		return RecordLit(m), nil
+	case "UnionType":
+		m, err := jsonChildren(node, "alternatives")
+		if err != nil {
+			return nil, err
+		}
+		return UnionType(m), nil
+	case "ToMap":
+		if a, err = jsonChild(node, "record"); err != nil {
+			return nil, err
+		}
+		if b, err = jsonChild(node, "type"); err != nil {
+			return nil, err
+		}
+		return ToMap{Record: a, Type: b}, nil
+	case "Field":
+		if a, err = jsonChild(node, "record"); err != nil {
+			return nil, err
+		}
+		if name, err = jsonString(node, "field"); err != nil {
+			return nil, err
+		}
+		return Field{Record: a, FieldName: name}, nil
+	case "Project":
+		if a, err = jsonChild(node, "record"); err != nil {
+			return nil, err
+		}
+		rawNames, err := jsonArray(node, "fields")
+		if err != nil {
+			return nil, err
+		}
+		fieldNames := make([]string, len(rawNames))
+		for i, rawName := range rawNames {
+			if fieldNames[i], err = unwrapString(rawName); err != nil {
+				return nil, err
+			}
+		}
+		return Project{Record: a, FieldNames: fieldNames}, nil
+	case "ProjectType":
+		if a, err = jsonChild(node, "record"); err != nil {
+			return nil, err
+		}
+		if b, err = jsonChild(node, "selector"); err != nil {
+			return nil, err
+		}
+		return ProjectType{Record: a, Selector: b}, nil
+	case "Merge":
+		if a, err = jsonChild(node, "handler"); err != nil {
+			return nil, err
+		}
+		if b, err = jsonChild(node, "union"); err != nil {
+			return nil, err
+		}
+		if c, err = jsonChild(node, "annotation"); err != nil {
+			return nil, err
+		}
+		return Merge{Handler: a, Union: b, Annotation: c}, nil
+	case "Assert":
+		if a, err = jsonChild(node, "annotation"); err != nil {
+			return nil, err
+		}
+		return Assert{Annotation: a}, nil
+	case "Import":
+		return decodeJSONImport(node)
+	}
+	return nil, fmt.Errorf("JSON decode error: unknown kind %s", kind)
+}
+
+func decodeJSONImport(node map[string]interface{}) (Term, error) {
+	var i Import
+	mode, err := jsonInt(node, "mode")
+	if err != nil {
+		return nil, err
+	}
+	if mode < int(Code) || mode > int(Location) {
+		return nil, fmt.Errorf("JSON decode error: unknown import mode %d", mode)
+	}
+	i.ImportMode = ImportMode(mode)
+	if _, ok := node["hash"]; ok {
+		digest, err := jsonString(node, "hash")
+		if err != nil {
+			return nil, err
+		}
+		if i.Hash, err = hex.DecodeString(digest); err != nil {
+			return nil, err
+		}
+	}
+	// exactly one of these members says where the import is from
+	var from []string
+	for _, k := range []string{"env", "local", "remote", "missing"} {
+		if _, ok := node[k]; ok {
+			from = append(from, k)
+		}
+	}
+	if len(from) != 1 {
+		return nil, fmt.Errorf("JSON decode error: import must have one of env, local, remote or missing, got %v", from)
+	}
+	switch from[0] {
+	case "env":
+		name, err := jsonString(node, "env")
+		if err != nil {
+			return nil, err
+		}
+		i.Fetchable = EnvVar(name)
+	case "local":
+		path, err := jsonString(node, "local")
+		if err != nil {
+			return nil, err
+		}
+		local := Local(path)
+		i.Fetchable = NewLocal(local.Kind(), local.PathComponents()...)
+	case "remote":
+		rawURL, err := jsonString(node, "remote")
+		if err != nil {
+			return nil, err
+		}
+		u, err := url.Parse(rawURL)
+		if err != nil {
+			return nil, err
+		}
+		i.Fetchable = NewRemote(u)
+	case "missing":
+		i.Fetchable = Missing{}
+	}
+	return i, nil
+}
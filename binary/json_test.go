@@ -0,0 +1,81 @@
+package binary_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"net/url"
+
+	. "github.com/philandstuff/dhall-golang/binary"
+	. "github.com/philandstuff/dhall-golang/core"
+	"github.com/philandstuff/dhall-golang/parser"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+func mustParseURL(s string) *url.URL {
+	u, err := url.Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return u
+}
+
+var _ = Describe("JSON syntax trees", func() {
+	DescribeTable("round-trip",
+		func(term Term) {
+			var buf bytes.Buffer
+			Expect(EncodeAsJSON(&buf, term)).To(Succeed())
+			actual, err := DecodeAsJSON(&buf)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(actual).To(Equal(term))
+		},
+		Entry("Natural", Natural),
+		Entry("Kind", Kind),
+		Entry("x@2", Var{Name: "x", Index: 2}),
+		Entry("-3", IntegerLit(-3)),
+		Entry("Infinity", DoubleLit(math.Inf(1))),
+		Entry("λ(x : Natural) → x + 1",
+			NewLambda("x", Natural, NaturalPlus(NewVar("x"), NaturalLit(1)))),
+		Entry("merge without annotation",
+			Merge{Handler: RecordLit{"A": True}, Union: Field{Record: UnionType{"A": nil}, FieldName: "A"}}),
+		Entry("let with annotation",
+			NewLet(NewVar("x"), Binding{Variable: "x", Annotation: Natural, Value: NaturalLit(1)})),
+		Entry("import",
+			Import{
+				ImportHashed: ImportHashed{
+					Fetchable: NewRemote(mustParseURL("https://example.com/foo")),
+					Hash:      []byte{0x12, 0x20, 0xab},
+				},
+				ImportMode: RawText,
+			}),
+	)
+	It("round-trips a parsed expression", func() {
+		term, err := parser.Parse("-", []byte(`
+let f = λ(xs : List { a : Natural }) → List/length { a : Natural } xs
+in  { text = "n = ${Natural/show (f [ { a = 1 } ])}"
+    , opt = None Bool
+    , proj = { a = 1, b = 2 }.{ a }
+    , ok = if True then [] : List Integer else [ +1, -2 ]
+    , env = env:HOME as Text ? ./local.dhall
+    }
+`))
+		Expect(err).ToNot(HaveOccurred())
+		b, err := json.Marshal(JSONTerm{term.(Term)})
+		Expect(err).ToNot(HaveOccurred())
+		var actual JSONTerm
+		Expect(json.Unmarshal(b, &actual)).To(Succeed())
+		Expect(actual.Term).To(Equal(term))
+	})
+	It("names node kinds", func() {
+		b, err := json.Marshal(JSONTerm{Some{NaturalLit(3)}})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(b).To(MatchJSON(`{"kind": "Some", "val": {"kind": "NaturalLit", "value": 3}}`))
+	})
+	It("rejects unknown kinds", func() {
+		_, err := DecodeAsJSON(bytes.NewBufferString(`{"kind": "Nonsense"}`))
+		Expect(err).To(HaveOccurred())
+	})
+})
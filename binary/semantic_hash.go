@@ -20,3 +20,18 @@ func SemanticHash(e core.Term) ([]byte, error) {
 	hash := sha256.Sum256(buf.Bytes())
 	return append([]byte{0x12, 0x20}, hash[:]...), nil
 }
+
+// Hash returns a structural hash of an expression, for use as a map
+// key.  It is the sha256 sum of the CBOR representation of the
+// alpha-normalized expression, so alpha-equivalent expressions hash
+// the same, but unlike SemanticHash the expression is not
+// beta-normalized first: `1 + 1` and `2` hash differently.  This makes
+// it much cheaper than SemanticHash.
+func Hash(e core.Term) ([32]byte, error) {
+	var buf bytes.Buffer
+	err := EncodeAsCbor(&buf, core.AlphaNormalize(e))
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return sha256.Sum256(buf.Bytes()), nil
+}
@@ -0,0 +1,62 @@
+package binary_test
+
+import (
+	. "github.com/philandstuff/dhall-golang/binary"
+	. "github.com/philandstuff/dhall-golang/core"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Hash", func() {
+	x, y := NewVar("x"), NewVar("y")
+	hash := func(t Term) [32]byte {
+		h, err := Hash(t)
+		Expect(err).ToNot(HaveOccurred())
+		return h
+	}
+	DescribeTable("hashes alpha-equivalent terms equally",
+		func(a, b Term) {
+			Expect(hash(a)).To(Equal(hash(b)))
+		},
+		Entry(`λ(x : Natural) → x ≅ λ(y : Natural) → y`,
+			NewLambda("x", Natural, x), NewLambda("y", Natural, y)),
+		Entry(`∀(x : Type) → x ≅ ∀(y : Type) → y`,
+			NewPi("x", Type, x), NewPi("y", Type, y)),
+		Entry(`λ(x : Type) → λ(x : x) → x@1 ≅ λ(a : Type) → λ(b : a) → a`,
+			NewLambda("x", Type, NewLambda("x", x, Var{Name: "x", Index: 1})),
+			NewLambda("a", Type, NewLambda("b", NewVar("a"), NewVar("a")))),
+		Entry(`let x = 1 in x ≅ let y = 1 in y`,
+			NewLet(x, Binding{Variable: "x", Value: NaturalLit(1)}),
+			NewLet(y, Binding{Variable: "y", Value: NaturalLit(1)})),
+		Entry(`λ(y : Natural) → x ≅ λ(z : Natural) → x -- free variable`,
+			NewLambda("y", Natural, x), NewLambda("z", Natural, x)),
+		Entry(`λ(x : Natural) → x@1 ≅ λ(y : Natural) → x -- free variable past a binder`,
+			NewLambda("x", Natural, Var{Name: "x", Index: 1}), NewLambda("y", Natural, x)),
+		Entry(`λ(x : Natural) → _ ≅ λ(y : Natural) → _ -- free variable named _`,
+			NewLambda("x", Natural, NewVar("_")), NewLambda("y", Natural, NewVar("_"))),
+	)
+	DescribeTable("hashes distinct terms differently",
+		func(a, b Term) {
+			Expect(hash(a)).ToNot(Equal(hash(b)))
+		},
+		Entry(`1 + 1 ≇ 2 -- not normalized`,
+			NaturalPlus(NaturalLit(1), NaturalLit(1)), NaturalLit(2)),
+		Entry(`λ(x : Type) → λ(y : Type) → x ≇ λ(x : Type) → λ(y : Type) → y`,
+			NewLambda("x", Type, NewLambda("y", Type, x)), NewLambda("x", Type, NewLambda("y", Type, y))),
+		Entry(`x ≇ y -- free variables`, x, y),
+		Entry(`λ(y : Natural) → x ≇ λ(x : Natural) → x`,
+			NewLambda("y", Natural, x), NewLambda("x", Natural, x)),
+		Entry(`{ a = 1 } ≇ { b = 1 }`,
+			RecordLit{"a": NaturalLit(1)}, RecordLit{"b": NaturalLit(1)}),
+		Entry(`λ(x : Natural) → _ ≇ λ(x : Natural) → x`,
+			NewLambda("x", Natural, NewVar("_")), NewLambda("x", Natural, x)),
+		Entry(`λ(_ : Natural) → _@1 ≇ λ(_ : Natural) → _`,
+			NewLambda("_", Natural, Var{Name: "_", Index: 1}), NewLambda("_", Natural, NewVar("_"))),
+	)
+	It("fails on an import with no location", func() {
+		_, err := Hash(Import{})
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -2,9 +2,11 @@ package main
 
 import (
 	"bytes"
+	"flag"
 	"fmt"
 	"log"
 	"os"
+	"path/filepath"
 
 	"github.com/philandstuff/dhall-golang/binary"
 	"github.com/philandstuff/dhall-golang/core"
@@ -12,12 +14,32 @@ import (
 	"github.com/philandstuff/dhall-golang/parser"
 )
 
+var output = flag.String("output", "dhall", "output format: dhall or cbor-diag")
+var cacheDir = flag.String("cache-dir", "", "directory to cache imports in, instead of XDG_CACHE_HOME")
+var noCache = flag.Bool("no-cache", false, "don't read or write the import cache")
+
+// newLoader returns a Loader using the import cache selected by the
+// -cache-dir and -no-cache flags.
+func newLoader(cacheDir string, noCache bool) imports.Loader {
+	switch {
+	case noCache:
+		return imports.Loader{Cache: imports.NoCache{}}
+	case cacheDir != "":
+		return imports.Loader{Cache: imports.DirCache(filepath.Join(cacheDir, "dhall"))}
+	}
+	return imports.Loader{Cache: imports.StandardCache{}}
+}
+
 func main() {
+	flag.Parse()
+	if *output != "dhall" && *output != "cbor-diag" {
+		log.Fatalf("Unknown output format %s", *output)
+	}
 	expr, err := parser.ParseReader("-", os.Stdin)
 	if err != nil {
 		log.Fatalf("Parse error: %v", err)
 	}
-	resolvedExpr, err := imports.Load(expr.(core.Term))
+	resolvedExpr, err := newLoader(*cacheDir, *noCache).Load(expr.(core.Term))
 	if err != nil {
 		log.Fatalf("Import resolve error: %v", err)
 	}
@@ -27,10 +49,19 @@ func main() {
 	}
 	fmt.Fprint(os.Stderr, inferredType)
 	fmt.Fprintln(os.Stderr)
-	fmt.Println(core.AlphaBetaEval(resolvedExpr))
 
 	var buf = new(bytes.Buffer)
 	binary.EncodeAsCbor(buf, core.Quote(core.AlphaBetaEval(resolvedExpr)))
+	if *output == "cbor-diag" {
+		diag, err := binary.CborDiag(buf.Bytes())
+		if err != nil {
+			log.Fatalf("failed to render CBOR: %v", err)
+		}
+		fmt.Println(diag)
+		return
+	}
+
+	fmt.Println(core.AlphaBetaEval(resolvedExpr))
 	final, err := binary.DecodeAsCbor(buf)
 	if err != nil {
 		log.Fatalf("failed to decode: %v", err)
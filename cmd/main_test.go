@@ -0,0 +1,44 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/philandstuff/dhall-golang/core"
+	"github.com/philandstuff/dhall-golang/imports"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Import cache flags", func() {
+	var dir string
+	var frozen core.Term
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "dhall-cmd")
+		Expect(err).ToNot(HaveOccurred())
+		file := filepath.Join(dir, "one.dhall")
+		Expect(ioutil.WriteFile(file, []byte("1"), 0644)).To(Succeed())
+		frozen, err = imports.FreezeWith(imports.NoCache{},
+			core.Import{ImportHashed: core.ImportHashed{Fetchable: core.Local(file)}})
+		Expect(err).ToNot(HaveOccurred())
+
+		// poison the cache, so we can tell whether it was used
+		imports.DirCache(filepath.Join(dir, "dhall")).Save(frozen.(core.Import).Hash, core.NaturalLit(2))
+	})
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+	It("reads frozen imports from -cache-dir", func() {
+		actual, err := newLoader(dir, false).Load(frozen)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(Equal(core.NaturalLit(2)))
+	})
+	It("refetches frozen imports with -no-cache", func() {
+		actual, err := newLoader(dir, true).Load(frozen)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(Equal(core.NaturalLit(1)))
+	})
+})
@@ -0,0 +1,128 @@
+package core
+
+// AlphaNormalize renames every bound variable in t to `_`, adjusting
+// indices so that the result means the same thing, without otherwise
+// normalizing it.  Alpha-equivalent Terms, such as `λ(x : A) → x` and
+// `λ(y : A) → y`, have the same AlphaNormalize result.  Free variables
+// keep their names.
+func AlphaNormalize(t Term) Term {
+	return alphaWith(nil, t)
+}
+
+// alphaWith alpha-normalizes t under the binders named in ctx,
+// innermost last.
+func alphaWith(ctx []string, t Term) Term {
+	walk := func(t Term) Term {
+		if t == nil {
+			return nil
+		}
+		return alphaWith(ctx, t)
+	}
+	// bind returns ctx extended with name, without sharing its
+	// backing array with siblings
+	bind := func(ctx []string, name string) []string {
+		return append(ctx[:len(ctx):len(ctx)], name)
+	}
+	switch t := t.(type) {
+	case Var:
+		seen := 0
+		for j := len(ctx) - 1; j >= 0; j-- {
+			if ctx[j] != t.Name {
+				continue
+			}
+			if seen == t.Index {
+				return Var{Name: "_", Index: len(ctx) - 1 - j}
+			}
+			seen++
+		}
+		if t.Name == "_" {
+			// every binder in ctx is now named `_`, so a free `_`
+			// must skip all of them
+			return Var{Name: "_", Index: t.Index - seen + len(ctx)}
+		}
+		return Var{Name: t.Name, Index: t.Index - seen}
+	case LambdaTerm:
+		return LambdaTerm{
+			Label: "_",
+			Type:  walk(t.Type),
+			Body:  alphaWith(bind(ctx, t.Label), t.Body),
+		}
+	case PiTerm:
+		return PiTerm{
+			Label: "_",
+			Type:  walk(t.Type),
+			Body:  alphaWith(bind(ctx, t.Label), t.Body),
+		}
+	case AppTerm:
+		return AppTerm{Fn: walk(t.Fn), Arg: walk(t.Arg)}
+	case OpTerm:
+		return OpTerm{OpCode: t.OpCode, L: walk(t.L), R: walk(t.R)}
+	case Let:
+		bindings := make([]Binding, len(t.Bindings))
+		scope := ctx
+		for i, b := range t.Bindings {
+			bindings[i] = Binding{
+				Variable: "_",
+				Value:    alphaWith(scope, b.Value),
+			}
+			if b.Annotation != nil {
+				bindings[i].Annotation = alphaWith(scope, b.Annotation)
+			}
+			scope = bind(scope, b.Variable)
+		}
+		return Let{Bindings: bindings, Body: alphaWith(scope, t.Body)}
+	case Annot:
+		return Annot{Expr: walk(t.Expr), Annotation: walk(t.Annotation)}
+	case TextLitTerm:
+		result := TextLitTerm{Suffix: t.Suffix}
+		for _, chunk := range t.Chunks {
+			result.Chunks = append(result.Chunks, Chunk{Prefix: chunk.Prefix, Expr: walk(chunk.Expr)})
+		}
+		return result
+	case IfTerm:
+		return IfTerm{Cond: walk(t.Cond), T: walk(t.T), F: walk(t.F)}
+	case EmptyList:
+		return EmptyList{Type: walk(t.Type)}
+	case NonEmptyList:
+		result := make(NonEmptyList, len(t))
+		for i, item := range t {
+			result[i] = walk(item)
+		}
+		return result
+	case Some:
+		return Some{Val: walk(t.Val)}
+	case RecordType:
+		result := make(RecordType, len(t))
+		for k, v := range t {
+			result[k] = walk(v)
+		}
+		return result
+	case RecordLit:
+		result := make(RecordLit, len(t))
+		for k, v := range t {
+			result[k] = walk(v)
+		}
+		return result
+	case ToMap:
+		return ToMap{Record: walk(t.Record), Type: walk(t.Type)}
+	case Field:
+		return Field{Record: walk(t.Record), FieldName: t.FieldName}
+	case Project:
+		return Project{Record: walk(t.Record), FieldNames: t.FieldNames}
+	case ProjectType:
+		return ProjectType{Record: walk(t.Record), Selector: walk(t.Selector)}
+	case UnionType:
+		result := make(UnionType, len(t))
+		for k, v := range t {
+			result[k] = walk(v)
+		}
+		return result
+	case Merge:
+		return Merge{Handler: walk(t.Handler), Union: walk(t.Union), Annotation: walk(t.Annotation)}
+	case Assert:
+		return Assert{Annotation: walk(t.Annotation)}
+	default:
+		// Universe, Builtin, literals, Import
+		return t
+	}
+}
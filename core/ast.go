@@ -219,6 +219,16 @@ type (
 		Index int
 	}
 
+	// A typedTerm is an internal sentinel value used by TypeOf() in
+	// the process of typechecking lets.  The bound value is
+	// substituted into the body along with its type, so that it is
+	// not typechecked again at each use.  This matters for deep
+	// chains of lets, such as the Prelude.
+	typedTerm struct {
+		Term Term
+		Type Value
+	}
+
 	// A quoteVar is an internal sentinel value used by Quote() in the
 	// process of converting Values back to Terms.
 	quoteVar struct {
@@ -239,6 +249,8 @@ func (Var) isValue() {}
 func (localVar) isTerm()  {}
 func (localVar) isValue() {}
 
+func (typedTerm) isTerm() {}
+
 func (quoteVar) isValue() {}
 
 // NewVar returns a new Var Term
@@ -465,7 +477,14 @@ type (
 		Body     Term
 	}
 
-	// no LetValue, since normalization removes all lets
+	// A letVal is a let kept by EvalOptions.KeepLets.  Like a
+	// LambdaValue, its body is a function of the bound variable.
+	letVal struct {
+		Label      string
+		Annotation Value // may be nil
+		Value      Value
+		Body       func(Value) Value
+	}
 
 	Annot struct {
 		Expr       Term
@@ -475,8 +494,9 @@ type (
 	// no AnnotValue either
 )
 
-func (Let) isTerm()   {}
-func (Annot) isTerm() {}
+func (Let) isTerm()     {}
+func (letVal) isValue() {}
+func (Annot) isTerm()   {}
 
 // NewLet returns a let Term
 func NewLet(body Term, bindings ...Binding) Term {
@@ -635,12 +655,13 @@ func (d DoubleLit) String() string {
 	if math.IsInf(f, -1) {
 		return "-Infinity"
 	}
-	// if we have a whole number, we need to append .0 to it so we get a valid
-	// Double literal
-	if f == float64(int64(f)) {
-		return fmt.Sprintf("%#v.0", float64(d))
+	s := fmt.Sprintf("%#v", float64(d))
+	// if we have a whole number without an exponent, we need to
+	// append .0 to it so we get a valid Double literal
+	if f == float64(int64(f)) && !strings.ContainsRune(s, 'e') {
+		return s + ".0"
 	}
-	return fmt.Sprintf("%#v", float64(d))
+	return s
 }
 
 func (Some) isTerm()     {}
@@ -716,6 +737,8 @@ func (v localVar) String() string {
 	return fmt.Sprint("local:", v.Name, "/", v.Index)
 }
 
+func (t typedTerm) String() string { return fmt.Sprint(t.Term) }
+
 func (lam LambdaTerm) String() string {
 	return fmt.Sprintf("(λ(%s : %v) → %v)", lam.Label, lam.Type, lam.Body)
 }
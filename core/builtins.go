@@ -5,6 +5,18 @@ import (
 	"strings"
 )
 
+// A FoldLimitError is returned when a fold would bring the number of
+// fold steps taken to Steps, more than EvalOptions.MaxFoldSteps.
+type FoldLimitError struct {
+	Fold  Builtin
+	Steps uint
+	Limit int
+}
+
+func (e *FoldLimitError) Error() string {
+	return fmt.Sprintf("%s brings the number of fold steps to %d, exceeding the limit of %d", e.Fold, e.Steps, e.Limit)
+}
+
 func (naturalBuildVal) Call(x Value) Value {
 	var succ Value = LambdaValue{
 		Label:  "x",
@@ -48,11 +60,17 @@ func (fold naturalFoldVal) Call(x Value) Value {
 			succ: x,
 		}
 	}
-	zero := x
+	return fold.fold(x, applyVal)
+}
+
+// fold applies a Natural/fold with all but its last argument to
+// zero, applying the step function with apply.  It returns nil if the
+// Natural isn't a literal.
+func (fold naturalFoldVal) fold(zero Value, apply func(Value, ...Value) Value) Value {
 	if n, ok := fold.n.(NaturalLit); ok {
 		result := zero
 		for i := 0; i < int(n); i++ {
-			result = applyVal(fold.succ, result)
+			result = apply(fold.succ, result)
 		}
 		return result
 	}
@@ -278,14 +296,20 @@ func (l listFoldVal) Call(x Value) Value {
 			cons: x,
 		}
 	}
-	empty := x
+	return l.fold(x, applyVal)
+}
+
+// fold applies a List/fold with all but its last argument to empty,
+// applying the cons function with apply.  It returns nil if the list
+// isn't a literal.
+func (l listFoldVal) fold(empty Value, apply func(Value, ...Value) Value) Value {
 	if _, ok := l.list.(EmptyListVal); ok {
 		return empty
 	}
 	if list, ok := l.list.(NonEmptyListVal); ok {
 		result := empty
 		for i := len(list) - 1; i >= 0; i-- {
-			result = applyVal(l.cons, list[i], result)
+			result = apply(l.cons, list[i], result)
 		}
 		return result
 	}
@@ -1,7 +1,95 @@
 package core
 
-import "math"
+import (
+	"math"
+	"reflect"
+)
 
+// StructurallyEqual reports whether two Terms are syntactically
+// identical, without normalizing them.  Unlike judgmental equality,
+// which is only defined on Terms whose imports have been resolved,
+// StructurallyEqual can compare raw parsed Terms: two Terms containing
+// the same unresolved import are structurally equal.
+//
+// Doubles are compared as the binary encoding writes them, so that a
+// Term containing NaN is structurally equal to itself, but 0.0 and
+// -0.0 are not structurally equal.
+func StructurallyEqual(t1 Term, t2 Term) bool {
+	return structurallyEqualValues(reflect.ValueOf(t1), reflect.ValueOf(t2))
+}
+
+var doubleLitType = reflect.TypeOf(DoubleLit(0))
+
+// structurallyEqualValues is like reflect.DeepEqual, except in how
+// it compares DoubleLits.
+func structurallyEqualValues(v1, v2 reflect.Value) bool {
+	if !v1.IsValid() || !v2.IsValid() {
+		return v1.IsValid() == v2.IsValid()
+	}
+	if v1.Type() != v2.Type() {
+		return false
+	}
+	if v1.Type() == doubleLitType {
+		d1, d2 := v1.Float(), v2.Float()
+		if math.IsNaN(d1) || math.IsNaN(d2) {
+			return math.IsNaN(d1) && math.IsNaN(d2)
+		}
+		return math.Float64bits(d1) == math.Float64bits(d2)
+	}
+	switch v1.Kind() {
+	case reflect.Interface, reflect.Ptr:
+		if v1.IsNil() || v2.IsNil() {
+			return v1.IsNil() == v2.IsNil()
+		}
+		return structurallyEqualValues(v1.Elem(), v2.Elem())
+	case reflect.Struct:
+		for i := 0; i < v1.NumField(); i++ {
+			if !structurallyEqualValues(v1.Field(i), v2.Field(i)) {
+				return false
+			}
+		}
+		return true
+	case reflect.Slice, reflect.Array:
+		if v1.Kind() == reflect.Slice && v1.IsNil() != v2.IsNil() {
+			return false
+		}
+		if v1.Len() != v2.Len() {
+			return false
+		}
+		for i := 0; i < v1.Len(); i++ {
+			if !structurallyEqualValues(v1.Index(i), v2.Index(i)) {
+				return false
+			}
+		}
+		return true
+	case reflect.Map:
+		if v1.IsNil() != v2.IsNil() || v1.Len() != v2.Len() {
+			return false
+		}
+		for _, k := range v1.MapKeys() {
+			if !structurallyEqualValues(v1.MapIndex(k), v2.MapIndex(k)) {
+				return false
+			}
+		}
+		return true
+	case reflect.Bool:
+		return v1.Bool() == v2.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v1.Int() == v2.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v1.Uint() == v2.Uint()
+	case reflect.Float32, reflect.Float64:
+		return v1.Float() == v2.Float()
+	case reflect.String:
+		return v1.String() == v2.String()
+	case reflect.Func:
+		return v1.IsNil() && v2.IsNil()
+	}
+	panic("unexpected kind " + v1.Kind().String() + " in Term")
+}
+
+// judgmentallyEqual is only defined on Terms with no unresolved
+// imports; use StructurallyEqual for Terms before import resolution.
 func judgmentallyEqual(t1 Term, t2 Term) bool {
 	v1 := Eval(t1)
 	v2 := Eval(t2)
@@ -155,8 +243,17 @@ func judgmentallyEqualValsWith(level int, v1 Value, v2 Value) bool {
 		if !ok {
 			return false
 		}
-		return judgmentallyEqualValsWith(level, v1.Record, v2.Record) &&
-			judgmentallyEqualValsWith(level, v1.Type, v2.Type)
+		if v1.Type != nil {
+			if v2.Type == nil {
+				return false
+			}
+			if !judgmentallyEqualValsWith(level, v1.Type, v2.Type) {
+				return false
+			}
+		} else if v2.Type != nil {
+			return false
+		}
+		return judgmentallyEqualValsWith(level, v1.Record, v2.Record)
 	case fieldVal:
 		v2, ok := v2.(fieldVal)
 		if !ok {
@@ -210,6 +307,8 @@ func judgmentallyEqualValsWith(level int, v1 Value, v2 Value) bool {
 			if !judgmentallyEqualValsWith(level, v1.Annotation, v2.Annotation) {
 				return false
 			}
+		} else if v2.Annotation != nil {
+			return false
 		}
 		return judgmentallyEqualValsWith(level, v1.Handler, v2.Handler) &&
 			judgmentallyEqualValsWith(level, v1.Union, v2.Union)
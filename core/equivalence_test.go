@@ -1,6 +1,9 @@
 package core
 
 import (
+	"math"
+	"net/url"
+
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 )
@@ -30,4 +33,56 @@ var _ = DescribeTable("judgmentallyEqual",
 		NewPi("a", Type, Apply(List, NewVar("a"))),
 		NewPi("b", Type, Apply(List, NewVar("b"))),
 		true),
+	Entry("Neutral merges with and without annotation",
+		Merge{Handler: RecordLit{}, Union: NewVar("x")},
+		Merge{Handler: RecordLit{}, Union: NewVar("x"), Annotation: Natural},
+		false),
+	Entry("Neutral merges without and with annotation",
+		Merge{Handler: RecordLit{}, Union: NewVar("x"), Annotation: Natural},
+		Merge{Handler: RecordLit{}, Union: NewVar("x")},
+		false),
+)
+
+var importFoo = Import{ImportHashed: ImportHashed{Fetchable: Local("foo")}}
+
+var _ = DescribeTable("StructurallyEqual",
+	func(t1, t2 Term, expected bool) {
+		Expect(StructurallyEqual(t1, t2)).To(Equal(expected))
+	},
+	Entry("Unequal things", Bool, Natural, false),
+	Entry("Identical imports", importFoo, importFoo, true),
+	Entry("Terms sharing an identical import",
+		NaturalPlus(importFoo, NaturalLit(1)),
+		NaturalPlus(Import{ImportHashed: ImportHashed{Fetchable: Local("foo")}}, NaturalLit(1)),
+		true),
+	Entry("Imports of different locations",
+		importFoo,
+		Import{ImportHashed: ImportHashed{Fetchable: Local("bar")}},
+		false),
+	Entry("Imports with different modes",
+		importFoo,
+		Import{ImportHashed: importFoo.ImportHashed, ImportMode: RawText},
+		false),
+	Entry("Imports with different hashes",
+		importFoo,
+		Import{ImportHashed: ImportHashed{Fetchable: Local("foo"), Hash: []byte{0x12, 0x20}}},
+		false),
+	Entry("Lambda terms with different labels are not structurally equal",
+		NewLambda("a", Natural, NewVar("a")),
+		NewLambda("b", Natural, NewVar("b")),
+		false),
+	Entry("Identical remote imports",
+		Import{ImportHashed: ImportHashed{Fetchable: NewRemote(&url.URL{Scheme: "https", Host: "example.com", Path: "/foo"})}},
+		Import{ImportHashed: ImportHashed{Fetchable: NewRemote(&url.URL{Scheme: "https", Host: "example.com", Path: "/foo"})}},
+		true),
+	Entry("Terms containing NaN",
+		NewList(DoubleLit(math.NaN()), importFoo),
+		NewList(DoubleLit(math.NaN()), importFoo),
+		true),
+	Entry("Positive and negative zero",
+		DoubleLit(0), DoubleLit(math.Copysign(0, -1)),
+		false),
+	Entry("Different Doubles",
+		DoubleLit(1.5), DoubleLit(2.5),
+		false),
 )
@@ -1,7 +1,7 @@
 package core
 
 import (
-	"errors"
+	gocontext "context"
 	"fmt"
 	"sort"
 	"strings"
@@ -11,7 +11,192 @@ type Env map[string][]Value
 
 // Eval normalizes Term to a Value.
 func Eval(t Term) Value {
-	return evalWith(t, Env{}, false)
+	ev := &evaluator{}
+	return ev.evalData(t, Env{})
+}
+
+// TryEval is like Eval, but returns an error rather than panicking if
+// ∧ or ⩓ finds colliding fields which aren't records (a *MergeError).
+// Terms which typecheck don't have such collisions.
+func TryEval(t Term) (Value, error) {
+	return EvalOptions{}.TryEval(t)
+}
+
+// EvalContext is like TryEval, but also gives up once ctx is done,
+// returning ctx.Err().  It checks ctx on its way into each subterm
+// and at each step of Natural/fold and List/fold, so that a deadline
+// bounds the time spent on a huge fold.
+func EvalContext(ctx gocontext.Context, t Term) (Value, error) {
+	return EvalOptions{}.EvalContext(ctx, t)
+}
+
+// An evaluator holds the settings and state of one evaluation.
+// Lambdas it produces keep referring to it.
+type evaluator struct {
+	// alphaNormalize renames every bound variable to `_`.
+	alphaNormalize bool
+	// keepLets keeps the let bindings at the top of the Term, as
+	// for EvalOptions.KeepLets.
+	keepLets bool
+	// ctx, if not nil, is checked as evaluation proceeds.
+	ctx gocontext.Context
+	// mergeDefaultHandlers is EvalOptions.MergeDefaultHandlers.
+	mergeDefaultHandlers bool
+	// maxFoldSteps, if positive, limits foldSteps.
+	maxFoldSteps int
+	// foldSteps counts the fold steps taken so far.
+	foldSteps uint
+}
+
+// contextError is panicked with when an evaluator's context is done.
+type contextError struct{ err error }
+
+// check panics with a contextError if ev's context is done.
+func (ev *evaluator) check() {
+	if ev.ctx == nil {
+		return
+	}
+	select {
+	case <-ev.ctx.Done():
+		panic(contextError{ev.ctx.Err()})
+	default:
+	}
+}
+
+// finish is deferred by the entry points which return evaluation
+// errors rather than panicking.  It recovers those errors into *err,
+// and lifts ev's limits, since lambdas in the result still refer to
+// ev and mustn't fail when they are applied after we've returned.
+func (ev *evaluator) finish(err *error) {
+	ev.ctx = nil
+	ev.maxFoldSteps = 0
+	if r := recover(); r != nil {
+		switch r := r.(type) {
+		case contextError:
+			*err = r.err
+		case *FoldLimitError:
+			*err = r
+		case *MergeError:
+			*err = r
+		case *UnresolvedImportError:
+			*err = r
+		default:
+			panic(r)
+		}
+	}
+}
+
+// evalWith evaluates t in e, alpha-normalizing it if
+// shouldAlphaNormalize is set.
+func evalWith(t Term, e Env, shouldAlphaNormalize bool) Value {
+	ev := &evaluator{alphaNormalize: shouldAlphaNormalize}
+	return ev.eval(t, e)
+}
+
+// EvalOptions configures evaluation, and the evaluation the
+// typechecker does.  The zero value behaves the same as Eval.
+type EvalOptions struct {
+	// KeepLets, if set, keeps the let bindings at the top of the
+	// Term instead of substituting them into the body.  The bound
+	// values and the body are still normalized, so Quote gives back
+	// a let expression.  Lets anywhere else are inlined as usual.
+	// This is a lighter normalization, for tools such as formatters
+	// which want readable output.
+	KeepLets bool
+
+	// MaxFoldSteps, if positive, limits the total number of times
+	// Natural/fold and List/fold may apply their step functions
+	// within one call.  A call which would need more fails with a
+	// *FoldLimitError instead of grinding through it.
+	MaxFoldSteps int
+
+	// MergeDefaultHandlers enables a non-standard extension to
+	// merge: a handler named `_` (DefaultHandler) is used for every
+	// alternative which has no handler of its own.  The default
+	// handler is not applied to the alternative's payload, if any;
+	// it is the result of the merge as it is.  It is a type error
+	// for the union to have an alternative named `_`.  This is off
+	// by default, as standard Dhall rejects such merges.
+	MergeDefaultHandlers bool
+}
+
+func (o EvalOptions) evaluator() *evaluator {
+	return &evaluator{
+		keepLets:             o.KeepLets,
+		maxFoldSteps:         o.MaxFoldSteps,
+		mergeDefaultHandlers: o.MergeDefaultHandlers,
+	}
+}
+
+// Eval normalizes Term to a Value according to o.  Like Eval, it
+// panics on the errors TryEval returns.
+func (o EvalOptions) Eval(t Term) Value {
+	v, err := o.TryEval(t)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// TryEval is like o.Eval, but returns an error rather than panicking
+// if a fold exceeds MaxFoldSteps (a *FoldLimitError), if ∧ or ⩓
+// finds colliding fields which aren't records (a *MergeError), or if
+// t contains an import (an *UnresolvedImportError).
+func (o EvalOptions) TryEval(t Term) (v Value, err error) {
+	ev := o.evaluator()
+	defer ev.finish(&err)
+	return ev.evalTop(t, Env{}), nil
+}
+
+// EvalContext is like o.TryEval, but also gives up once ctx is done,
+// returning ctx.Err().
+func (o EvalOptions) EvalContext(ctx gocontext.Context, t Term) (v Value, err error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	ev := o.evaluator()
+	ev.ctx = ctx
+	defer ev.finish(&err)
+	return ev.evalTop(t, Env{}), nil
+}
+
+// normalize is Eval, by ev.
+func (ev *evaluator) normalize(t Term) Value {
+	return ev.evalData(t, Env{})
+}
+
+// evalTop evaluates t in e, keeping its top-level lets if
+// ev.keepLets is set.
+func (ev *evaluator) evalTop(t Term, e Env) Value {
+	if let, ok := t.(Let); ok && ev.keepLets {
+		return ev.evalLet(let.Bindings, let.Body, e)
+	}
+	return ev.eval(t, e)
+}
+
+// evalLet keeps the first of bindings as a letVal, whose body binds
+// the rest.
+func (ev *evaluator) evalLet(bindings []Binding, body Term, e Env) Value {
+	if len(bindings) == 0 {
+		return ev.evalTop(body, e)
+	}
+	b := bindings[0]
+	v := letVal{
+		Label: b.Variable,
+		Value: ev.eval(b.Value, e),
+		Body: func(x Value) Value {
+			newEnv := Env{}
+			for k, v := range e {
+				newEnv[k] = v
+			}
+			newEnv[b.Variable] = append([]Value{x}, newEnv[b.Variable]...)
+			return ev.evalLet(bindings[1:], body, newEnv)
+		},
+	}
+	if b.Annotation != nil {
+		v.Annotation = ev.eval(b.Annotation, e)
+	}
+	return v
 }
 
 // AlphaBetaEval alpha-beta-normalizes Term to a Value.
@@ -19,7 +204,64 @@ func AlphaBetaEval(t Term) Value {
 	return evalWith(t, Env{}, true)
 }
 
-func evalWith(t Term, e Env, shouldAlphaNormalize bool) Value {
+// evalData evaluates t in e, like eval, but takes a fast path
+// through data: lists, Optionals and records are walked directly, and
+// leaves which are certainly already in normal form, such as the
+// literals of a record fed back from a cached normal form, are
+// converted without evaluating them.  Only the rest of t is evaluated
+// as usual, so no part of t is walked twice.
+func (ev *evaluator) evalData(t Term, e Env) Value {
+	ev.check()
+	switch t := t.(type) {
+	case NonEmptyList:
+		list := make(NonEmptyListVal, len(t))
+		for i, item := range t {
+			list[i] = ev.evalData(item, e)
+		}
+		return list
+	case Some:
+		return SomeVal{ev.evalData(t.Val, e)}
+	case RecordType:
+		record := make(RecordTypeVal, len(t))
+		for k, field := range t {
+			record[k] = ev.evalData(field, e)
+		}
+		return record
+	case RecordLit:
+		record := make(RecordLitVal, len(t))
+		for k, field := range t {
+			record[k] = ev.evalData(field, e)
+		}
+		return record
+	}
+	if v, ok := normalLeaf(t); ok {
+		return v
+	}
+	return ev.eval(t, e)
+}
+
+// normalLeaf converts t directly to a Value if it is a literal or
+// type builtin, which is certainly already in normal form.  For
+// anything else it returns false and t must be evaluated as usual.
+func normalLeaf(t Term) (Value, bool) {
+	switch t := t.(type) {
+	case Universe, NaturalLit, IntegerLit, DoubleLit, BoolLit:
+		return t.(Value), true
+	case Builtin:
+		switch t {
+		case Bool, Natural, Integer, Double, Text:
+			return t, true
+		}
+	case TextLitTerm:
+		if len(t.Chunks) == 0 {
+			return TextLitVal{Suffix: t.Suffix}, true
+		}
+	}
+	return nil, false
+}
+
+func (ev *evaluator) eval(t Term, e Env) Value {
+	ev.check()
 	switch t := t.(type) {
 	case Universe:
 		return t
@@ -77,43 +319,45 @@ func evalWith(t Term, e Env, shouldAlphaNormalize bool) Value {
 		return e[t.Name][t.Index]
 	case localVar:
 		return t
+	case typedTerm:
+		return ev.evalData(t.Term, e)
 	case LambdaTerm:
 		v := LambdaValue{
 			Label:  t.Label,
-			Domain: evalWith(t.Type, e, shouldAlphaNormalize),
+			Domain: ev.eval(t.Type, e),
 			Fn: func(x Value) Value {
 				newEnv := Env{}
 				for k, v := range e {
 					newEnv[k] = v
 				}
 				newEnv[t.Label] = append([]Value{x}, newEnv[t.Label]...)
-				return evalWith(t.Body, newEnv, shouldAlphaNormalize)
+				return ev.eval(t.Body, newEnv)
 			},
 		}
-		if shouldAlphaNormalize {
+		if ev.alphaNormalize {
 			v.Label = "_"
 		}
 		return v
 	case PiTerm:
 		v := PiValue{
 			Label:  t.Label,
-			Domain: evalWith(t.Type, e, shouldAlphaNormalize),
+			Domain: ev.eval(t.Type, e),
 			Range: func(x Value) Value {
 				newEnv := Env{}
 				for k, v := range e {
 					newEnv[k] = v
 				}
 				newEnv[t.Label] = append([]Value{x}, newEnv[t.Label]...)
-				return evalWith(t.Body, newEnv, shouldAlphaNormalize)
+				return ev.eval(t.Body, newEnv)
 			}}
-		if shouldAlphaNormalize {
+		if ev.alphaNormalize {
 			v.Label = "_"
 		}
 		return v
 	case AppTerm:
-		fn := evalWith(t.Fn, e, shouldAlphaNormalize)
-		arg := evalWith(t.Arg, e, shouldAlphaNormalize)
-		return applyVal(fn, arg)
+		fn := ev.eval(t.Fn, e)
+		arg := ev.eval(t.Arg, e)
+		return ev.apply(fn, arg)
 	case Let:
 		newEnv := Env{}
 		for k, v := range e {
@@ -121,12 +365,12 @@ func evalWith(t Term, e Env, shouldAlphaNormalize bool) Value {
 		}
 
 		for _, b := range t.Bindings {
-			val := evalWith(b.Value, newEnv, shouldAlphaNormalize)
+			val := ev.eval(b.Value, newEnv)
 			newEnv[b.Variable] = append([]Value{val}, newEnv[b.Variable]...)
 		}
-		return evalWith(t.Body, newEnv, shouldAlphaNormalize)
+		return ev.eval(t.Body, newEnv)
 	case Annot:
-		return evalWith(t.Expr, e, shouldAlphaNormalize)
+		return ev.eval(t.Expr, e)
 	case DoubleLit:
 		return t
 	case TextLitTerm:
@@ -134,7 +378,7 @@ func evalWith(t Term, e Env, shouldAlphaNormalize bool) Value {
 		var newChunks ChunkVals
 		for _, chunk := range t.Chunks {
 			str.WriteString(chunk.Prefix)
-			normExpr := evalWith(chunk.Expr, e, shouldAlphaNormalize)
+			normExpr := ev.eval(chunk.Expr, e)
 			if text, ok := normExpr.(TextLitVal); ok {
 				if len(text.Chunks) != 0 {
 					// first chunk gets the rest of str
@@ -164,15 +408,15 @@ func evalWith(t Term, e Env, shouldAlphaNormalize bool) Value {
 	case BoolLit:
 		return t
 	case IfTerm:
-		condVal := evalWith(t.Cond, e, shouldAlphaNormalize)
+		condVal := ev.eval(t.Cond, e)
 		if condVal == True {
-			return evalWith(t.T, e, shouldAlphaNormalize)
+			return ev.eval(t.T, e)
 		}
 		if condVal == False {
-			return evalWith(t.F, e, shouldAlphaNormalize)
+			return ev.eval(t.F, e)
 		}
-		tVal := evalWith(t.T, e, shouldAlphaNormalize)
-		fVal := evalWith(t.F, e, shouldAlphaNormalize)
+		tVal := ev.eval(t.T, e)
+		fVal := ev.eval(t.F, e)
 		if tVal == True && fVal == False {
 			return condVal
 		}
@@ -181,8 +425,8 @@ func evalWith(t Term, e Env, shouldAlphaNormalize bool) Value {
 		}
 		return ifVal{
 			Cond: condVal,
-			T:    evalWith(t.T, e, shouldAlphaNormalize),
-			F:    evalWith(t.F, e, shouldAlphaNormalize),
+			T:    tVal,
+			F:    fVal,
 		}
 	case NaturalLit:
 		return t
@@ -192,11 +436,11 @@ func evalWith(t Term, e Env, shouldAlphaNormalize bool) Value {
 		// these are cases where we *don't* evaluate t.L and t.R up front
 		switch t.OpCode {
 		case TextAppendOp:
-			return evalWith(
+			return ev.eval(
 				TextLitTerm{Chunks: Chunks{{Expr: t.L}, {Expr: t.R}}},
-				e, shouldAlphaNormalize)
+				e)
 		case CompleteOp:
-			return evalWith(
+			return ev.eval(
 				Annot{
 					Expr: OpTerm{
 						OpCode: RightBiasedRecordMergeOp,
@@ -205,10 +449,10 @@ func evalWith(t Term, e Env, shouldAlphaNormalize bool) Value {
 					},
 					Annotation: Field{t.L, "Type"},
 				},
-				e, shouldAlphaNormalize)
+				e)
 		}
-		l := evalWith(t.L, e, shouldAlphaNormalize)
-		r := evalWith(t.R, e, shouldAlphaNormalize)
+		l := ev.eval(t.L, e)
+		r := ev.eval(t.R, e)
 		switch t.OpCode {
 		case OrOp, AndOp, EqOp, NeOp:
 			lb, lok := l.(BoolLit)
@@ -277,7 +521,11 @@ func evalWith(t Term, e Env, shouldAlphaNormalize bool) Value {
 			ll, lok := l.(NonEmptyListVal)
 			rl, rok := r.(NonEmptyListVal)
 			if lok && rok {
-				return append(ll, rl...)
+				// copy rather than append to ll, which may share
+				// its backing array with other Values
+				result := make(NonEmptyListVal, 0, len(ll)+len(rl))
+				result = append(result, ll...)
+				return append(result, rl...)
 			}
 		case PlusOp:
 			ln, lok := l.(NaturalLit)
@@ -310,18 +558,7 @@ func evalWith(t Term, e Env, shouldAlphaNormalize bool) Value {
 				return l
 			}
 		case RecordMergeOp:
-			lR, lOk := l.(RecordLitVal)
-			rR, rOk := r.(RecordLitVal)
-
-			if lOk && len(lR) == 0 {
-				return r
-			}
-			if rOk && len(rR) == 0 {
-				return l
-			}
-			if lOk && rOk {
-				return mustMergeRecordLitVals(lR, rR)
-			}
+			return recordMergeVal(l, r)
 		case RecordTypeMergeOp:
 			lRT, lOk := l.(RecordTypeVal)
 			rRT, rOk := r.(RecordTypeVal)
@@ -335,32 +572,12 @@ func evalWith(t Term, e Env, shouldAlphaNormalize bool) Value {
 			if lOk && rOk {
 				result, err := mergeRecordTypes(lRT, rRT)
 				if err != nil {
-					panic(err) // shouldn't happen for well-typed terms
+					panic(err) // a *MergeError; shouldn't happen for well-typed terms
 				}
 				return result
 			}
 		case RightBiasedRecordMergeOp:
-			lLit, lOk := l.(RecordLitVal)
-			rLit, rOk := r.(RecordLitVal)
-			if lOk && len(lLit) == 0 {
-				return r
-			}
-			if rOk && len(rLit) == 0 {
-				return l
-			}
-			if lOk && rOk {
-				result := RecordLitVal{}
-				for k, v := range lLit {
-					result[k] = v
-				}
-				for k, v := range rLit {
-					result[k] = v
-				}
-				return result
-			}
-			if judgmentallyEqualVals(l, r) {
-				return l
-			}
+			return rightBiasedMergeVal(l, r)
 		case ImportAltOp:
 			// nothing special
 		case EquivOp:
@@ -368,33 +585,33 @@ func evalWith(t Term, e Env, shouldAlphaNormalize bool) Value {
 		}
 		return opValue{OpCode: t.OpCode, L: l, R: r}
 	case EmptyList:
-		return EmptyListVal{Type: evalWith(t.Type, e, shouldAlphaNormalize)}
+		return EmptyListVal{Type: ev.eval(t.Type, e)}
 	case NonEmptyList:
 		result := make([]Value, len(t))
 		for i, t := range t {
-			result[i] = evalWith(t, e, shouldAlphaNormalize)
+			result[i] = ev.eval(t, e)
 		}
 		return NonEmptyListVal(result)
 	case Some:
-		return SomeVal{evalWith(t.Val, e, shouldAlphaNormalize)}
+		return SomeVal{ev.eval(t.Val, e)}
 	case RecordType:
 		newRT := RecordTypeVal{}
 		for k, v := range t {
-			newRT[k] = evalWith(v, e, shouldAlphaNormalize)
+			newRT[k] = ev.eval(v, e)
 		}
 		return newRT
 	case RecordLit:
 		newRT := RecordLitVal{}
 		for k, v := range t {
-			newRT[k] = evalWith(v, e, shouldAlphaNormalize)
+			newRT[k] = ev.eval(v, e)
 		}
 		return newRT
 	case ToMap:
-		recordVal := evalWith(t.Record, e, shouldAlphaNormalize)
+		recordVal := ev.eval(t.Record, e)
 		record, ok := recordVal.(RecordLitVal)
 		if ok {
 			if len(record) == 0 {
-				return EmptyListVal{Type: evalWith(t.Type, e, shouldAlphaNormalize)}
+				return EmptyListVal{Type: ev.eval(t.Type, e)}
 			}
 			fieldnames := []string{}
 			for k := range record {
@@ -407,12 +624,13 @@ func evalWith(t Term, e Env, shouldAlphaNormalize bool) Value {
 			}
 			return result
 		}
-		return toMapVal{
-			Record: record,
-			Type:   evalWith(t.Type, e, shouldAlphaNormalize),
+		output := toMapVal{Record: recordVal}
+		if t.Type != nil {
+			output.Type = ev.eval(t.Type, e)
 		}
+		return output
 	case Field:
-		record := evalWith(t.Record, e, shouldAlphaNormalize)
+		record := ev.eval(t.Record, e)
 		for { // simplifications
 			if proj, ok := record.(projectVal); ok {
 				record = proj.Record
@@ -482,71 +700,24 @@ func evalWith(t Term, e Env, shouldAlphaNormalize bool) Value {
 			FieldName: t.FieldName,
 		}
 	case Project:
-		record := evalWith(t.Record, e, shouldAlphaNormalize)
-		fieldNames := t.FieldNames
+		record := ev.eval(t.Record, e)
+		fieldNames := append([]string{}, t.FieldNames...)
 		sort.Strings(fieldNames)
-		// simplifications
-		for {
-			if proj, ok := record.(projectVal); ok {
-				record = proj.Record
-				continue
-			}
-			op, ok := record.(opValue)
-			if ok && op.OpCode == RightBiasedRecordMergeOp {
-				if r, ok := op.R.(RecordLitVal); ok {
-					notOverridden := []string{}
-					overrides := RecordLitVal{}
-					for _, fieldName := range fieldNames {
-						if override, ok := r[fieldName]; ok {
-							overrides[fieldName] = override
-						} else {
-							notOverridden = append(notOverridden, fieldName)
-						}
-					}
-					if len(notOverridden) == 0 {
-						return overrides
-					}
-					return opValue{
-						OpCode: RightBiasedRecordMergeOp,
-						L: projectVal{
-							Record:     op.L,
-							FieldNames: notOverridden,
-						},
-						R: overrides,
-					}
-				}
-			}
-
-			break
-		}
-		if lit, ok := record.(RecordLitVal); ok {
-			result := make(RecordLitVal)
-			for _, k := range fieldNames {
-				result[k] = lit[k]
-			}
-			return result
-		}
-		if len(fieldNames) == 0 {
-			return RecordLitVal{}
-		}
-		return projectVal{
-			Record:     record,
-			FieldNames: fieldNames,
-		}
+		return projectValue(record, fieldNames)
 	case ProjectType:
 		// if `t` typechecks, `t.Selector` has to eval to a
 		// RecordTypeVal, so this is safe
-		s := evalWith(t.Selector, e, shouldAlphaNormalize).(RecordTypeVal)
+		s := ev.eval(t.Selector, e).(RecordTypeVal)
 		fieldNames := make([]string, 0, len(s))
 		for fieldName := range s {
 			fieldNames = append(fieldNames, fieldName)
 		}
-		return evalWith(
+		return ev.eval(
 			Project{
 				Record:     t.Record,
 				FieldNames: fieldNames,
 			},
-			e, shouldAlphaNormalize)
+			e)
 	case UnionType:
 		result := make(unionTypeVal, len(t))
 		for k, v := range t {
@@ -554,25 +725,42 @@ func evalWith(t Term, e Env, shouldAlphaNormalize bool) Value {
 				result[k] = nil
 				continue
 			}
-			result[k] = evalWith(v, e, shouldAlphaNormalize)
+			result[k] = ev.eval(v, e)
 		}
 		return result
 	case Merge:
-		handlerVal := evalWith(t.Handler, e, shouldAlphaNormalize)
-		unionVal := evalWith(t.Union, e, shouldAlphaNormalize)
+		handlerVal := ev.eval(t.Handler, e)
+		unionVal := ev.eval(t.Union, e)
 		if handlers, ok := handlerVal.(RecordLitVal); ok {
 			// TODO: test tricky Field inputs
 			if union, ok := unionVal.(AppValue); ok {
 				if field, ok := union.Fn.(fieldVal); ok {
-					return applyVal(
-						handlers[field.FieldName],
-						union.Arg,
-					)
+					if handler, ok := handlers[field.FieldName]; ok {
+						return ev.apply(handler, union.Arg)
+					}
+					if handler, ok := ev.defaultHandler(handlers); ok {
+						return handler
+					}
+				}
+			}
+			if some, ok := unionVal.(SomeVal); ok {
+				if handler, ok := handlers["Some"]; ok {
+					return ev.apply(handler, some.Val)
+				}
+			}
+			if none, ok := unionVal.(AppValue); ok && none.Fn == None {
+				if handler, ok := handlers["None"]; ok {
+					return handler
 				}
 			}
 			if union, ok := unionVal.(fieldVal); ok {
 				// empty union alternative
-				return handlers[union.FieldName]
+				if handler, ok := handlers[union.FieldName]; ok {
+					return handler
+				}
+				if handler, ok := ev.defaultHandler(handlers); ok {
+					return handler
+				}
 			}
 		}
 		output := mergeVal{
@@ -580,16 +768,125 @@ func evalWith(t Term, e Env, shouldAlphaNormalize bool) Value {
 			Union:   unionVal,
 		}
 		if t.Annotation != nil {
-			output.Annotation = evalWith(t.Annotation, e, shouldAlphaNormalize)
+			output.Annotation = ev.eval(t.Annotation, e)
 		}
 		return output
 	case Assert:
-		return assertVal{Annotation: evalWith(t.Annotation, e, shouldAlphaNormalize)}
+		return assertVal{Annotation: ev.eval(t.Annotation, e)}
+	case Import:
+		panic(&UnresolvedImportError{Import: t.Fetchable})
 	default:
 		panic(fmt.Sprint("unknown term type", t))
 	}
 }
 
+// DefaultHandler is the name of the handler used by merge for
+// alternatives without a handler of their own, when
+// EvalOptions.MergeDefaultHandlers is set.
+const DefaultHandler = "_"
+
+// defaultHandler returns the default handler among handlers, if ev
+// has the extension enabled.
+func (ev *evaluator) defaultHandler(handlers RecordLitVal) (Value, bool) {
+	if !ev.mergeDefaultHandlers {
+		return nil, false
+	}
+	handler, ok := handlers[DefaultHandler]
+	return handler, ok
+}
+
+// projectValue projects the sorted fieldNames out of record,
+// simplifying the projection where the standard allows.
+func projectValue(record Value, fieldNames []string) Value {
+	for { // simplifications
+		if proj, ok := record.(projectVal); ok {
+			record = proj.Record
+			continue
+		}
+		op, ok := record.(opValue)
+		if ok && op.OpCode == RightBiasedRecordMergeOp {
+			if r, ok := op.R.(RecordLitVal); ok {
+				notOverridden := []string{}
+				overrides := RecordLitVal{}
+				for _, fieldName := range fieldNames {
+					if override, ok := r[fieldName]; ok {
+						overrides[fieldName] = override
+					} else {
+						notOverridden = append(notOverridden, fieldName)
+					}
+				}
+				if len(notOverridden) == 0 {
+					return overrides
+				}
+				// the left side may simplify further, eg
+				// (e.{ a, b } ⫽ { c = 1 }).{ a, c }
+				return rightBiasedMergeVal(projectValue(op.L, notOverridden), overrides)
+			}
+		}
+		break
+	}
+	if lit, ok := record.(RecordLitVal); ok {
+		result := make(RecordLitVal)
+		for _, k := range fieldNames {
+			result[k] = lit[k]
+		}
+		return result
+	}
+	if len(fieldNames) == 0 {
+		return RecordLitVal{}
+	}
+	return projectVal{
+		Record:     record,
+		FieldNames: fieldNames,
+	}
+}
+
+// apply is like applyVal, except that Natural/fold and List/fold
+// apply their step functions through ev, so that ev checks its
+// context between steps.
+func (ev *evaluator) apply(fn Value, args ...Value) Value {
+	out := fn
+	for _, arg := range args {
+		var result Value
+		switch f := out.(type) {
+		case naturalFoldVal:
+			if f.succ != nil {
+				if n, ok := f.n.(NaturalLit); ok {
+					ev.spendFoldSteps(NaturalFold, uint(n))
+				}
+				result = f.fold(arg, ev.step)
+			}
+		case listFoldVal:
+			if f.cons != nil {
+				if list, ok := f.list.(NonEmptyListVal); ok {
+					ev.spendFoldSteps(ListFold, uint(len(list)))
+				}
+				result = f.fold(arg, ev.step)
+			}
+		}
+		if result == nil {
+			result = applyVal(out, arg)
+		}
+		out = result
+	}
+	return out
+}
+
+// spendFoldSteps counts the steps of a fold against ev's limit,
+// panicking with a *FoldLimitError if they would exceed it.
+func (ev *evaluator) spendFoldSteps(fold Builtin, steps uint) {
+	ev.foldSteps += steps
+	if ev.maxFoldSteps > 0 && ev.foldSteps > uint(ev.maxFoldSteps) {
+		panic(&FoldLimitError{Fold: fold, Steps: ev.foldSteps, Limit: ev.maxFoldSteps})
+	}
+}
+
+// step applies one step of a fold, once ev's context isn't done.
+func (ev *evaluator) step(fn Value, args ...Value) Value {
+	ev.check()
+	return ev.apply(fn, args...)
+}
+
 func applyVal(fn Value, args ...Value) Value {
 	out := fn
 	for _, arg := range args {
@@ -604,6 +901,71 @@ func applyVal(fn Value, args ...Value) Value {
 	return out
 }
 
+// A MergeError reports a field which ∧ or ⩓ can't combine, because
+// it is present on both sides but isn't a record on both.
+type MergeError struct {
+	// Path is the names of the fields leading to the collision,
+	// outermost first.
+	Path []string
+	// Left and Right describe the two colliding fields, such as
+	// "Natural" or "record".
+	Left, Right string
+}
+
+func (e *MergeError) Error() string {
+	return fmt.Sprintf("can't merge field %s: %s collides with %s",
+		strings.Join(e.Path, "."), e.Left, e.Right)
+}
+
+// An UnresolvedImportError is the error for evaluating or
+// typechecking a Term which still contains an import.
+type UnresolvedImportError struct {
+	Import Fetchable
+}
+
+func (e *UnresolvedImportError) Error() string {
+	return fmt.Sprintf("unresolved import %v", e.Import)
+}
+
+// mergeKind describes v for a MergeError.
+func mergeKind(v Value) string {
+	switch v.(type) {
+	case RecordLitVal:
+		return "record"
+	case RecordTypeVal:
+		return "record type"
+	case NaturalLit:
+		return "Natural"
+	case IntegerLit:
+		return "Integer"
+	case DoubleLit:
+		return "Double"
+	case TextLitVal:
+		return "Text"
+	case BoolLit:
+		return "Bool"
+	case EmptyListVal, NonEmptyListVal:
+		return "List"
+	case SomeVal:
+		return "Optional"
+	case LambdaValue:
+		return "function"
+	}
+	return fmt.Sprint(Quote(v))
+}
+
+// isRecordLike reports whether v is a record literal, or a neutral
+// term which may turn out to be one.
+func isRecordLike(v Value) bool {
+	switch v.(type) {
+	case RecordTypeVal, NaturalLit, IntegerLit, DoubleLit, TextLitVal,
+		BoolLit, EmptyListVal, NonEmptyListVal, SomeVal, LambdaValue,
+		PiValue, Universe, Builtin:
+		return false
+	}
+	return true
+}
+
 func mergeRecordTypes(l RecordTypeVal, r RecordTypeVal) (RecordTypeVal, error) {
 	var err error
 	result := make(RecordTypeVal)
@@ -615,11 +977,13 @@ func mergeRecordTypes(l RecordTypeVal, r RecordTypeVal) (RecordTypeVal, error) {
 			lSubrecord, Lok := lField.(RecordTypeVal)
 			rSubrecord, Rok := v.(RecordTypeVal)
 			if !(Lok && Rok) {
-				return nil, errors.New("Record mismatch")
+				return nil, &MergeError{Path: []string{k}, Left: mergeKind(lField), Right: mergeKind(v)}
 			}
 			result[k], err = mergeRecordTypes(lSubrecord, rSubrecord)
 			if err != nil {
-				return nil, err
+				mergeErr := err.(*MergeError)
+				mergeErr.Path = append([]string{k}, mergeErr.Path...)
+				return nil, mergeErr
 			}
 		} else {
 			result[k] = v
@@ -628,23 +992,80 @@ func mergeRecordTypes(l RecordTypeVal, r RecordTypeVal) (RecordTypeVal, error) {
 	return result, nil
 }
 
-func mustMergeRecordLitVals(l RecordLitVal, r RecordLitVal) RecordLitVal {
+// rightBiasedMergeVal returns the value of l ⫽ r.
+func rightBiasedMergeVal(l Value, r Value) Value {
+	lLit, lOk := l.(RecordLitVal)
+	rLit, rOk := r.(RecordLitVal)
+	if lOk && len(lLit) == 0 {
+		return r
+	}
+	if rOk && len(rLit) == 0 {
+		return l
+	}
+	if lOk && rOk {
+		result := RecordLitVal{}
+		for k, v := range lLit {
+			result[k] = v
+		}
+		for k, v := range rLit {
+			result[k] = v
+		}
+		return result
+	}
+	if judgmentallyEqualVals(l, r) {
+		return l
+	}
+	return opValue{OpCode: RightBiasedRecordMergeOp, L: l, R: r}
+}
+
+// recordMergeVal returns the value of l ∧ r.  It panics with a
+// *MergeError if they have colliding fields which aren't records.
+func recordMergeVal(l Value, r Value) Value {
+	lR, lOk := l.(RecordLitVal)
+	rR, rOk := r.(RecordLitVal)
+
+	if lOk && len(lR) == 0 {
+		return r
+	}
+	if rOk && len(rR) == 0 {
+		return l
+	}
+	if lOk && rOk {
+		return mergeRecordLitVals(lR, rR)
+	}
+	return opValue{OpCode: RecordMergeOp, L: l, R: r}
+}
+
+func mergeRecordLitVals(l RecordLitVal, r RecordLitVal) RecordLitVal {
 	output := make(RecordLitVal)
 	for k, v := range l {
 		output[k] = v
 	}
 	for k, v := range r {
 		if lField, ok := output[k]; ok {
-			lSubrecord, Lok := lField.(RecordLitVal)
-			rSubrecord, Rok := v.(RecordLitVal)
-			if !(Lok && Rok) {
-				// typecheck ought to have caught this
-				panic("Record mismatch")
+			if !isRecordLike(lField) || !isRecordLike(v) {
+				panic(&MergeError{Path: []string{k}, Left: mergeKind(lField), Right: mergeKind(v)})
 			}
-			output[k] = mustMergeRecordLitVals(lSubrecord, rSubrecord)
+			// colliding fields may be neutral, eg
+			// λ(x : { b : Natural }) → { a = x } ∧ { a = { c = 1 } }
+			output[k] = mergeFieldVals(k, lField, v)
 		} else {
 			output[k] = v
 		}
 	}
 	return output
 }
+
+// mergeFieldVals merges the values of field k of two records,
+// prefixing k to the Path of any MergeError from deeper down.
+func mergeFieldVals(k string, l Value, r Value) (result Value) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			if mergeErr, ok := rec.(*MergeError); ok {
+				mergeErr.Path = append([]string{k}, mergeErr.Path...)
+			}
+			panic(rec)
+		}
+	}()
+	return recordMergeVal(l, r)
+}
@@ -1,10 +1,21 @@
 package core
 
 import (
+	gocontext "context"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
 	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 )
 
+func evalAndCompare(in Term, expected Term) {
+	Expect(Quote(Eval(in))).To(Equal(expected))
+}
+
 var _ = Describe("Eval", func() {
 	It("Type", func() {
 		Expect(Eval(Type)).To(Equal(Type))
@@ -31,6 +42,31 @@ var _ = Describe("Eval", func() {
 		Expect(f.Label).
 			To(Equal("_"))
 	})
+	DescribeTable("fast path through data",
+		func(t Term) {
+			Expect(Eval(t)).To(Equal(evalWith(t, Env{}, false)))
+		},
+		Entry("Natural literal", NaturalLit(3)),
+		Entry("Text literal", TextLitTerm{Suffix: "foo"}),
+		Entry("type builtin", Natural),
+		Entry("record of literals", RecordLit{"a": NaturalLit(1), "b": Some{True}}),
+		Entry("record type", RecordType{"a": Natural, "b": Text}),
+		Entry("list of records", NewList(RecordLit{"a": DoubleLit(1.5)}, RecordLit{"a": DoubleLit(2)})),
+		Entry("large record", largeNormalRecord(50)),
+		Entry("record with a redex", RecordLit{"a": NaturalLit(1), "b": NaturalPlus(NaturalLit(1), NaturalLit(2))}),
+		Entry("list with a redex", NewList(NaturalLit(1), NaturalPlus(NaturalLit(1), NaturalLit(2)))),
+		Entry("Optional with a redex", Some{NaturalPlus(NaturalLit(1), NaturalLit(2))}),
+	)
+	DescribeTable("no fast path for leaves which may not be normal",
+		func(t Term) {
+			_, ok := normalLeaf(t)
+			Expect(ok).To(BeFalse())
+		},
+		Entry("free variable", x),
+		Entry("interpolated Text", TextLitTerm{Chunks: Chunks{{Expr: x}}}),
+		Entry("function builtin", NaturalFold),
+		Entry("operator", NaturalPlus(NaturalLit(1), NaturalLit(2))),
+	)
 	Describe("application", func() {
 		It("To neutral", func() {
 			Expect(Eval(Apply(Var{Name: "f"}, Var{Name: "x"}))).
@@ -44,4 +80,652 @@ var _ = Describe("Eval", func() {
 				To(Equal(Type))
 		})
 	})
+	DescribeTable("toMap of neutral record", evalAndCompare,
+		Entry("toMap x", ToMap{Record: x}, ToMap{Record: x}),
+		Entry("toMap x : List {mapKey : Text, mapValue : Natural}",
+			ToMap{
+				Record: x,
+				Type:   Apply(List, RecordType{"mapKey": Text, "mapValue": Natural}),
+			},
+			ToMap{
+				Record: x,
+				Type:   Apply(List, RecordType{"mapKey": Text, "mapValue": Natural}),
+			}),
+	)
+	DescribeTable("toMap of empty record", evalAndCompare,
+		Entry("toMap {=} : List {mapKey : Text, mapValue : Bool}",
+			ToMap{
+				Record: RecordLit{},
+				Type:   Apply(List, RecordType{"mapKey": Text, "mapValue": Bool}),
+			},
+			EmptyList{Apply(List, RecordType{"mapKey": Text, "mapValue": Bool})}),
+		Entry("λ(T : Type) → toMap {=} : List {mapKey : Text, mapValue : T}",
+			NewLambda("T", Type, ToMap{
+				Record: RecordLit{},
+				Type:   Apply(List, RecordType{"mapKey": Text, "mapValue": NewVar("T")}),
+			}),
+			NewLambda("T", Type,
+				EmptyList{Apply(List, RecordType{"mapKey": Text, "mapValue": NewVar("T")})})),
+	)
+})
+
+var x = NewVar("x")
+
+var mergeHandlers = RecordLit{
+	"A": NewLambda("n", Natural, NaturalPlus(NewVar("n"), NaturalLit(1))),
+	"B": NaturalLit(0),
+}
+var mergeUnion = UnionType{"A": Natural, "B": nil}
+var optionalHandlers = RecordLit{
+	"None": NaturalLit(0),
+	"Some": NewLambda("n", Natural, NewVar("n")),
+}
+
+var _ = DescribeTable("Integer builtins", evalAndCompare,
+	Entry(`Integer/show +12`, Apply(IntegerShow, IntegerLit(12)), TextLitTerm{Suffix: "+12"}),
+	Entry(`Integer/show -12`, Apply(IntegerShow, IntegerLit(-12)), TextLitTerm{Suffix: "-12"}),
+	Entry(`Integer/show +0`, Apply(IntegerShow, IntegerLit(0)), TextLitTerm{Suffix: "+0"}),
+	Entry(`Integer/show -9223372036854775808`,
+		Apply(IntegerShow, IntegerLit(math.MinInt64)), TextLitTerm{Suffix: "-9223372036854775808"}),
+	Entry(`Integer/show (Natural/toInteger 12)`,
+		Apply(IntegerShow, Apply(NaturalToInteger, NaturalLit(12))), TextLitTerm{Suffix: "+12"}),
+	Entry(`Integer/show x`, Apply(IntegerShow, x), Apply(IntegerShow, x)),
+	Entry(`Integer/toDouble +12`, Apply(IntegerToDouble, IntegerLit(12)), DoubleLit(12)),
+	Entry(`Integer/toDouble -12`, Apply(IntegerToDouble, IntegerLit(-12)), DoubleLit(-12)),
+	Entry(`Integer/toDouble +9007199254740993 -- rounds to nearest`,
+		Apply(IntegerToDouble, IntegerLit(9007199254740993)), DoubleLit(9007199254740992)),
+	Entry(`Integer/toDouble x`, Apply(IntegerToDouble, x), Apply(IntegerToDouble, x)),
+)
+
+var _ = DescribeTable("Double/show", evalAndCompare,
+	Entry(`Double/show 1.5`, Apply(DoubleShow, DoubleLit(1.5)), TextLitTerm{Suffix: "1.5"}),
+	Entry(`Double/show 2.0`, Apply(DoubleShow, DoubleLit(2)), TextLitTerm{Suffix: "2.0"}),
+	Entry(`Double/show 1e100 -- no .0 after an exponent`,
+		Apply(DoubleShow, DoubleLit(1e100)), TextLitTerm{Suffix: "1e+100"}),
+)
+
+var _ = DescribeTable("Merge", evalAndCompare,
+	Entry(`merge handlers (< A : Natural | B >.A 2)`,
+		Merge{Handler: mergeHandlers, Union: Apply(Field{mergeUnion, "A"}, NaturalLit(2))},
+		NaturalLit(3)),
+	Entry(`merge handlers < A : Natural | B >.B`,
+		Merge{Handler: mergeHandlers, Union: Field{mergeUnion, "B"}},
+		NaturalLit(0)),
+	Entry(`merge handlers x -- neutral scrutinee`,
+		Merge{Handler: mergeHandlers, Union: x},
+		Merge{Handler: mergeHandlers, Union: x}),
+	Entry(`merge handlers x : Natural -- keeps annotation`,
+		Merge{Handler: mergeHandlers, Union: x, Annotation: Natural},
+		Merge{Handler: mergeHandlers, Union: x, Annotation: Natural}),
+	Entry(`merge handlers x : (λ(t : Type) → t) Natural -- normalizes annotation`,
+		Merge{Handler: mergeHandlers, Union: x,
+			Annotation: Apply(NewLambda("t", Type, NewVar("t")), Natural)},
+		Merge{Handler: mergeHandlers, Union: x, Annotation: Natural}),
+	Entry(`merge handlers (< A : Natural | B >.A 2) : Natural -- drops annotation`,
+		Merge{Handler: mergeHandlers, Union: Apply(Field{mergeUnion, "A"}, NaturalLit(2)), Annotation: Natural},
+		NaturalLit(3)),
+	Entry(`merge { None = 0, Some = λ(n : Natural) → n } (Some 5)`,
+		Merge{Handler: optionalHandlers, Union: Some{NaturalLit(5)}},
+		NaturalLit(5)),
+	Entry(`merge { None = 0, Some = λ(n : Natural) → n } (None Natural)`,
+		Merge{Handler: optionalHandlers, Union: Apply(None, Natural)},
+		NaturalLit(0)),
+	Entry(`merge { None = 0, Some = λ(n : Natural) → n } (Some x)`,
+		Merge{Handler: optionalHandlers, Union: Some{x}},
+		x),
+)
+
+func text(chunks Chunks, suffix string) TextLitTerm {
+	return TextLitTerm{Chunks: chunks, Suffix: suffix}
+}
+
+var _ = DescribeTable("Text literals", evalAndCompare,
+	Entry(`"${x}"`, text(Chunks{{Expr: x}}, ""), x),
+	Entry(`"${"${x}"}"`, text(Chunks{{Expr: text(Chunks{{Expr: x}}, "")}}, ""), x),
+	Entry(`"${""}${x}"`, text(Chunks{{Expr: text(nil, "")}, {Expr: x}}, ""), x),
+	Entry(`"a${"b"}c"`,
+		text(Chunks{{Prefix: "a", Expr: text(nil, "b")}}, "c"),
+		text(nil, "abc")),
+	Entry(`"${x}${y}"`,
+		text(Chunks{{Expr: x}, {Expr: NewVar("y")}}, ""),
+		text(Chunks{{Expr: x}, {Expr: NewVar("y")}}, "")),
+	Entry(`"a${x}b${"c"}d"`,
+		text(Chunks{{Prefix: "a", Expr: x}, {Prefix: "b", Expr: text(nil, "c")}}, "d"),
+		text(Chunks{{Prefix: "a", Expr: x}}, "bcd")),
+	Entry(`"a${"b${x}c"}d"`,
+		text(Chunks{{Prefix: "a", Expr: text(Chunks{{Prefix: "b", Expr: x}}, "c")}}, "d"),
+		text(Chunks{{Prefix: "ab", Expr: x}}, "cd")),
+	Entry(`"a${"b${x}c${y}d"}e${z}f"`,
+		text(Chunks{
+			{Prefix: "a", Expr: text(Chunks{{Prefix: "b", Expr: x}, {Prefix: "c", Expr: NewVar("y")}}, "d")},
+			{Prefix: "e", Expr: NewVar("z")},
+		}, "f"),
+		text(Chunks{{Prefix: "ab", Expr: x}, {Prefix: "c", Expr: NewVar("y")}, {Prefix: "de", Expr: NewVar("z")}}, "f")),
+)
+
+// These are the shapes that the remoteSystems normalization test
+// exercises: Text built up inside a List/fold over a neutral list.
+var concatText = NewLambda("a", Text, NewLambda("b", Text,
+	TextAppend(NewVar("a"), TextAppend(text(nil, "\n"), NewVar("b")))))
+
+var _ = DescribeTable("Text under List/fold", evalAndCompare,
+	Entry(`List/fold Text x Text (λ(a : Text) → λ(b : Text) → a ++ "\n" ++ b) ""`,
+		Apply(ListFold, Text, x, Text, concatText, text(nil, "")),
+		Apply(ListFold, Text, x, Text,
+			NewLambda("a", Text, NewLambda("b", Text,
+				text(Chunks{{Expr: NewVar("a")}, {Prefix: "\n", Expr: NewVar("b")}}, ""))),
+			text(nil, ""))),
+	Entry(`List/fold Text [ "a", "b" ] Text (λ(a : Text) → λ(b : Text) → a ++ "\n" ++ b) ""`,
+		Apply(ListFold, Text, NewList(text(nil, "a"), text(nil, "b")), Text, concatText, text(nil, "")),
+		text(nil, "a\nb\n")),
+	Entry(`List/fold Text [ x ] Text (λ(a : Text) → λ(b : Text) → a ++ "\n" ++ b) ""`,
+		Apply(ListFold, Text, NewList(x), Text, concatText, text(nil, "")),
+		text(Chunks{{Expr: x}}, "\n")),
+	Entry(`"${Natural/show x.cores} ${merge { A = "a", B = "b" } x.platform}"`,
+		text(Chunks{
+			{Expr: Apply(NaturalShow, Field{Record: x, FieldName: "cores"})},
+			{Prefix: " ", Expr: Merge{
+				Handler: RecordLit{"A": text(nil, "a"), "B": text(nil, "b")},
+				Union:   Field{Record: x, FieldName: "platform"},
+			}},
+		}, ""),
+		text(Chunks{
+			{Expr: Apply(NaturalShow, Field{Record: x, FieldName: "cores"})},
+			{Prefix: " ", Expr: Merge{
+				Handler: RecordLit{"A": text(nil, "a"), "B": text(nil, "b")},
+				Union:   Field{Record: x, FieldName: "platform"},
+			}},
+		}, "")),
+)
+
+var _ = DescribeTable("Operators", evalAndCompare,
+	Entry(`x || True`, BoolOr(x, True), True),
+	Entry(`True || x`, BoolOr(True, x), True),
+	Entry(`x || False`, BoolOr(x, False), x),
+	Entry(`False || x`, BoolOr(False, x), x),
+	Entry(`x || x`, BoolOr(x, x), x),
+	Entry(`x && True`, BoolAnd(x, True), x),
+	Entry(`True && x`, BoolAnd(True, x), x),
+	Entry(`x && False`, BoolAnd(x, False), False),
+	Entry(`False && x`, BoolAnd(False, x), False),
+	Entry(`x && x`, BoolAnd(x, x), x),
+	Entry(`x == True`, OpTerm{OpCode: EqOp, L: x, R: True}, x),
+	Entry(`True == x`, OpTerm{OpCode: EqOp, L: True, R: x}, x),
+	Entry(`x == x`, OpTerm{OpCode: EqOp, L: x, R: x}, True),
+	Entry(`x != False`, OpTerm{OpCode: NeOp, L: x, R: False}, x),
+	Entry(`False != x`, OpTerm{OpCode: NeOp, L: False, R: x}, x),
+	Entry(`x != x`, OpTerm{OpCode: NeOp, L: x, R: x}, False),
+	Entry(`x + 0`, NaturalPlus(x, NaturalLit(0)), x),
+	Entry(`0 + x`, NaturalPlus(NaturalLit(0), x), x),
+	Entry(`2 + 3`, NaturalPlus(NaturalLit(2), NaturalLit(3)), NaturalLit(5)),
+	Entry(`x * 0`, NaturalTimes(x, NaturalLit(0)), NaturalLit(0)),
+	Entry(`0 * x`, NaturalTimes(NaturalLit(0), x), NaturalLit(0)),
+	Entry(`x * 1`, NaturalTimes(x, NaturalLit(1)), x),
+	Entry(`1 * x`, NaturalTimes(NaturalLit(1), x), x),
+	Entry(`x ++ ""`, TextAppend(x, TextLitTerm{}), x),
+	Entry(`"" ++ x`, TextAppend(TextLitTerm{}, x), x),
+	Entry(`"a" ++ "b"`,
+		TextAppend(TextLitTerm{Suffix: "a"}, TextLitTerm{Suffix: "b"}),
+		TextLitTerm{Suffix: "ab"}),
+	Entry(`x # ([] : List Natural)`,
+		ListAppend(x, EmptyList{Apply(List, Natural)}), x),
+	Entry(`([] : List Natural) # x`,
+		ListAppend(EmptyList{Apply(List, Natural)}, x), x),
+	Entry(`[1] # [2]`,
+		ListAppend(NewList(NaturalLit(1)), NewList(NaturalLit(2))),
+		NewList(NaturalLit(1), NaturalLit(2))),
+	Entry(`let ys = [1, 2] # [3] in [ys # [4], ys # [5]] -- appends don't share storage`,
+		NewLet(
+			NewList(
+				ListAppend(NewVar("ys"), NewList(NaturalLit(4))),
+				ListAppend(NewVar("ys"), NewList(NaturalLit(5))),
+			),
+			Binding{
+				Variable: "ys",
+				Value: ListAppend(
+					NewList(NaturalLit(1), NaturalLit(2)),
+					NewList(NaturalLit(3))),
+			}),
+		NewList(
+			NewList(NaturalLit(1), NaturalLit(2), NaturalLit(3), NaturalLit(4)),
+			NewList(NaturalLit(1), NaturalLit(2), NaturalLit(3), NaturalLit(5)),
+		)),
+	Entry(`([] : List Natural) # ([] : List Natural)`,
+		ListAppend(EmptyList{Apply(List, Natural)}, EmptyList{Apply(List, Natural)}),
+		EmptyList{Apply(List, Natural)}),
+	Entry(`([1] # [2]) # [3]`,
+		ListAppend(ListAppend(NewList(NaturalLit(1)), NewList(NaturalLit(2))), NewList(NaturalLit(3))),
+		NewList(NaturalLit(1), NaturalLit(2), NaturalLit(3))),
+	Entry(`[1] # (([] : List Natural) # [2])`,
+		ListAppend(NewList(NaturalLit(1)), ListAppend(EmptyList{Apply(List, Natural)}, NewList(NaturalLit(2)))),
+		NewList(NaturalLit(1), NaturalLit(2))),
+	Entry(`(x # [1]) # ([] : List Natural)`,
+		ListAppend(ListAppend(x, NewList(NaturalLit(1))), EmptyList{Apply(List, Natural)}),
+		ListAppend(x, NewList(NaturalLit(1)))),
+	Entry(`[] : List ((λ(a : Type) → a) Natural) -- element type is normalized`,
+		EmptyList{Apply(List, Apply(NewLambda("a", Type, NewVar("a")), Natural))},
+		EmptyList{Apply(List, Natural)}),
+	Entry(`List/length Natural (([] : List Natural) # [1, 2])`,
+		Apply(ListLength, Natural, ListAppend(EmptyList{Apply(List, Natural)}, NewList(NaturalLit(1), NaturalLit(2)))),
+		NaturalLit(2)),
+	Entry(`List/head Natural ([] # [])`,
+		Apply(ListHead, Natural, ListAppend(EmptyList{Apply(List, Natural)}, EmptyList{Apply(List, Natural)})),
+		Apply(None, Natural)),
+	Entry(`x ∧ {=}`, OpTerm{OpCode: RecordMergeOp, L: x, R: RecordLit{}}, x),
+	Entry(`{ a = { b = 1 } } ∧ { a = { c = 2 } }`,
+		OpTerm{OpCode: RecordMergeOp,
+			L: RecordLit{"a": RecordLit{"b": NaturalLit(1)}},
+			R: RecordLit{"a": RecordLit{"c": NaturalLit(2)}}},
+		RecordLit{"a": RecordLit{"b": NaturalLit(1), "c": NaturalLit(2)}}),
+	Entry(`{ a = x } ∧ { a = { c = 1 } } -- neutral colliding field`,
+		OpTerm{OpCode: RecordMergeOp,
+			L: RecordLit{"a": x},
+			R: RecordLit{"a": RecordLit{"c": NaturalLit(1)}}},
+		RecordLit{"a": OpTerm{OpCode: RecordMergeOp, L: x, R: RecordLit{"c": NaturalLit(1)}}}),
+	Entry(`{ a = { b = x } } ∧ { a = { b = {=} } }`,
+		OpTerm{OpCode: RecordMergeOp,
+			L: RecordLit{"a": RecordLit{"b": x}},
+			R: RecordLit{"a": RecordLit{"b": RecordLit{}}}},
+		RecordLit{"a": RecordLit{"b": x}}),
+	Entry(`{=} ∧ x`, OpTerm{OpCode: RecordMergeOp, L: RecordLit{}, R: x}, x),
+	Entry(`x ⫽ {=}`, OpTerm{OpCode: RightBiasedRecordMergeOp, L: x, R: RecordLit{}}, x),
+	Entry(`{=} ⫽ x`, OpTerm{OpCode: RightBiasedRecordMergeOp, L: RecordLit{}, R: x}, x),
+	Entry(`x ⫽ x`, OpTerm{OpCode: RightBiasedRecordMergeOp, L: x, R: x}, x),
+	Entry(`{ a = 1, b = 2 } ⫽ { a = 3 }`,
+		OpTerm{OpCode: RightBiasedRecordMergeOp,
+			L: RecordLit{"a": NaturalLit(1), "b": NaturalLit(2)},
+			R: RecordLit{"a": NaturalLit(3)}},
+		RecordLit{"a": NaturalLit(3), "b": NaturalLit(2)}),
+	Entry(`{ a = { b = 1 } } ⫽ { a = { c = 2 } } -- doesn't recurse`,
+		OpTerm{OpCode: RightBiasedRecordMergeOp,
+			L: RecordLit{"a": RecordLit{"b": NaturalLit(1)}},
+			R: RecordLit{"a": RecordLit{"c": NaturalLit(2)}}},
+		RecordLit{"a": RecordLit{"c": NaturalLit(2)}}),
+	Entry(`x ⫽ { a = 1 }`,
+		OpTerm{OpCode: RightBiasedRecordMergeOp, L: x, R: RecordLit{"a": NaturalLit(1)}},
+		OpTerm{OpCode: RightBiasedRecordMergeOp, L: x, R: RecordLit{"a": NaturalLit(1)}}),
+	Entry(`{ a = 1 } ⫽ x`,
+		OpTerm{OpCode: RightBiasedRecordMergeOp, L: RecordLit{"a": NaturalLit(1)}, R: x},
+		OpTerm{OpCode: RightBiasedRecordMergeOp, L: RecordLit{"a": NaturalLit(1)}, R: x}),
+	Entry(`(x ⫽ { a = 1 }) ⫽ { b = 2 } -- not reassociated`,
+		OpTerm{OpCode: RightBiasedRecordMergeOp,
+			L: OpTerm{OpCode: RightBiasedRecordMergeOp, L: x, R: RecordLit{"a": NaturalLit(1)}},
+			R: RecordLit{"b": NaturalLit(2)}},
+		OpTerm{OpCode: RightBiasedRecordMergeOp,
+			L: OpTerm{OpCode: RightBiasedRecordMergeOp, L: x, R: RecordLit{"a": NaturalLit(1)}},
+			R: RecordLit{"b": NaturalLit(2)}}),
+	Entry(`(x ⫽ { a = 1 }).a`,
+		Field{OpTerm{OpCode: RightBiasedRecordMergeOp, L: x, R: RecordLit{"a": NaturalLit(1)}}, "a"},
+		NaturalLit(1)),
+	Entry(`(x ⫽ { a = 1 }).b`,
+		Field{OpTerm{OpCode: RightBiasedRecordMergeOp, L: x, R: RecordLit{"a": NaturalLit(1)}}, "b"},
+		Field{x, "b"}),
+	Entry(`({ a = 1 } ⫽ x).a`,
+		Field{OpTerm{OpCode: RightBiasedRecordMergeOp, L: RecordLit{"a": NaturalLit(1)}, R: x}, "a"},
+		Field{OpTerm{OpCode: RightBiasedRecordMergeOp, L: RecordLit{"a": NaturalLit(1)}, R: x}, "a"}),
+	Entry(`({ a = 1, b = 2 } ⫽ x).a`,
+		Field{OpTerm{OpCode: RightBiasedRecordMergeOp,
+			L: RecordLit{"a": NaturalLit(1), "b": NaturalLit(2)}, R: x}, "a"},
+		Field{OpTerm{OpCode: RightBiasedRecordMergeOp, L: RecordLit{"a": NaturalLit(1)}, R: x}, "a"}),
+	Entry(`({ a = 1 } ⫽ x).b`,
+		Field{OpTerm{OpCode: RightBiasedRecordMergeOp, L: RecordLit{"a": NaturalLit(1)}, R: x}, "b"},
+		Field{x, "b"}),
+	Entry(`(x ⫽ { a = 1 }).{ a, b }`,
+		Project{OpTerm{OpCode: RightBiasedRecordMergeOp, L: x, R: RecordLit{"a": NaturalLit(1)}}, []string{"a", "b"}},
+		OpTerm{OpCode: RightBiasedRecordMergeOp,
+			L: Project{x, []string{"b"}},
+			R: RecordLit{"a": NaturalLit(1)}}),
+	Entry(`(x ⫽ { a = 1, b = 2 }).{ a }`,
+		Project{OpTerm{OpCode: RightBiasedRecordMergeOp,
+			L: x, R: RecordLit{"a": NaturalLit(1), "b": NaturalLit(2)}}, []string{"a"}},
+		RecordLit{"a": NaturalLit(1)}),
+	Entry(`x == False -- not simplified`,
+		OpTerm{OpCode: EqOp, L: x, R: False},
+		OpTerm{OpCode: EqOp, L: x, R: False}),
+	Entry(`x != True -- not simplified`,
+		OpTerm{OpCode: NeOp, L: x, R: True},
+		OpTerm{OpCode: NeOp, L: x, R: True}),
+	Entry(`(x ∧ { a = 1, b = 2 }).a`,
+		Field{OpTerm{OpCode: RecordMergeOp,
+			L: x, R: RecordLit{"a": NaturalLit(1), "b": NaturalLit(2)}}, "a"},
+		Field{OpTerm{OpCode: RecordMergeOp, L: x, R: RecordLit{"a": NaturalLit(1)}}, "a"}),
+	Entry(`(x ∧ { a = 1 }).b`,
+		Field{OpTerm{OpCode: RecordMergeOp, L: x, R: RecordLit{"a": NaturalLit(1)}}, "b"},
+		Field{x, "b"}),
+	Entry(`({ a = 1, b = 2 } ∧ x).a`,
+		Field{OpTerm{OpCode: RecordMergeOp,
+			L: RecordLit{"a": NaturalLit(1), "b": NaturalLit(2)}, R: x}, "a"},
+		Field{OpTerm{OpCode: RecordMergeOp, L: RecordLit{"a": NaturalLit(1)}, R: x}, "a"}),
+	Entry(`({ a = 1 } ∧ x).b`,
+		Field{OpTerm{OpCode: RecordMergeOp, L: RecordLit{"a": NaturalLit(1)}, R: x}, "b"},
+		Field{x, "b"}),
+	Entry(`x ⩓ {}`, OpTerm{OpCode: RecordTypeMergeOp, L: x, R: RecordType{}}, x),
+	Entry(`{} ⩓ x`, OpTerm{OpCode: RecordTypeMergeOp, L: RecordType{}, R: x}, x),
+)
+
+var _ = DescribeTable("If", evalAndCompare,
+	Entry(`if True then x else y`, IfTerm{Cond: True, T: x, F: NewVar("y")}, x),
+	Entry(`if False then x else y`, IfTerm{Cond: False, T: x, F: NewVar("y")}, NewVar("y")),
+	Entry(`if x then True else False`, IfTerm{Cond: x, T: True, F: False}, x),
+	Entry(`if x then y else y`, IfTerm{Cond: x, T: NewVar("y"), F: NewVar("y")}, NewVar("y")),
+	Entry(`if x then False else True -- not simplified`,
+		IfTerm{Cond: x, T: False, F: True},
+		IfTerm{Cond: x, T: False, F: True}),
+	Entry(`if x then 1 + 1 else y -- branches are normalized`,
+		IfTerm{Cond: x, T: NaturalPlus(NaturalLit(1), NaturalLit(1)), F: NewVar("y")},
+		IfTerm{Cond: x, T: NaturalLit(2), F: NewVar("y")}),
+)
+
+var _ = DescribeTable("Equivalence", evalAndCompare,
+	Entry(`x ≡ x -- not reduced to a Bool`,
+		OpTerm{OpCode: EquivOp, L: x, R: x},
+		OpTerm{OpCode: EquivOp, L: x, R: x}),
+	Entry(`1 + 1 ≡ 2 -- both sides normalized`,
+		OpTerm{OpCode: EquivOp, L: NaturalPlus(NaturalLit(1), NaturalLit(1)), R: NaturalLit(2)},
+		OpTerm{OpCode: EquivOp, L: NaturalLit(2), R: NaturalLit(2)}),
+)
+
+var _ = DescribeTable("Assert", evalAndCompare,
+	Entry(`assert : 1 + 1 ≡ 2 -- keeps the normalized equivalence`,
+		Assert{OpTerm{OpCode: EquivOp, L: NaturalPlus(NaturalLit(1), NaturalLit(1)), R: NaturalLit(2)}},
+		Assert{OpTerm{OpCode: EquivOp, L: NaturalLit(2), R: NaturalLit(2)}}),
+	Entry(`assert : x ≡ x`,
+		Assert{OpTerm{OpCode: EquivOp, L: x, R: x}},
+		Assert{OpTerm{OpCode: EquivOp, L: x, R: x}}),
+)
+
+var _ = DescribeTable("Record selection and projection", evalAndCompare,
+	Entry(`x.{ a, b }.a`,
+		Field{Project{x, []string{"a", "b"}}, "a"},
+		Field{x, "a"}),
+	Entry(`x.{ b, a }.{ a }`,
+		Project{Project{x, []string{"b", "a"}}, []string{"a"}},
+		Project{x, []string{"a"}}),
+	Entry(`x.{}`,
+		Project{x, []string{}},
+		RecordLit{}),
+	Entry(`(x ⫽ { a = 1 }).({ b : Bool, a : Natural })`,
+		ProjectType{OpTerm{OpCode: RightBiasedRecordMergeOp, L: x, R: RecordLit{"a": NaturalLit(1)}},
+			RecordType{"b": Bool, "a": Natural}},
+		OpTerm{OpCode: RightBiasedRecordMergeOp,
+			L: Project{x, []string{"b"}},
+			R: RecordLit{"a": NaturalLit(1)}}),
+	Entry(`(x.{ a, b } ⫽ { c = 1 }).{ a, c }`,
+		Project{OpTerm{OpCode: RightBiasedRecordMergeOp,
+			L: Project{x, []string{"a", "b"}},
+			R: RecordLit{"c": NaturalLit(1)}}, []string{"a", "c"}},
+		OpTerm{OpCode: RightBiasedRecordMergeOp,
+			L: Project{x, []string{"a"}},
+			R: RecordLit{"c": NaturalLit(1)}}),
+	Entry(`((x ⫽ { a = 1 }) ⫽ { b = 2 }).{ c, b, a }`,
+		Project{OpTerm{OpCode: RightBiasedRecordMergeOp,
+			L: OpTerm{OpCode: RightBiasedRecordMergeOp, L: x, R: RecordLit{"a": NaturalLit(1)}},
+			R: RecordLit{"b": NaturalLit(2)}}, []string{"c", "b", "a"}},
+		OpTerm{OpCode: RightBiasedRecordMergeOp,
+			L: OpTerm{OpCode: RightBiasedRecordMergeOp,
+				L: Project{x, []string{"c"}},
+				R: RecordLit{"a": NaturalLit(1)}},
+			R: RecordLit{"b": NaturalLit(2)}}),
+	Entry(`((x ⫽ { a = 1 }) ⫽ { b = 2 }).{ a, b } -- merges the projected left side`,
+		Project{OpTerm{OpCode: RightBiasedRecordMergeOp,
+			L: OpTerm{OpCode: RightBiasedRecordMergeOp, L: x, R: RecordLit{"a": NaturalLit(1)}},
+			R: RecordLit{"b": NaturalLit(2)}}, []string{"a", "b"}},
+		RecordLit{"a": NaturalLit(1), "b": NaturalLit(2)}),
+	Entry(`(x ∧ { a = 1, b = True }).a`,
+		Field{OpTerm{OpCode: RecordMergeOp, L: x, R: RecordLit{"a": NaturalLit(1), "b": True}}, "a"},
+		Field{OpTerm{OpCode: RecordMergeOp, L: x, R: RecordLit{"a": NaturalLit(1)}}, "a"}),
+	Entry(`(x ∧ { a = 1 }).b`,
+		Field{OpTerm{OpCode: RecordMergeOp, L: x, R: RecordLit{"a": NaturalLit(1)}}, "b"},
+		Field{x, "b"}),
+)
+
+var _ = It("Project does not reorder the Term's field names", func() {
+	fieldNames := []string{"b", "a"}
+	Eval(Project{x, fieldNames})
+	Expect(fieldNames).To(Equal([]string{"b", "a"}))
+})
+
+// Church encodings build recursive structures out of functions, so
+// normalizing them exercises application of neutral and builtin
+// functions together.
+var _ = Describe("Church-encoded structures", func() {
+	N := NewVar("N")
+	succ := NewVar("succ")
+	zero := NewVar("zero")
+	peanoType := NewPi("N", Type, NewPi("succ", NewAnonPi(N, N), NewPi("zero", N, N)))
+	peano := func(n int) Term {
+		var body Term = zero
+		for i := 0; i < n; i++ {
+			body = Apply(succ, body)
+		}
+		return NewLambda("N", Type,
+			NewLambda("succ", NewAnonPi(N, N),
+				NewLambda("zero", N, body)))
+	}
+	It("converts a Peano natural to a Natural", func() {
+		evalAndCompare(
+			Apply(peano(3), Natural,
+				NewLambda("x", Natural, NaturalPlus(x, NaturalLit(1))),
+				NaturalLit(0)),
+			NaturalLit(3))
+	})
+	It("builds a Natural with Natural/build", func() {
+		evalAndCompare(Apply(NaturalBuild, peano(2)), NaturalLit(2))
+	})
+	It("leaves a neutral Peano natural alone", func() {
+		p := NewVar("p")
+		plusOne := NewLambda("x", Natural, NaturalPlus(x, NaturalLit(1)))
+		evalAndCompare(
+			NewLambda("p", peanoType, Apply(p, Natural, plusOne, NaturalLit(0))),
+			NewLambda("p", peanoType, Apply(p, Natural, plusOne, NaturalLit(0))))
+	})
+	It("converts a Church-encoded list to a List", func() {
+		list := NewVar("list")
+		cons := NewVar("cons")
+		nil_ := NewVar("nil")
+		evalAndCompare(
+			Apply(ListBuild, Natural,
+				NewLambda("list", Type,
+					NewLambda("cons", NewAnonPi(Natural, NewAnonPi(list, list)),
+						NewLambda("nil", list,
+							Apply(cons, NaturalLit(1), Apply(cons, x, nil_)))))),
+			NewList(NaturalLit(1), x))
+	})
 })
+
+var _ = Describe("EvalOptions", func() {
+	y := NewVar("y")
+	// let x = 1 + 1 let y : Natural = x in [ x + y, y ]
+	shared := NewLet(NewList(NaturalPlus(x, y), y),
+		Binding{Variable: "x", Value: NaturalPlus(NaturalLit(1), NaturalLit(1))},
+		Binding{Variable: "y", Annotation: Natural, Value: x},
+	)
+	It("inlines lets by default", func() {
+		Expect(Quote(EvalOptions{}.Eval(shared))).
+			To(Equal(NewList(NaturalLit(4), NaturalLit(2))))
+	})
+	It("keeps top-level lets with KeepLets", func() {
+		Expect(Quote(EvalOptions{KeepLets: true}.Eval(shared))).
+			To(Equal(NewLet(NewList(NaturalPlus(x, y), y),
+				Binding{Variable: "x", Value: NaturalLit(2)},
+				Binding{Variable: "y", Annotation: Natural, Value: x},
+			)))
+	})
+	It("keeps shadowed lets apart", func() {
+		// let x = 1 in let x = 2 in x@1
+		Expect(Quote(EvalOptions{KeepLets: true}.Eval(
+			NewLet(NewLet(Var{Name: "x", Index: 1}, Binding{Variable: "x", Value: NaturalLit(2)}),
+				Binding{Variable: "x", Value: NaturalLit(1)})))).
+			To(Equal(NewLet(Var{Name: "x", Index: 1},
+				Binding{Variable: "x", Value: NaturalLit(1)},
+				Binding{Variable: "x", Value: NaturalLit(2)})))
+	})
+	It("inlines lets below the top level", func() {
+		// λ(n : Natural) → let x = n in x
+		Expect(Quote(EvalOptions{KeepLets: true}.Eval(
+			NewLambda("n", Natural, NewLet(x, Binding{Variable: "x", Value: NewVar("n")}))))).
+			To(Equal(NewLambda("n", Natural, NewVar("n"))))
+	})
+})
+
+var _ = Describe("MergeError", func() {
+	It("reports the path to a collision three levels deep", func() {
+		_, err := TryEval(OpTerm{OpCode: RecordMergeOp,
+			L: RecordLit{"a": RecordLit{"b": RecordLit{"c": NaturalLit(1), "d": True}}},
+			R: RecordLit{"a": RecordLit{"b": RecordLit{"c": True}}}})
+		Expect(err).To(Equal(&MergeError{Path: []string{"a", "b", "c"}, Left: "Natural", Right: "Bool"}))
+		Expect(err).To(MatchError("can't merge field a.b.c: Natural collides with Bool"))
+	})
+	It("doesn't report a collision with a neutral field", func() {
+		Expect(TryEval(OpTerm{OpCode: RecordMergeOp,
+			L: RecordLit{"a": RecordLit{"b": x}},
+			R: RecordLit{"a": RecordLit{"b": RecordLit{"c": True}}}})).
+			To(Equal(RecordLitVal{"a": RecordLitVal{"b": opValue{
+				OpCode: RecordMergeOp, L: Var{Name: "x"}, R: RecordLitVal{"c": True}}}}))
+	})
+	It("reports collisions in ⩓", func() {
+		_, err := TryEval(OpTerm{OpCode: RecordTypeMergeOp,
+			L: RecordType{"a": RecordType{"b": Natural}},
+			R: RecordType{"a": Text}})
+		Expect(err).To(Equal(&MergeError{Path: []string{"a"}, Left: "record type", Right: "Text"}))
+	})
+})
+
+var _ = Describe("MaxFoldSteps", func() {
+	x := NewVar("x")
+	plusOne := NewLambda("x", Natural, NaturalPlus(x, NaturalLit(1)))
+	opts := EvalOptions{MaxFoldSteps: 100}
+	fold := func(n NaturalLit) Term {
+		return Apply(NaturalFold, n, Natural, plusOne, NaturalLit(0))
+	}
+
+	It("folds a Natural within the limit", func() {
+		Expect(opts.TryEval(fold(100))).To(Equal(NaturalLit(100)))
+	})
+	It("aborts a large Natural/fold", func() {
+		_, err := opts.TryEval(fold(1000000000))
+		Expect(err).To(Equal(&FoldLimitError{Fold: NaturalFold, Steps: 1000000000, Limit: 100}))
+	})
+	It("aborts a large List/fold", func() {
+		list := make([]Term, 101)
+		for i := range list {
+			list[i] = NaturalLit(i)
+		}
+		_, err := opts.TryEval(Apply(ListFold, Natural, NewList(list[0], list[1:]...), Natural,
+			NewLambda("x", Natural, plusOne), NaturalLit(0)))
+		Expect(err).To(Equal(&FoldLimitError{Fold: ListFold, Steps: 101, Limit: 100}))
+	})
+	It("counts every fold in the call against the limit", func() {
+		_, err := opts.TryEval(NaturalPlus(fold(60), fold(60)))
+		Expect(err).To(Equal(&FoldLimitError{Fold: NaturalFold, Steps: 120, Limit: 100}))
+	})
+	It("gives each call its own budget", func() {
+		Expect(opts.TryEval(fold(60))).To(Equal(NaturalLit(60)))
+		Expect(opts.TryEval(fold(60))).To(Equal(NaturalLit(60)))
+	})
+	It("doesn't limit the functions it returns", func() {
+		// λ(n : Natural) → Natural/fold n Natural (λ(x : Natural) → x + 1) 0
+		fn, err := opts.TryEval(NewLambda("n", Natural,
+			Apply(NaturalFold, NewVar("n"), Natural, plusOne, NaturalLit(0))))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(applyVal(fn, NaturalLit(200))).To(Equal(NaturalLit(200)))
+	})
+	It("aborts a large fold while typechecking", func() {
+		// assert : Natural/fold 1000000000 Natural (λ(x : Natural) → x + 1) 0 ≡ 0
+		_, err := opts.TypeOf(Assert{Annotation: OpTerm{OpCode: EquivOp,
+			L: fold(1000000000), R: NaturalLit(0)}})
+		Expect(err).To(Equal(&FoldLimitError{Fold: NaturalFold, Steps: 1000000000, Limit: 100}))
+	})
+	It("panics from EvalOptions.Eval", func() {
+		Expect(func() { opts.Eval(fold(101)) }).To(Panic())
+	})
+	It("doesn't limit Eval", func() {
+		Expect(Eval(fold(101))).To(Equal(NaturalLit(101)))
+	})
+})
+
+var _ = Describe("EvalContext", func() {
+	x := NewVar("x")
+	plusOne := NewLambda("x", Natural, NaturalPlus(x, NaturalLit(1)))
+
+	It("evaluates a term within the deadline", func() {
+		ctx, cancel := gocontext.WithTimeout(gocontext.Background(), time.Second)
+		defer cancel()
+		Expect(EvalContext(ctx, Apply(NaturalFold, NaturalLit(100), Natural, plusOne, NaturalLit(0)))).
+			To(Equal(NaturalLit(100)))
+	})
+	DescribeTable("aborts a large fold promptly",
+		func(step Term) {
+			ctx, cancel := gocontext.WithTimeout(gocontext.Background(), 50*time.Millisecond)
+			defer cancel()
+			start := time.Now()
+			_, err := EvalContext(ctx, Apply(NaturalFold, NaturalLit(10000000000), Natural, step, NaturalLit(0)))
+			Expect(err).To(Equal(gocontext.DeadlineExceeded))
+			Expect(time.Since(start)).To(BeNumerically("<", time.Second))
+		},
+		Entry("with a lambda step", plusOne),
+		Entry("with a builtin step", Apply(NaturalSubtract, NaturalLit(0))),
+	)
+	It("returns immediately for a cancelled context", func() {
+		ctx, cancel := gocontext.WithCancel(gocontext.Background())
+		cancel()
+		_, err := EvalContext(ctx, NaturalLit(1))
+		Expect(err).To(Equal(gocontext.Canceled))
+	})
+	It("doesn't interfere with variables with an empty label", func() {
+		empty := NewVar("")
+		t := Apply(NewLambda("", Natural, NaturalPlus(empty, NaturalLit(1))), NaturalLit(2))
+		Expect(EvalContext(gocontext.Background(), t)).To(Equal(NaturalLit(3)))
+		Expect(Eval(t)).To(Equal(NaturalLit(3)))
+		Expect(TypeOf(t)).To(Equal(Natural))
+	})
+	It("produces the same fold builtins as Eval", func() {
+		for _, fold := range []Term{NaturalFold, ListFold} {
+			v, err := EvalContext(gocontext.Background(), fold)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(judgmentallyEqualVals(v, Eval(fold))).To(BeTrue())
+		}
+	})
+	It("returns values which can be used after the context is done", func() {
+		ctx, cancel := gocontext.WithCancel(gocontext.Background())
+		v, err := EvalContext(ctx, plusOne)
+		Expect(err).ToNot(HaveOccurred())
+		cancel()
+		Expect(Quote(v)).To(Equal(plusOne))
+	})
+})
+
+// largeNormalRecord returns an already-normal record with n fields,
+// each itself a small record.
+func largeNormalRecord(n int) RecordLit {
+	record := make(RecordLit, n)
+	for i := 0; i < n; i++ {
+		record[fmt.Sprintf("field%d", i)] = RecordLit{
+			"name":    TextLitTerm{Suffix: fmt.Sprint("name", i)},
+			"count":   NaturalLit(i),
+			"enabled": True,
+			"tags":    NewList(TextLitTerm{Suffix: "a"}, TextLitTerm{Suffix: "b"}),
+		}
+	}
+	return record
+}
+
+func BenchmarkEvalNormalRecord(b *testing.B) {
+	record := largeNormalRecord(1000)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		Eval(record)
+	}
+}
+
+// BenchmarkEvalNonNormalList evaluates a large normal record followed
+// by a redex, which is only found after walking the whole record.
+func BenchmarkEvalNonNormalList(b *testing.B) {
+	list := NewList(largeNormalRecord(1000), RecordLit{"sum": NaturalPlus(NaturalLit(1), NaturalLit(2))})
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		Eval(list)
+	}
+}
@@ -0,0 +1,273 @@
+package core
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// DefaultFactorMinSize is the FactorOptions.MinSize used by Factor.
+const DefaultFactorMinSize = 8
+
+// FactorOptions controls Factor.
+type FactorOptions struct {
+	// MinSize is the smallest Term, counted in syntax tree nodes,
+	// which is hoisted into a let binding.  If not positive,
+	// DefaultFactorMinSize is used.
+	MinSize int
+}
+
+// Factor finds closed subterms of t which occur more than once and
+// are at least DefaultFactorMinSize nodes, and hoists each into a let
+// binding at the top of t, so that it is only written once.  Larger
+// subterms are hoisted first.  The result means the same as t: it
+// normalizes to the same Value.
+//
+// Factor is the inverse of the let inlining which Eval does, for
+// tools such as formatters which want smaller, more readable output.
+func Factor(t Term) Term {
+	return FactorOptions{}.Factor(t)
+}
+
+// Factor is like the package-level Factor, but hoists subterms of at
+// least o.MinSize nodes.
+func (o FactorOptions) Factor(t Term) Term {
+	minSize := o.MinSize
+	if minSize <= 0 {
+		minSize = DefaultFactorMinSize
+	}
+	used := map[string]bool{}
+	collectNames(t, used)
+	var bindings []Binding
+	for n := 1; ; n++ {
+		terms := []Term{t}
+		for _, b := range bindings {
+			terms = append(terms, b.Value)
+		}
+		repeated := largestRepeated(terms, minSize)
+		if repeated == nil {
+			break
+		}
+		name := "_" + strconv.Itoa(n)
+		for used[name] {
+			n++
+			name = "_" + strconv.Itoa(n)
+		}
+		key := termKey(repeated)
+		v := Var{Name: name}
+		t = replaceTerm(t, key, v)
+		for i := range bindings {
+			bindings[i].Value = replaceTerm(bindings[i].Value, key, v)
+		}
+		// repeated may occur in earlier bindings, so it must be bound
+		// before them
+		bindings = append([]Binding{{Variable: name, Value: repeated}}, bindings...)
+	}
+	if len(bindings) == 0 {
+		return t
+	}
+	return Let{Bindings: bindings, Body: t}
+}
+
+// termKey returns a string which is the same for two Terms exactly
+// when they are structurally equal.
+func termKey(t Term) string {
+	return fmt.Sprintf("%#v", t)
+}
+
+// largestRepeated returns the largest closed subterm, of at least
+// minSize nodes, which occurs more than once among terms, or nil if
+// there is none.
+func largestRepeated(terms []Term, minSize int) Term {
+	counts := map[string]int{}
+	var candidates []Term
+	var visit func(t Term)
+	visit = func(t Term) {
+		if size := termSize(t); size >= minSize && closedUnder(nil, t) {
+			key := termKey(t)
+			counts[key]++
+			if counts[key] == 2 {
+				candidates = append(candidates, t)
+			}
+		}
+		mapChildren(t, func(child Term) Term {
+			visit(child)
+			return child
+		})
+	}
+	for _, t := range terms {
+		visit(t)
+	}
+	var largest Term
+	for _, c := range candidates {
+		if largest == nil || termSize(c) > termSize(largest) {
+			largest = c
+		}
+	}
+	return largest
+}
+
+// replaceTerm replaces each subterm of t with the given key by v.
+func replaceTerm(t Term, key string, v Var) Term {
+	if termKey(t) == key {
+		return v
+	}
+	return mapChildren(t, func(child Term) Term {
+		return replaceTerm(child, key, v)
+	})
+}
+
+// termSize returns the number of nodes in t.
+func termSize(t Term) int {
+	size := 1
+	mapChildren(t, func(child Term) Term {
+		size += termSize(child)
+		return child
+	})
+	return size
+}
+
+// closedUnder reports whether t has no free variables, other than
+// those bound by the binders named in bound.
+func closedUnder(bound []string, t Term) bool {
+	bind := func(bound []string, name string) []string {
+		return append(bound[:len(bound):len(bound)], name)
+	}
+	switch t := t.(type) {
+	case Var:
+		seen := 0
+		for _, name := range bound {
+			if name == t.Name {
+				seen++
+			}
+		}
+		return t.Index < seen
+	case localVar:
+		return false
+	case LambdaTerm:
+		return closedUnder(bound, t.Type) && closedUnder(bind(bound, t.Label), t.Body)
+	case PiTerm:
+		return closedUnder(bound, t.Type) && closedUnder(bind(bound, t.Label), t.Body)
+	case Let:
+		for _, b := range t.Bindings {
+			if !closedUnder(bound, b.Value) {
+				return false
+			}
+			if b.Annotation != nil && !closedUnder(bound, b.Annotation) {
+				return false
+			}
+			bound = bind(bound, b.Variable)
+		}
+		return closedUnder(bound, t.Body)
+	}
+	closed := true
+	mapChildren(t, func(child Term) Term {
+		closed = closed && closedUnder(bound, child)
+		return child
+	})
+	return closed
+}
+
+// collectNames adds every variable name and binder label in t to
+// names.
+func collectNames(t Term, names map[string]bool) {
+	switch t := t.(type) {
+	case Var:
+		names[t.Name] = true
+	case LambdaTerm:
+		names[t.Label] = true
+	case PiTerm:
+		names[t.Label] = true
+	case Let:
+		for _, b := range t.Bindings {
+			names[b.Variable] = true
+		}
+	}
+	mapChildren(t, func(child Term) Term {
+		collectNames(child, names)
+		return child
+	})
+}
+
+// mapChildren returns t with f applied to each of its immediate,
+// non-nil subterms, regardless of the variables they bind.
+func mapChildren(t Term, f func(Term) Term) Term {
+	walk := func(t Term) Term {
+		if t == nil {
+			return nil
+		}
+		return f(t)
+	}
+	switch t := t.(type) {
+	case LambdaTerm:
+		return LambdaTerm{Label: t.Label, Type: walk(t.Type), Body: walk(t.Body)}
+	case PiTerm:
+		return PiTerm{Label: t.Label, Type: walk(t.Type), Body: walk(t.Body)}
+	case AppTerm:
+		return AppTerm{Fn: walk(t.Fn), Arg: walk(t.Arg)}
+	case OpTerm:
+		return OpTerm{OpCode: t.OpCode, L: walk(t.L), R: walk(t.R)}
+	case Let:
+		bindings := make([]Binding, len(t.Bindings))
+		for i, b := range t.Bindings {
+			bindings[i] = Binding{
+				Variable:   b.Variable,
+				Annotation: walk(b.Annotation),
+				Value:      walk(b.Value),
+			}
+		}
+		return Let{Bindings: bindings, Body: walk(t.Body)}
+	case Annot:
+		return Annot{Expr: walk(t.Expr), Annotation: walk(t.Annotation)}
+	case TextLitTerm:
+		result := TextLitTerm{Suffix: t.Suffix}
+		for _, chunk := range t.Chunks {
+			result.Chunks = append(result.Chunks, Chunk{Prefix: chunk.Prefix, Expr: walk(chunk.Expr)})
+		}
+		return result
+	case IfTerm:
+		return IfTerm{Cond: walk(t.Cond), T: walk(t.T), F: walk(t.F)}
+	case EmptyList:
+		return EmptyList{Type: walk(t.Type)}
+	case NonEmptyList:
+		result := make(NonEmptyList, len(t))
+		for i, item := range t {
+			result[i] = walk(item)
+		}
+		return result
+	case Some:
+		return Some{Val: walk(t.Val)}
+	case RecordType:
+		result := make(RecordType, len(t))
+		for k, v := range t {
+			result[k] = walk(v)
+		}
+		return result
+	case RecordLit:
+		result := make(RecordLit, len(t))
+		for k, v := range t {
+			result[k] = walk(v)
+		}
+		return result
+	case ToMap:
+		return ToMap{Record: walk(t.Record), Type: walk(t.Type)}
+	case Field:
+		return Field{Record: walk(t.Record), FieldName: t.FieldName}
+	case Project:
+		return Project{Record: walk(t.Record), FieldNames: t.FieldNames}
+	case ProjectType:
+		return ProjectType{Record: walk(t.Record), Selector: walk(t.Selector)}
+	case UnionType:
+		result := make(UnionType, len(t))
+		for k, v := range t {
+			result[k] = walk(v)
+		}
+		return result
+	case Merge:
+		return Merge{Handler: walk(t.Handler), Union: walk(t.Union), Annotation: walk(t.Annotation)}
+	case Assert:
+		return Assert{Annotation: walk(t.Annotation)}
+	default:
+		// Universe, Builtin, Var, literals, Import
+		return t
+	}
+}
@@ -0,0 +1,85 @@
+package core
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Factor", func() {
+	server := RecordLit{
+		"host":    TextLitTerm{Suffix: "localhost"},
+		"port":    NaturalLit(8080),
+		"tls":     True,
+		"timeout": NaturalLit(30),
+		"weight":  NaturalLit(2),
+		"aliases": NewList(TextLitTerm{Suffix: "a"}, TextLitTerm{Suffix: "b"}),
+	}
+
+	It("hoists a repeated large record into a single let", func() {
+		t := RecordLit{
+			"primary": server,
+			"backup":  server,
+			"name":    TextLitTerm{Suffix: "cluster"},
+		}
+		factored := Factor(t)
+		Expect(factored).To(Equal(Let{
+			Bindings: []Binding{{Variable: "_1", Value: server}},
+			Body: RecordLit{
+				"primary": NewVar("_1"),
+				"backup":  NewVar("_1"),
+				"name":    TextLitTerm{Suffix: "cluster"},
+			},
+		}))
+		Expect(judgmentallyEqual(factored, t)).To(BeTrue())
+		_, err := TypeOf(factored)
+		Expect(err).ToNot(HaveOccurred())
+	})
+	It("leaves small repeated subterms alone", func() {
+		t := NewList(NaturalPlus(NaturalLit(1), NaturalLit(2)), NaturalPlus(NaturalLit(1), NaturalLit(2)))
+		Expect(Factor(t)).To(Equal(t))
+	})
+	It("hoists smaller subterms with a smaller MinSize", func() {
+		sum := NaturalPlus(NaturalLit(1), NaturalLit(2))
+		t := NewList(sum, sum)
+		factored := FactorOptions{MinSize: 3}.Factor(t)
+		Expect(factored).To(Equal(Let{
+			Bindings: []Binding{{Variable: "_1", Value: sum}},
+			Body:     NewList(NewVar("_1"), NewVar("_1")),
+		}))
+		Expect(judgmentallyEqual(factored, t)).To(BeTrue())
+	})
+	It("doesn't hoist subterms which refer to bound variables", func() {
+		x := NewVar("x")
+		record := RecordLit{"a": x, "b": x, "c": x, "d": x, "e": x, "f": x, "g": x, "h": x}
+		t := NewLambda("x", Natural, NewList(record, record))
+		Expect(Factor(t)).To(Equal(t))
+	})
+	It("hoists closed subterms out of binders", func() {
+		t := NewLambda("x", Natural, NewList(server, server))
+		factored := Factor(t)
+		Expect(factored).To(Equal(Let{
+			Bindings: []Binding{{Variable: "_1", Value: server}},
+			Body:     NewLambda("x", Natural, NewList(NewVar("_1"), NewVar("_1"))),
+		}))
+		Expect(judgmentallyEqual(factored, t)).To(BeTrue())
+	})
+	It("avoids names already used in the term", func() {
+		t := RecordLit{"a": server, "b": server, "c": NewLambda("_1", Natural, NewVar("_1"))}
+		factored := Factor(t).(Let)
+		Expect(factored.Bindings[0].Variable).To(Equal("_2"))
+		Expect(judgmentallyEqual(factored, t)).To(BeTrue())
+	})
+	It("hoists a subterm repeated inside an earlier binding first", func() {
+		pair := RecordLit{"first": server, "second": server}
+		t := NewList(pair, pair, server)
+		factored := Factor(t)
+		Expect(factored).To(Equal(Let{
+			Bindings: []Binding{
+				{Variable: "_2", Value: server},
+				{Variable: "_1", Value: RecordLit{"first": NewVar("_2"), "second": NewVar("_2")}},
+			},
+			Body: NewList(NewVar("_1"), NewVar("_1"), NewVar("_2")),
+		}))
+		Expect(judgmentallyEqual(factored, t)).To(BeTrue())
+	})
+})
@@ -9,6 +9,7 @@ import (
 	"os"
 	"path"
 	"strings"
+	"sync"
 )
 
 type EnvVar string
@@ -64,20 +65,78 @@ func (e EnvVar) AsLocation() Term {
 	return Apply(Field{LocationType, "Environment"}, TextLitTerm{Suffix: e.String()})
 }
 
+// A LocalKind says what the path of a Local import is relative to.
+type LocalKind int
+
+const (
+	// LocalHere is relative to the importing file: ./path
+	LocalHere LocalKind = iota
+	// LocalParent is relative to the importing file's parent
+	// directory: ../path
+	LocalParent
+	// LocalHome is relative to the user's home directory: ~/path
+	LocalHome
+	// LocalAbsolute is an absolute path: /path
+	LocalAbsolute
+)
+
+// NewLocal returns the Local import of the given kind with the given
+// path components.  A LocalHere path which climbs out of its
+// directory becomes LocalParent, as the standard canonicalizes it.
+func NewLocal(kind LocalKind, components ...string) Local {
+	p := path.Join(components...)
+	switch kind {
+	case LocalParent:
+		return Local(path.Join("..", p))
+	case LocalHome:
+		return Local(path.Join("~", p))
+	case LocalAbsolute:
+		return Local(path.Join("/", p))
+	}
+	switch Local(p).Kind() {
+	case LocalHome, LocalAbsolute:
+		// keep a first component such as `~` from being read as
+		// another kind of path
+		return Local("./" + p)
+	}
+	return Local(p)
+}
+
+// Kind returns what l is relative to.  A LocalHere path may be
+// written with or without its leading `./`; it needs it only if its
+// first component is `~`.
+func (l Local) Kind() LocalKind {
+	s := string(l)
+	switch {
+	case strings.HasPrefix(s, "./"):
+		return LocalHere
+	case strings.HasPrefix(s, "/"):
+		return LocalAbsolute
+	case s == "~" || strings.HasPrefix(s, "~/"):
+		return LocalHome
+	case s == ".." || strings.HasPrefix(s, "../"):
+		return LocalParent
+	}
+	return LocalHere
+}
+
 func (l Local) Name() string { return string(l) }
 func (Local) Origin() string { return NullOrigin }
 func (l Local) String() string {
-	if l.IsAbs() || l.IsRelativeToHome() || l.IsRelativeToParent() {
+	if l.Kind() != LocalHere || strings.HasPrefix(string(l), "./") {
 		return string(l)
-	} else {
-		return "./" + string(l)
 	}
+	return "./" + string(l)
 }
 func (l Local) Fetch(origin string) (string, error) {
 	if origin != NullOrigin {
 		return "", fmt.Errorf("Can't get %s from remote import at %s", l, origin)
 	}
-	bytes, err := ioutil.ReadFile(string(l))
+	filePath, err := l.FilePath()
+	if err != nil {
+		return "", err
+	}
+	bytes, err := ioutil.ReadFile(filePath)
 	return string(bytes), err
 }
 func (l Local) ChainOnto(base Fetchable) (Fetchable, error) {
@@ -86,7 +145,11 @@ func (l Local) ChainOnto(base Fetchable) (Fetchable, error) {
 		if l.IsAbs() || l.IsRelativeToHome() {
 			return l, nil
 		}
-		return Local(path.Join(path.Dir(string(r)), string(l))), nil
+		joined := path.Join(path.Dir(string(r)), string(l))
+		if r.Kind() == LocalHere {
+			return NewLocal(LocalHere, joined), nil
+		}
+		return Local(joined), nil
 	case Remote:
 		if l.IsAbs() {
 			return nil, errors.New("Can't get absolute path from remote import")
@@ -101,9 +164,9 @@ func (l Local) ChainOnto(base Fetchable) (Fetchable, error) {
 	}
 }
 
-func (l Local) IsAbs() bool              { return path.IsAbs(string(l)) }
-func (l Local) IsRelativeToParent() bool { return strings.HasPrefix(string(l), "..") }
-func (l Local) IsRelativeToHome() bool   { return string(l)[0] == '~' }
+func (l Local) IsAbs() bool              { return l.Kind() == LocalAbsolute }
+func (l Local) IsRelativeToParent() bool { return l.Kind() == LocalParent }
+func (l Local) IsRelativeToHome() bool   { return l.Kind() == LocalHome }
 
 //asRelativeRef converts a local path to a relative reference
 func (l Local) asRelativeRef() *url.URL {
@@ -128,7 +191,7 @@ func (l Local) asRelativeRef() *url.URL {
 }
 
 func (l Local) PathComponents() []string {
-	if l.IsAbs() || l.IsRelativeToHome() || l.IsRelativeToParent() {
+	if l.Kind() != LocalHere || strings.HasPrefix(string(l), "./") {
 		return strings.Split(string(l), "/")[1:]
 	} else {
 		return strings.Split(string(l), "/")
@@ -143,26 +206,102 @@ func NewRemote(u *url.URL) Remote {
 
 var client http.Client
 
+// A CachedResponse is the body of a remote import together with the
+// validators needed to revalidate it with a conditional request.
+type CachedResponse struct {
+	Body         string
+	ETag         string
+	LastModified string
+}
+
+// An HTTPCache stores the responses to remote imports, keyed by URL.
+type HTTPCache interface {
+	Get(url string) (CachedResponse, bool)
+	Put(url string, response CachedResponse)
+}
+
+// MemoryHTTPCache is an HTTPCache which holds responses in memory.
+// It is safe for concurrent use.
+type MemoryHTTPCache struct {
+	mu        sync.Mutex
+	responses map[string]CachedResponse
+}
+
+// NewMemoryHTTPCache returns an empty MemoryHTTPCache.
+func NewMemoryHTTPCache() *MemoryHTTPCache {
+	return &MemoryHTTPCache{responses: make(map[string]CachedResponse)}
+}
+
+// Get returns the cached response for url, if any.
+func (c *MemoryHTTPCache) Get(url string) (CachedResponse, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	response, ok := c.responses[url]
+	return response, ok
+}
+
+// Put caches response for url.
+func (c *MemoryHTTPCache) Put(url string, response CachedResponse) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.responses[url] = response
+}
+
 func (r Remote) Name() string   { return r.url.String() }
 func (r Remote) Origin() string { return fmt.Sprintf("%s://%s", r.url.Scheme, r.Authority()) }
 func (r Remote) String() string { return fmt.Sprintf("%v", r.url) }
 func (r Remote) Fetch(origin string) (string, error) {
-	req, err := http.NewRequest("GET", r.url.String(), nil)
+	return r.FetchWithHeaders(origin, nil)
+}
+
+// FetchWithHeaders is like Fetch, but also sends headers with the
+// request.  So that credentials are not leaked to other servers,
+// headers are only sent when the import is not cross-origin.
+func (r Remote) FetchWithHeaders(origin string, headers http.Header) (string, error) {
+	return r.FetchWith(origin, headers, nil)
+}
+
+// FetchWith is like FetchWithHeaders, but also consults cache, if not
+// nil.  When cache holds a response for the URL, the fetch is made
+// conditional on the cached ETag and Last-Modified headers, and a 304
+// Not Modified reply is served from the cache.
+func (r Remote) FetchWith(origin string, headers http.Header, cache HTTPCache) (string, error) {
+	location := r.Canonical().url.String()
+	req, err := http.NewRequest("GET", location, nil)
 	if err != nil {
 		return "", err
 	}
-	req.Header.Set("User-Agent", "dhall-golang")
 	corsFlag := origin != NullOrigin && origin != r.Origin()
+	if !corsFlag {
+		for name, values := range headers {
+			req.Header[http.CanonicalHeaderKey(name)] = append([]string(nil), values...)
+		}
+	}
+	req.Header.Set("User-Agent", "dhall-golang")
 	if corsFlag {
 		req.Header.Set("Origin", origin)
 	}
+	var cached CachedResponse
+	var isCached bool
+	if cache != nil {
+		cached, isCached = cache.Get(location)
+	}
+	if isCached {
+		if cached.ETag != "" {
+			req.Header.Set("If-None-Match", cached.ETag)
+		}
+		if cached.LastModified != "" {
+			req.Header.Set("If-Modified-Since", cached.LastModified)
+		}
+	}
 
 	resp, err := client.Do(req)
 	if err != nil {
 		return "", err
 	}
 	defer resp.Body.Close()
-	if resp.StatusCode != http.StatusOK {
+	notModified := isCached && resp.StatusCode == http.StatusNotModified
+	if resp.StatusCode != http.StatusOK && !notModified {
 		return "", fmt.Errorf("Got status %d from URL %s", resp.StatusCode, r.url)
 	}
 	if corsFlag &&
@@ -170,9 +309,23 @@ func (r Remote) Fetch(origin string) (string, error) {
 		resp.Header.Get("Access-Control-Allow-Origin") != origin {
 		return "", fmt.Errorf("URL %s does not permit CORS requests from %s", r.url, origin)
 	}
+	if notModified {
+		return cached.Body, nil
+	}
 
 	bodyBytes, err := ioutil.ReadAll(resp.Body)
-	return string(bodyBytes), err
+	if err != nil {
+		return "", err
+	}
+	etag, lastModified := resp.Header.Get("ETag"), resp.Header.Get("Last-Modified")
+	if cache != nil && (etag != "" || lastModified != "") {
+		cache.Put(location, CachedResponse{
+			Body:         string(bodyBytes),
+			ETag:         etag,
+			LastModified: lastModified,
+		})
+	}
+	return string(bodyBytes), nil
 }
 func (r Remote) ChainOnto(base Fetchable) (Fetchable, error) {
 	return r, nil
@@ -190,6 +343,56 @@ func (r Remote) PathComponents() []string {
 	}
 	return strings.Split(r.url.EscapedPath()[1:], "/")
 }
+
+// Canonical returns r with each segment of its path re-encoded
+// canonically, so that equivalent spellings of the same URL, such as
+// `%7e` and `~`, compare equal and are fetched alike.  The binary
+// encoding of an import keeps its path as written, so it is not
+// affected.
+func (r Remote) Canonical() Remote {
+	segments := strings.Split(r.url.EscapedPath(), "/")
+	for i, segment := range segments {
+		segments[i] = canonicalSegment(segment)
+	}
+	rawPath := strings.Join(segments, "/")
+	path, err := url.PathUnescape(rawPath)
+	if err != nil {
+		return r
+	}
+	u := *r.url
+	u.Path, u.RawPath = path, rawPath
+	return Remote{url: &u}
+}
+
+// canonicalSegment decodes the characters of a URL path segment
+// which need not be percent-encoded, and percent-encodes the rest
+// with upper-case hex digits, as RFC 3986 section 6.2.2 recommends.
+func canonicalSegment(segment string) string {
+	decoded, err := url.PathUnescape(segment)
+	if err != nil {
+		return segment
+	}
+	var buf strings.Builder
+	for i := 0; i < len(decoded); i++ {
+		c := decoded[i]
+		if isSegmentChar(c) {
+			buf.WriteByte(c)
+		} else {
+			fmt.Fprintf(&buf, "%%%02X", c)
+		}
+	}
+	return buf.String()
+}
+
+// isSegmentChar reports whether c may appear unescaped in a URL path
+// segment: the pchar production of RFC 3986.
+func isSegmentChar(c byte) bool {
+	switch {
+	case 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z', '0' <= c && c <= '9':
+		return true
+	}
+	return strings.IndexByte("-._~!$&'()*+,;=:@", c) >= 0
+}
 func (r Remote) Query() *string {
 	if r.url.RawQuery == "" && !r.url.ForceQuery {
 		return nil
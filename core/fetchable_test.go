@@ -44,6 +44,8 @@ var _ = DescribeTable("ChainOnto", func(fetchable, base, expected Fetchable) {
 	Entry("Relative local onto Remote", Local("foo"), makeRemote("https://example.com/bar/baz"), makeRemote("https://example.com/bar/foo")),
 	Entry("Relative local with tricky chars onto Remote", Local("foo:bar#[☃"), makeRemote("https://example.com/bar/baz"), makeRemote("https://example.com/bar/foo:bar%23%5B%E2%98%83")),
 	Entry("Relative local onto Missing", Local("foo"), Missing{}, Local("foo")),
+	Entry("Relative local onto Local starting with ~", Local("foo"), Local("./~/baz"), Local("./~/foo")),
+	Entry("Relative local climbing out of Local", Local("../../foo"), Local("bar/baz"), Local("../foo")),
 	Entry("Parent-relative local onto EnvVar", Local("../foo"), EnvVar("bar"), Local("../foo")),
 	Entry("Parent-relative local onto Local", Local("../foo"), Local("/bar/baz/quux"), Local("/bar/foo")),
 	Entry("Parent-relative local onto Remote", Local("../foo"), makeRemote("https://example.com/bar/baz/quux"), makeRemote("https://example.com/bar/foo")),
@@ -63,6 +65,32 @@ var _ = DescribeTable("ChainOnto", func(fetchable, base, expected Fetchable) {
 	Entry("Remote onto Missing", makeRemote("https://example.com/foo"), Missing{}, makeRemote("https://example.com/foo")),
 )
 
+var _ = DescribeTable("Local kinds", func(local Local, kind LocalKind, str string, components []string) {
+	Expect(local.Kind()).To(Equal(kind))
+	Expect(local.String()).To(Equal(str))
+	Expect(local.PathComponents()).To(Equal(components))
+},
+	Entry("here", Local("foo/bar"), LocalHere, "./foo/bar", []string{"foo", "bar"}),
+	Entry("here with ./", Local("./foo/bar"), LocalHere, "./foo/bar", []string{"foo", "bar"}),
+	Entry("here starting with ~", Local("./~/bar"), LocalHere, "./~/bar", []string{"~", "bar"}),
+	Entry("here starting with ..", Local("..foo/bar"), LocalHere, "./..foo/bar", []string{"..foo", "bar"}),
+	Entry("here starting with ~ in the name", Local("~foo"), LocalHere, "./~foo", []string{"~foo"}),
+	Entry("parent", Local("../foo/bar"), LocalParent, "../foo/bar", []string{"foo", "bar"}),
+	Entry("home", Local("~/foo/bar"), LocalHome, "~/foo/bar", []string{"foo", "bar"}),
+	Entry("absolute", Local("/foo/bar"), LocalAbsolute, "/foo/bar", []string{"foo", "bar"}),
+)
+
+var _ = DescribeTable("NewLocal", func(kind LocalKind, components []string, expected Local) {
+	Expect(NewLocal(kind, components...)).To(Equal(expected))
+},
+	Entry("here", LocalHere, []string{"foo", "bar"}, Local("foo/bar")),
+	Entry("here starting with ~", LocalHere, []string{"~", "bar"}, Local("./~/bar")),
+	Entry("here climbing out", LocalHere, []string{"..", "bar"}, Local("../bar")),
+	Entry("parent", LocalParent, []string{"foo"}, Local("../foo")),
+	Entry("home", LocalHome, []string{"foo"}, Local("~/foo")),
+	Entry("absolute", LocalAbsolute, []string{"foo"}, Local("/foo")),
+)
+
 const ExampleRemoteOrigin = "http://example.com"
 
 var _ = Describe("Fetch", func() {
@@ -140,5 +168,69 @@ var _ = Describe("Fetch", func() {
 				Expect(actual).To(Equal("this content allows origin http://example.com"))
 			})
 		})
+		Context("with an HTTPCache", func() {
+			var requests []*http.Request
+			var cache *MemoryHTTPCache
+			fetch := func(path string) (string, error) {
+				remote := internal.NewRemoteImport(server.URL()+path, Code).Fetchable.(Remote)
+				return remote.FetchWith(NullOrigin, nil, cache)
+			}
+			BeforeEach(func() {
+				requests = nil
+				cache = NewMemoryHTTPCache()
+				server.RouteToHandler("GET", "/etag.dhall",
+					func(w http.ResponseWriter, r *http.Request) {
+						requests = append(requests, r)
+						if r.Header.Get("If-None-Match") == `"v1"` {
+							w.WriteHeader(http.StatusNotModified)
+							return
+						}
+						w.Header().Set("ETag", `"v1"`)
+						io.WriteString(w, "content with etag")
+					},
+				)
+				server.RouteToHandler("GET", "/last-modified.dhall",
+					func(w http.ResponseWriter, r *http.Request) {
+						requests = append(requests, r)
+						if r.Header.Get("If-Modified-Since") == "Wed, 21 Oct 2015 07:28:00 GMT" {
+							w.WriteHeader(http.StatusNotModified)
+							return
+						}
+						w.Header().Set("Last-Modified", "Wed, 21 Oct 2015 07:28:00 GMT")
+						io.WriteString(w, "content with last-modified")
+					},
+				)
+			})
+			It("serves a 304 from the cache using If-None-Match", func() {
+				first, err := fetch("/etag.dhall")
+				Expect(err).ToNot(HaveOccurred())
+				second, err := fetch("/etag.dhall")
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(first).To(Equal("content with etag"))
+				Expect(second).To(Equal("content with etag"))
+				Expect(requests).To(HaveLen(2))
+				Expect(requests[0].Header.Get("If-None-Match")).To(BeEmpty())
+				Expect(requests[1].Header.Get("If-None-Match")).To(Equal(`"v1"`))
+			})
+			It("serves a 304 from the cache using If-Modified-Since", func() {
+				_, err := fetch("/last-modified.dhall")
+				Expect(err).ToNot(HaveOccurred())
+				actual, err := fetch("/last-modified.dhall")
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(actual).To(Equal("content with last-modified"))
+				Expect(requests).To(HaveLen(2))
+				Expect(requests[1].Header.Get("If-Modified-Since")).
+					To(Equal("Wed, 21 Oct 2015 07:28:00 GMT"))
+			})
+			It("doesn't cache responses without validators", func() {
+				_, err := fetch("/no-cors.dhall")
+				Expect(err).ToNot(HaveOccurred())
+
+				_, ok := cache.Get(server.URL() + "/no-cors.dhall")
+				Expect(ok).To(BeFalse())
+			})
+		})
 	})
 })
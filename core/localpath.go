@@ -0,0 +1,98 @@
+package core
+
+import (
+	"os"
+	"runtime"
+	"strings"
+)
+
+// LocalFromFilePath returns the Local import of the file at p, which
+// is a path in the operating system's syntax, such as
+// `C:\dhall\package.dhall` on Windows.  It can be passed as the
+// ancestor of a Term read from that file, so that its relative
+// imports are resolved against the file's directory.
+//
+// A Local is always written with `/` separators, as in Dhall source.
+// On Windows, an absolute Local's first component is the volume: the
+// drive letter, as in `/C:/dhall/package.dhall`, or `UNC` followed by
+// the server and share, as in `/UNC/server/share/package.dhall` for
+// `\\server\share\package.dhall`, after Windows' own `\\?\UNC\`
+// syntax.
+func LocalFromFilePath(p string) Local {
+	return localFromFilePath(runtime.GOOS == "windows", p)
+}
+
+// FilePath returns the path of the file l refers to, in the
+// operating system's syntax.  A home-relative Local is resolved
+// against the user's home directory.
+func (l Local) FilePath() (string, error) {
+	var home string
+	if l.Kind() == LocalHome {
+		var err error
+		if home, err = os.UserHomeDir(); err != nil {
+			return "", err
+		}
+	}
+	return l.filePath(runtime.GOOS == "windows", home), nil
+}
+
+// localFromFilePath is LocalFromFilePath for Windows paths if windows
+// is set, or POSIX paths otherwise.
+func localFromFilePath(windows bool, p string) Local {
+	if windows {
+		p = strings.ReplaceAll(p, `\`, "/")
+		switch {
+		case strings.HasPrefix(p, "//"):
+			return NewLocal(LocalAbsolute, "UNC", p[2:])
+		case isDrive(p):
+			return NewLocal(LocalAbsolute, p[:2], p[2:])
+		}
+	}
+	if strings.HasPrefix(p, "/") {
+		return NewLocal(LocalAbsolute, p)
+	}
+	return NewLocal(LocalHere, p)
+}
+
+// filePath is FilePath for Windows if windows is set, or POSIX
+// otherwise, with home as the user's home directory.
+func (l Local) filePath(windows bool, home string) string {
+	sep := "/"
+	if windows {
+		sep = `\`
+	}
+	components := l.PathComponents()
+	join := func(prefix string, components []string) string {
+		if len(components) == 0 {
+			return prefix
+		}
+		return prefix + sep + strings.Join(components, sep)
+	}
+	switch l.Kind() {
+	case LocalParent:
+		return join("..", components)
+	case LocalHome:
+		return join(home, components)
+	case LocalAbsolute:
+		if windows && len(components) > 0 {
+			switch {
+			case isDrive(components[0]):
+				return join(components[0], components[1:])
+			case components[0] == "UNC" && len(components) > 2:
+				return join(`\\`+components[1]+sep+components[2], components[3:])
+			}
+		}
+		return sep + strings.Join(components, sep)
+	}
+	return join(".", components)
+}
+
+// isDrive reports whether p starts with a Windows drive letter, such
+// as `C:`.
+func isDrive(p string) bool {
+	if len(p) < 2 || p[1] != ':' {
+		return false
+	}
+	letter := p[0] | 0x20
+	return 'a' <= letter && letter <= 'z' && (len(p) == 2 || p[2] == '/' || p[2] == '\\')
+}
@@ -0,0 +1,59 @@
+package core
+
+import (
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = DescribeTable("Local from a Windows path",
+	func(windowsPath string, local Local, roundTrip string) {
+		Expect(localFromFilePath(true, windowsPath)).To(Equal(local))
+		Expect(local.filePath(true, `C:\Users\alice`)).To(Equal(roundTrip))
+	},
+	Entry("drive letter", `C:\dhall\package.dhall`, Local("/C:/dhall/package.dhall"), `C:\dhall\package.dhall`),
+	Entry("drive letter with forward slashes", `d:/dhall/package.dhall`, Local("/d:/dhall/package.dhall"), `d:\dhall\package.dhall`),
+	Entry("UNC", `\\server\share\dhall\package.dhall`, Local("/UNC/server/share/dhall/package.dhall"), `\\server\share\dhall\package.dhall`),
+	Entry("relative", `dhall\package.dhall`, Local("dhall/package.dhall"), `.\dhall\package.dhall`),
+	Entry("relative to here", `.\dhall\package.dhall`, Local("dhall/package.dhall"), `.\dhall\package.dhall`),
+	Entry("relative to parent", `..\dhall\package.dhall`, Local("../dhall/package.dhall"), `..\dhall\package.dhall`),
+	Entry("rooted on the current drive", `\dhall\package.dhall`, Local("/dhall/package.dhall"), `\dhall\package.dhall`),
+)
+
+var _ = DescribeTable("Local to a file path",
+	func(local Local, windows bool, expected string) {
+		Expect(local.filePath(windows, filepath.FromSlash("/home/alice"))).To(Equal(expected))
+	},
+	Entry("here", Local("./a/b.dhall"), false, "./a/b.dhall"),
+	Entry("here without ./", Local("a/b.dhall"), false, "./a/b.dhall"),
+	Entry("parent", Local("../../a.dhall"), false, "../../a.dhall"),
+	Entry("absolute", Local("/etc/a.dhall"), false, "/etc/a.dhall"),
+	Entry("home", Local("~/a.dhall"), false, filepath.FromSlash("/home/alice")+"/a.dhall"),
+	Entry("a drive letter is an ordinary directory on POSIX", Local("/C:/a.dhall"), false, "/C:/a.dhall"),
+	Entry("here on Windows", Local("./a/b.dhall"), true, `.\a\b.dhall`),
+	Entry("home on Windows", Local("~/a.dhall"), true, filepath.FromSlash("/home/alice")+`\a.dhall`),
+)
+
+var _ = Describe("Chaining onto a Windows path", func() {
+	base := localFromFilePath(true, `C:\projects\app\package.dhall`)
+	DescribeTable("resolves imports against the file's directory",
+		func(local Local, expected string) {
+			chained, err := local.ChainOnto(base)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(chained.(Local).filePath(true, "")).To(Equal(expected))
+		},
+		Entry("./types.dhall", Local("./types.dhall"), `C:\projects\app\types.dhall`),
+		Entry("../shared/types.dhall", Local("../shared/types.dhall"), `C:\projects\shared\types.dhall`),
+	)
+})
+
+var _ = Describe("LocalFromFilePath", func() {
+	It("reads the operating system's paths", func() {
+		p := filepath.Join("testdata", "foo")
+		local := LocalFromFilePath(p)
+		Expect(local).To(Equal(Local("testdata/foo")))
+		Expect(local.Fetch(NullOrigin)).To(Equal("Content of file 'foo'\n"))
+	})
+})
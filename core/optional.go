@@ -0,0 +1,26 @@
+package core
+
+// SomeTerm returns the Term `Some v`.
+func SomeTerm(v Term) Term {
+	return Some{Val: v}
+}
+
+// NoneTerm returns the Term `None typ`, the empty Optional whose
+// contents would have type typ.
+func NoneTerm(typ Term) Term {
+	return Apply(None, typ)
+}
+
+// MatchOptional reports whether v is an Optional value.  If so, it
+// returns the contents of `Some x`, or nil for `None T`.
+func MatchOptional(v Value) (some Value, ok bool) {
+	switch v := v.(type) {
+	case SomeVal:
+		return v.Val, true
+	case AppValue:
+		if v.Fn == None {
+			return nil, true
+		}
+	}
+	return nil, false
+}
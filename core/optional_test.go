@@ -0,0 +1,44 @@
+package core_test
+
+import (
+	. "github.com/philandstuff/dhall-golang/core"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Optional", func() {
+	It("round-trips Some through build, eval and match", func() {
+		some := SomeTerm(NaturalPlus(NaturalLit(1), NaturalLit(2)))
+		someType, err := TypeOf(some)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(Quote(someType)).To(Equal(Apply(Optional, Natural)))
+
+		contents, ok := MatchOptional(Eval(some))
+		Expect(ok).To(BeTrue())
+		Expect(contents).To(Equal(NaturalLit(3)))
+	})
+	It("round-trips None through build, eval and match", func() {
+		none := NoneTerm(Natural)
+		noneType, err := TypeOf(none)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(Quote(noneType)).To(Equal(Apply(Optional, Natural)))
+
+		contents, ok := MatchOptional(Eval(none))
+		Expect(ok).To(BeTrue())
+		Expect(contents).To(BeNil())
+	})
+	It("matches None built with a redex for its type", func() {
+		contents, ok := MatchOptional(Eval(NoneTerm(Apply(NewLambda("a", Type, NewVar("a")), Bool))))
+		Expect(ok).To(BeTrue())
+		Expect(contents).To(BeNil())
+	})
+	It("doesn't match other values", func() {
+		_, ok := MatchOptional(Eval(NaturalLit(3)))
+		Expect(ok).To(BeFalse())
+		_, ok = MatchOptional(Eval(None))
+		Expect(ok).To(BeFalse())
+		_, ok = MatchOptional(Eval(NewVar("x")))
+		Expect(ok).To(BeFalse())
+	})
+})
@@ -149,6 +149,18 @@ func quoteWith(ctx quoteContext, v Value) Term {
 			Type:  quoteWith(ctx, v.Domain),
 			Body:  quoteWith(ctx.extend(v.Label), bodyVal),
 		}
+	case letVal:
+		binding := Binding{Variable: v.Label, Value: quoteWith(ctx, v.Value)}
+		if v.Annotation != nil {
+			binding.Annotation = quoteWith(ctx, v.Annotation)
+		}
+		bodyVal := v.Body(quoteVar{Name: v.Label, Index: ctx[v.Label]})
+		body := quoteWith(ctx.extend(v.Label), bodyVal)
+		// gather consecutive lets back into one
+		if inner, ok := body.(Let); ok {
+			return NewLet(inner.Body, append([]Binding{binding}, inner.Bindings...)...)
+		}
+		return NewLet(body, binding)
 	case PiValue:
 		bodyVal := v.Range(quoteVar{Name: v.Label, Index: ctx[v.Label]})
 		return PiTerm{
@@ -71,3 +71,84 @@ var _ = DescribeTable("Quote",
 		EmptyListVal{Type: AppValue{Fn: List, Arg: Natural}},
 		EmptyList{Type: AppTerm{Fn: List, Arg: Natural}}),
 )
+
+// Every Value that evalWith can produce must be quotable.  Each entry
+// is a normal form which evaluates to a different kind of Value, so
+// quoting it should give back exactly the original Term.
+var _ = DescribeTable("Quote after Eval",
+	func(t Term) {
+		Expect(Quote(Eval(t))).To(Equal(t))
+	},
+	Entry("Universe", Type),
+	Entry("Builtin", Natural),
+	Entry("Natural/build", NaturalBuild),
+	Entry("Natural/even", NaturalEven),
+	Entry("Natural/fold", NaturalFold),
+	Entry("Natural/fold x", Apply(NaturalFold, x)),
+	Entry("Natural/fold x Bool", Apply(NaturalFold, x, Bool)),
+	Entry("Natural/fold x Bool y", Apply(NaturalFold, x, Bool, NewVar("y"))),
+	Entry("Natural/fold x Bool y z",
+		Apply(NaturalFold, x, Bool, NewVar("y"), NewVar("z"))),
+	Entry("Natural/isZero", NaturalIsZero),
+	Entry("Natural/odd", NaturalOdd),
+	Entry("Natural/show", NaturalShow),
+	Entry("Natural/subtract", NaturalSubtract),
+	Entry("Natural/subtract x", Apply(NaturalSubtract, x)),
+	Entry("Natural/subtract x y", Apply(NaturalSubtract, x, NewVar("y"))),
+	Entry("Natural/toInteger", NaturalToInteger),
+	Entry("Integer/show", IntegerShow),
+	Entry("Integer/toDouble", IntegerToDouble),
+	Entry("Double/show", DoubleShow),
+	Entry("Optional/build", OptionalBuild),
+	Entry("Optional/build Bool", Apply(OptionalBuild, Bool)),
+	Entry("Optional/fold", OptionalFold),
+	Entry("Optional/fold Bool x Natural",
+		Apply(OptionalFold, Bool, x, Natural)),
+	Entry("Optional/fold Bool x Natural y z",
+		Apply(OptionalFold, Bool, x, Natural, NewVar("y"), NewVar("z"))),
+	Entry("Text/show", TextShow),
+	Entry("List/build", ListBuild),
+	Entry("List/build Bool", Apply(ListBuild, Bool)),
+	Entry("List/fold", ListFold),
+	Entry("List/fold Bool x Natural y z",
+		Apply(ListFold, Bool, x, Natural, NewVar("y"), NewVar("z"))),
+	Entry("List/length Bool", Apply(ListLength, Bool)),
+	Entry("List/head Bool", Apply(ListHead, Bool)),
+	Entry("List/last Bool", Apply(ListLast, Bool)),
+	Entry("List/indexed Bool", Apply(ListIndexed, Bool)),
+	Entry("List/reverse Bool", Apply(ListReverse, Bool)),
+	Entry("List/reverse Bool x", Apply(ListReverse, Bool, x)),
+	Entry("free Var", x),
+	Entry("λ(y : Natural) → y", NewLambda("y", Natural, NewVar("y"))),
+	Entry("∀(y : Natural) → Natural", NewPi("y", Natural, Natural)),
+	Entry("x y", Apply(x, NewVar("y"))),
+	Entry("x + y", NaturalPlus(x, NewVar("y"))),
+	Entry("1", NaturalLit(1)),
+	Entry("1.0", DoubleLit(1)),
+	Entry("+1", IntegerLit(1)),
+	Entry("True", True),
+	Entry("[] : List Natural", EmptyList{Apply(List, Natural)}),
+	Entry("[x]", NewList(x)),
+	Entry(`"a${x}b"`, TextLitTerm{
+		Chunks: Chunks{{Prefix: "a", Expr: x}},
+		Suffix: "b",
+	}),
+	Entry("if x then 1 else 2",
+		IfTerm{Cond: x, T: NaturalLit(1), F: NaturalLit(2)}),
+	Entry("Some x", Some{x}),
+	Entry("{ a : Natural }", RecordType{"a": Natural}),
+	Entry("{ a = x }", RecordLit{"a": x}),
+	Entry("toMap x", ToMap{Record: x}),
+	Entry("toMap x : List { mapKey : Text, mapValue : Bool }", ToMap{
+		Record: x,
+		Type:   Apply(List, RecordType{"mapKey": Text, "mapValue": Bool}),
+	}),
+	Entry("x.a", Field{Record: x, FieldName: "a"}),
+	Entry("x.{ a, b }", Project{Record: x, FieldNames: []string{"a", "b"}}),
+	Entry("< A : Natural | B >", UnionType{"A": Natural, "B": nil}),
+	Entry("merge x y", Merge{Handler: x, Union: NewVar("y")}),
+	Entry("merge x y : Natural",
+		Merge{Handler: x, Union: NewVar("y"), Annotation: Natural}),
+	Entry("assert : x ≡ y",
+		Assert{Annotation: OpTerm{OpCode: EquivOp, L: x, R: NewVar("y")}}),
+)
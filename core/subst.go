@@ -22,6 +22,9 @@ func substAtLevel(i int, name string, replacement, t Term) Term {
 		return t
 	case localVar:
 		return t
+	case typedTerm:
+		// already closed
+		return t
 	case LambdaTerm:
 		j := i
 		if t.Label == name {
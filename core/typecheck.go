@@ -19,8 +19,8 @@ func (ctx context) freshLocal(name string) localVar {
 	return localVar{Name: name, Index: len(ctx[name])}
 }
 
-func assertTypeIs(ctx context, expr Term, expectedType Value, msg typeMessage) error {
-	actualType, err := typeWith(ctx, expr)
+func (ev *evaluator) assertTypeIs(ctx context, expr Term, expectedType Value, msg typeMessage) error {
+	actualType, err := ev.typeWith(ctx, expr)
 	if err != nil {
 		return err
 	}
@@ -53,14 +53,32 @@ func functionCheck(input Universe, output Universe) Universe {
 }
 
 func TypeOf(t Term) (Value, error) {
-	v, err := typeWith(context{}, t)
+	return EvalOptions{}.TypeOf(t)
+}
+
+// TypeOf is like the package-level TypeOf, but evaluates according
+// to o.  Evaluation errors, such as a *FoldLimitError, are returned
+// like type errors.
+func (o EvalOptions) TypeOf(t Term) (v Value, err error) {
+	ev := o.evaluator()
+	defer ev.finish(&err)
+	v, err = ev.typeWith(context{}, t)
 	if err != nil {
 		return nil, err
 	}
 	return v, nil
 }
 
-func typeWith(ctx context, t Term) (Value, error) {
+// TypeOfTerm is like TypeOf, but returns the type as a Term.
+func TypeOfTerm(t Term) (Term, error) {
+	v, err := TypeOf(t)
+	if err != nil {
+		return nil, err
+	}
+	return Quote(v), nil
+}
+
+func (ev *evaluator) typeWith(ctx context, t Term) (Value, error) {
 	switch t := t.(type) {
 	case Universe:
 		switch t {
@@ -177,6 +195,8 @@ func typeWith(ctx context, t Term) (Value, error) {
 		}
 	case Var:
 		return nil, mkTypeError(typeCheckBoundVar(t))
+	case typedTerm:
+		return t.Type, nil
 	case localVar:
 		if vals, ok := ctx[t.Name]; ok {
 			if t.Index < len(vals) {
@@ -186,11 +206,11 @@ func typeWith(ctx context, t Term) (Value, error) {
 		}
 		return nil, fmt.Errorf("Unknown variable %s", t.Name)
 	case AppTerm:
-		fnType, err := typeWith(ctx, t.Fn)
+		fnType, err := ev.typeWith(ctx, t.Fn)
 		if err != nil {
 			return nil, err
 		}
-		argType, err := typeWith(ctx, t.Arg)
+		argType, err := ev.typeWith(ctx, t.Arg)
 		if err != nil {
 			return nil, err
 		}
@@ -203,17 +223,17 @@ func typeWith(ctx context, t Term) (Value, error) {
 		if !judgmentallyEqualVals(expectedType, actualType) {
 			return nil, mkTypeError(typeMismatch(Quote(expectedType), Quote(actualType)))
 		}
-		bodyTypeVal := piType.Range(Eval(t.Arg))
+		bodyTypeVal := piType.Range(ev.normalize(t.Arg))
 		return bodyTypeVal, nil
 	case LambdaTerm:
-		_, err := typeWith(ctx, t.Type)
+		_, err := ev.typeWith(ctx, t.Type)
 		if err != nil {
 			return nil, err
 		}
-		argType := Eval(t.Type)
+		argType := ev.normalize(t.Type)
 		pi := PiValue{Label: t.Label, Domain: argType}
 		freshLocal := ctx.freshLocal(t.Label)
-		bt, err := typeWith(
+		bt, err := ev.typeWith(
 			ctx.extend(t.Label, argType),
 			subst(t.Label, freshLocal, t.Body))
 		if err != nil {
@@ -221,17 +241,17 @@ func typeWith(ctx context, t Term) (Value, error) {
 		}
 		pi.Range = func(x Value) Value {
 			rebound := rebindLocal(freshLocal, Quote(bt))
-			return evalWith(rebound, Env{
+			return ev.eval(rebound, Env{
 				t.Label: []Value{x},
-			}, false)
+			})
 		}
-		_, err = typeWith(ctx, Quote(pi))
+		_, err = ev.typeWith(ctx, Quote(pi))
 		if err != nil {
 			return nil, err
 		}
 		return pi, nil
 	case PiTerm:
-		inUniv, err := typeWith(ctx, t.Type)
+		inUniv, err := ev.typeWith(ctx, t.Type)
 		if err != nil {
 			return nil, err
 		}
@@ -240,8 +260,8 @@ func typeWith(ctx context, t Term) (Value, error) {
 			return nil, mkTypeError(invalidInputType)
 		}
 		freshLocal := ctx.freshLocal(t.Label)
-		outUniv, err := typeWith(
-			ctx.extend(t.Label, Eval(t.Type)),
+		outUniv, err := ev.typeWith(
+			ctx.extend(t.Label, ev.normalize(t.Type)),
 			subst(t.Label, freshLocal, t.Body))
 		if err != nil {
 			return nil, err
@@ -259,40 +279,40 @@ func typeWith(ctx context, t Term) (Value, error) {
 			binding := let.Bindings[0]
 			let.Bindings = let.Bindings[1:]
 
-			bindingType, err := typeWith(ctx, binding.Value)
+			bindingType, err := ev.typeWith(ctx, binding.Value)
 			if err != nil {
 				return nil, err
 			}
 
 			if binding.Annotation != nil {
-				_, err := typeWith(ctx, binding.Value)
+				_, err := ev.typeWith(ctx, binding.Annotation)
 				if err != nil {
 					return nil, err
 				}
-				if !judgmentallyEqualVals(bindingType, Eval(binding.Annotation)) {
+				if !judgmentallyEqualVals(bindingType, ev.normalize(binding.Annotation)) {
 					return nil, mkTypeError(annotMismatch(binding.Annotation, Quote(bindingType)))
 				}
 			}
 
-			value := Quote(Eval(binding.Value))
+			value := typedTerm{Term: Quote(ev.normalize(binding.Value)), Type: bindingType}
 			let = subst(binding.Variable, value, let).(Let)
 			ctx = ctx.extend(binding.Variable, bindingType)
 		}
-		return typeWith(ctx, let.Body)
+		return ev.typeWith(ctx, let.Body)
 	case Annot:
 		if t.Annotation != Sort {
 			// Γ ⊢ T₀ : i
-			if _, err := typeWith(ctx, t.Annotation); err != nil {
+			if _, err := ev.typeWith(ctx, t.Annotation); err != nil {
 				return nil, err
 			}
 		}
 		// Γ ⊢ t : T₁
-		actualType, err := typeWith(ctx, t.Expr)
+		actualType, err := ev.typeWith(ctx, t.Expr)
 		if err != nil {
 			return nil, err
 		}
 		// T₀ ≡ T₁
-		if !judgmentallyEqualVals(Eval(t.Annotation), actualType) {
+		if !judgmentallyEqualVals(ev.normalize(t.Annotation), actualType) {
 			return nil, mkTypeError(annotMismatch(t.Annotation, Quote(actualType)))
 		}
 		// ─────────────────
@@ -302,7 +322,7 @@ func typeWith(ctx context, t Term) (Value, error) {
 		return Double, nil
 	case TextLitTerm:
 		for _, chunk := range t.Chunks {
-			err := assertTypeIs(ctx, chunk.Expr, Text,
+			err := ev.assertTypeIs(ctx, chunk.Expr, Text,
 				cantInterpolate)
 			if err != nil {
 				return nil, err
@@ -312,26 +332,26 @@ func typeWith(ctx context, t Term) (Value, error) {
 	case BoolLit:
 		return Bool, nil
 	case IfTerm:
-		condType, err := typeWith(ctx, t.Cond)
+		condType, err := ev.typeWith(ctx, t.Cond)
 		if err != nil {
 			return nil, err
 		}
 		if condType != Bool {
 			return nil, mkTypeError(invalidPredicate)
 		}
-		L, err := typeWith(ctx, t.T)
+		L, err := ev.typeWith(ctx, t.T)
 		if err != nil {
 			return nil, err
 		}
 		// no need to check for err here
-		if t, _ := typeWith(ctx, Quote(L)); t != Type {
+		if t, _ := ev.typeWith(ctx, Quote(L)); t != Type {
 			return nil, mkTypeError(ifBranchMustBeTerm)
 		}
-		R, err := typeWith(ctx, t.F)
+		R, err := ev.typeWith(ctx, t.F)
 		if err != nil {
 			return nil, err
 		}
-		if t, _ := typeWith(ctx, Quote(R)); t != Type {
+		if t, _ := ev.typeWith(ctx, Quote(R)); t != Type {
 			return nil, mkTypeError(ifBranchMustBeTerm)
 		}
 		if !judgmentallyEqualVals(L, R) {
@@ -343,41 +363,41 @@ func typeWith(ctx context, t Term) (Value, error) {
 	case OpTerm:
 		switch t.OpCode {
 		case OrOp, AndOp, EqOp, NeOp:
-			err := assertTypeIs(ctx, t.L, Bool, cantBoolOp(t.OpCode))
+			err := ev.assertTypeIs(ctx, t.L, Bool, cantBoolOp(t.OpCode))
 			if err != nil {
 				return nil, err
 			}
-			err = assertTypeIs(ctx, t.R, Bool, cantBoolOp(t.OpCode))
+			err = ev.assertTypeIs(ctx, t.R, Bool, cantBoolOp(t.OpCode))
 			if err != nil {
 				return nil, err
 			}
 			return Bool, nil
 		case PlusOp, TimesOp:
-			err := assertTypeIs(ctx, t.L, Natural, cantNaturalOp(t.OpCode))
+			err := ev.assertTypeIs(ctx, t.L, Natural, cantNaturalOp(t.OpCode))
 			if err != nil {
 				return nil, err
 			}
-			err = assertTypeIs(ctx, t.R, Natural, cantNaturalOp(t.OpCode))
+			err = ev.assertTypeIs(ctx, t.R, Natural, cantNaturalOp(t.OpCode))
 			if err != nil {
 				return nil, err
 			}
 			return Natural, nil
 		case TextAppendOp:
-			err := assertTypeIs(ctx, t.L, Text, cantTextAppend)
+			err := ev.assertTypeIs(ctx, t.L, Text, cantTextAppend)
 			if err != nil {
 				return nil, err
 			}
-			err = assertTypeIs(ctx, t.R, Text, cantTextAppend)
+			err = ev.assertTypeIs(ctx, t.R, Text, cantTextAppend)
 			if err != nil {
 				return nil, err
 			}
 			return Text, nil
 		case ListAppendOp:
-			lt, err := typeWith(ctx, t.L)
+			lt, err := ev.typeWith(ctx, t.L)
 			if err != nil {
 				return nil, err
 			}
-			rt, err := typeWith(ctx, t.R)
+			rt, err := ev.typeWith(ctx, t.R)
 			if err != nil {
 				return nil, err
 			}
@@ -395,33 +415,33 @@ func typeWith(ctx context, t Term) (Value, error) {
 			}
 			return lt, nil
 		case RecordMergeOp:
-			lType, err := typeWith(ctx, t.L)
+			lType, err := ev.typeWith(ctx, t.L)
 			if err != nil {
 				return nil, err
 			}
-			rType, err := typeWith(ctx, t.R)
+			rType, err := ev.typeWith(ctx, t.R)
 			if err != nil {
 				return nil, err
 			}
 			recordType := OpTerm{L: Quote(lType), R: Quote(rType), OpCode: RecordTypeMergeOp}
-			if _, err = typeWith(ctx, recordType); err != nil {
+			if _, err = ev.typeWith(ctx, recordType); err != nil {
 				return nil, err
 			}
-			return Eval(recordType), nil
+			return ev.normalize(recordType), nil
 		case RecordTypeMergeOp:
-			lKind, err := typeWith(ctx, t.L)
+			lKind, err := ev.typeWith(ctx, t.L)
 			if err != nil {
 				return nil, err
 			}
-			rKind, err := typeWith(ctx, t.R)
+			rKind, err := ev.typeWith(ctx, t.R)
 			if err != nil {
 				return nil, err
 			}
-			lt, ok := Eval(t.L).(RecordTypeVal)
+			lt, ok := ev.normalize(t.L).(RecordTypeVal)
 			if !ok {
 				return nil, mkTypeError(combineTypesRequiresRecordType)
 			}
-			rt, ok := Eval(t.R).(RecordTypeVal)
+			rt, ok := ev.normalize(t.R).(RecordTypeVal)
 			if !ok {
 				return nil, mkTypeError(combineTypesRequiresRecordType)
 			}
@@ -434,11 +454,11 @@ func typeWith(ctx context, t Term) (Value, error) {
 			}
 			return rKind, nil
 		case RightBiasedRecordMergeOp:
-			lType, err := typeWith(ctx, t.L)
+			lType, err := ev.typeWith(ctx, t.L)
 			if err != nil {
 				return nil, err
 			}
-			rType, err := typeWith(ctx, t.R)
+			rType, err := ev.typeWith(ctx, t.R)
 			if err != nil {
 				return nil, err
 			}
@@ -459,21 +479,21 @@ func typeWith(ctx context, t Term) (Value, error) {
 			}
 			return result, nil
 		case ImportAltOp:
-			return typeWith(ctx, t.L)
+			return ev.typeWith(ctx, t.L)
 		case EquivOp:
-			lType, err := typeWith(ctx, t.L)
+			lType, err := ev.typeWith(ctx, t.L)
 			if err != nil {
 				return nil, err
 			}
-			rType, err := typeWith(ctx, t.R)
+			rType, err := ev.typeWith(ctx, t.R)
 			if err != nil {
 				return nil, err
 			}
-			err = assertTypeIs(ctx, Quote(lType), Type, incomparableExpression)
+			err = ev.assertTypeIs(ctx, Quote(lType), Type, incomparableExpression)
 			if err != nil {
 				return nil, err
 			}
-			err = assertTypeIs(ctx, Quote(lType), Type, incomparableExpression)
+			err = ev.assertTypeIs(ctx, Quote(rType), Type, incomparableExpression)
 			if err != nil {
 				return nil, err
 			}
@@ -482,7 +502,7 @@ func typeWith(ctx context, t Term) (Value, error) {
 			}
 			return Type, nil
 		case CompleteOp:
-			return typeWith(ctx,
+			return ev.typeWith(ctx,
 				Annot{
 					Expr: OpTerm{OpCode: RightBiasedRecordMergeOp,
 						L: Field{Record: t.L, FieldName: "default"},
@@ -495,27 +515,27 @@ func typeWith(ctx context, t Term) (Value, error) {
 			return nil, fmt.Errorf("Internal error: unknown opcode %v", t.OpCode)
 		}
 	case EmptyList:
-		_, err := typeWith(ctx, t.Type)
+		_, err := ev.typeWith(ctx, t.Type)
 		if err != nil {
 			return nil, err
 		}
-		listType := Eval(t.Type)
+		listType := ev.normalize(t.Type)
 		_, ok := listElementType(listType)
 		if !ok {
 			return nil, mkTypeError(invalidListType)
 		}
 		return listType, nil
 	case NonEmptyList:
-		T0, err := typeWith(ctx, t[0])
+		T0, err := ev.typeWith(ctx, t[0])
 		if err != nil {
 			return nil, err
 		}
-		err = assertTypeIs(ctx, Quote(T0), Type, invalidListType)
+		err = ev.assertTypeIs(ctx, Quote(T0), Type, invalidListType)
 		if err != nil {
 			return nil, err
 		}
 		for _, e := range t[1:] {
-			T1, err := typeWith(ctx, e)
+			T1, err := ev.typeWith(ctx, e)
 			if err != nil {
 				return nil, err
 			}
@@ -525,18 +545,18 @@ func typeWith(ctx context, t Term) (Value, error) {
 		}
 		return AppValue{List, T0}, nil
 	case Some:
-		A, err := typeWith(ctx, t.Val)
+		A, err := ev.typeWith(ctx, t.Val)
 		if err != nil {
 			return nil, err
 		}
-		if err = assertTypeIs(ctx, Quote(A), Type, invalidSome); err != nil {
+		if err = ev.assertTypeIs(ctx, Quote(A), Type, invalidSome); err != nil {
 			return nil, err
 		}
 		return AppValue{Optional, A}, nil
 	case RecordType:
 		recordUniverse := Type
 		for _, v := range t {
-			fieldUniverse, err := typeWith(ctx, v)
+			fieldUniverse, err := ev.typeWith(ctx, v)
 			if err != nil {
 				return nil, err
 			}
@@ -552,18 +572,18 @@ func typeWith(ctx context, t Term) (Value, error) {
 	case RecordLit:
 		recordType := RecordTypeVal{}
 		for k, v := range t {
-			fieldType, err := typeWith(ctx, v)
+			fieldType, err := ev.typeWith(ctx, v)
 			if err != nil {
 				return nil, err
 			}
 			recordType[k] = fieldType
 		}
-		if _, err := typeWith(ctx, Quote(recordType)); err != nil {
+		if _, err := ev.typeWith(ctx, Quote(recordType)); err != nil {
 			return nil, err
 		}
 		return recordType, nil
 	case ToMap:
-		recordTypeVal, err := typeWith(ctx, t.Record)
+		recordTypeVal, err := ev.typeWith(ctx, t.Record)
 		if err != nil {
 			return nil, err
 		}
@@ -576,11 +596,11 @@ func typeWith(ctx context, t Term) (Value, error) {
 			if t.Type == nil {
 				return nil, mkTypeError(missingToMapType)
 			}
-			err = assertTypeIs(ctx, t.Type, Type, invalidToMapRecordKind)
+			err = ev.assertTypeIs(ctx, t.Type, Type, invalidToMapRecordKind)
 			if err != nil {
 				return nil, err
 			}
-			tVal := Eval(t.Type)
+			tVal := ev.normalize(t.Type)
 			t, ok := listElementType(tVal)
 			if !ok {
 				return nil, mkTypeError(invalidToMapType(Quote(tVal)))
@@ -602,23 +622,23 @@ func typeWith(ctx context, t Term) (Value, error) {
 				}
 			}
 		}
-		if k, _ := typeWith(ctx, Quote(elemType)); k != Type {
+		if k, _ := ev.typeWith(ctx, Quote(elemType)); k != Type {
 			return nil, mkTypeError(invalidToMapRecordKind)
 		}
 		inferred := AppValue{List, RecordTypeVal{"mapKey": Text, "mapValue": elemType}}
 		if t.Type == nil {
 			return inferred, nil
 		}
-		if _, err = typeWith(ctx, t.Type); err != nil {
+		if _, err = ev.typeWith(ctx, t.Type); err != nil {
 			return nil, err
 		}
-		annot := Eval(t.Type)
+		annot := ev.normalize(t.Type)
 		if !judgmentallyEqualVals(inferred, annot) {
 			return nil, mkTypeError(mapTypeMismatch(Quote(inferred), t.Type))
 		}
 		return inferred, nil
 	case Field:
-		recordTypeVal, err := typeWith(ctx, t.Record)
+		recordTypeVal, err := ev.typeWith(ctx, t.Record)
 		if err != nil {
 			return nil, err
 		}
@@ -630,7 +650,7 @@ func typeWith(ctx context, t Term) (Value, error) {
 			}
 			return fieldType, nil
 		}
-		unionTypeV := Eval(t.Record)
+		unionTypeV := ev.normalize(t.Record)
 		unionType, ok := unionTypeV.(unionTypeVal)
 		if !ok {
 			return nil, mkTypeError(cantAccess)
@@ -648,7 +668,7 @@ func typeWith(ctx context, t Term) (Value, error) {
 			Range:  func(Value) Value { return unionType },
 		}, nil
 	case Project:
-		recordTypeVal, err := typeWith(ctx, t.Record)
+		recordTypeVal, err := ev.typeWith(ctx, t.Record)
 		if err != nil {
 			return nil, err
 		}
@@ -666,7 +686,7 @@ func typeWith(ctx context, t Term) (Value, error) {
 		}
 		return result, nil
 	case ProjectType:
-		recordTypeVal, err := typeWith(ctx, t.Record)
+		recordTypeVal, err := ev.typeWith(ctx, t.Record)
 		if err != nil {
 			return nil, err
 		}
@@ -674,11 +694,11 @@ func typeWith(ctx context, t Term) (Value, error) {
 		if !ok {
 			return nil, mkTypeError(cantProject)
 		}
-		_, err = typeWith(ctx, t.Selector)
+		_, err = ev.typeWith(ctx, t.Selector)
 		if err != nil {
 			return nil, err
 		}
-		selectorVal := Eval(t.Selector)
+		selectorVal := ev.normalize(t.Selector)
 		selector, ok := selectorVal.(RecordTypeVal)
 		if !ok {
 			return nil, mkTypeError(cantProjectByExpression)
@@ -706,7 +726,7 @@ func typeWith(ctx context, t Term) (Value, error) {
 				// empty alternative
 				continue
 			}
-			k, err := typeWith(ctx, typ)
+			k, err := ev.typeWith(ctx, typ)
 			if err != nil {
 				return nil, err
 			}
@@ -722,7 +742,7 @@ func typeWith(ctx context, t Term) (Value, error) {
 				}
 			}
 			if c == Sort {
-				if Eval(typ) != Kind {
+				if ev.normalize(typ) != Kind {
 					return nil, mkTypeError(invalidAlternativeType)
 				}
 			}
@@ -730,11 +750,11 @@ func typeWith(ctx context, t Term) (Value, error) {
 		}
 		return c, nil
 	case Merge:
-		handlerTypeVal, err := typeWith(ctx, t.Handler)
+		handlerTypeVal, err := ev.typeWith(ctx, t.Handler)
 		if err != nil {
 			return nil, err
 		}
-		unionTypeV, err := typeWith(ctx, t.Union)
+		unionTypeV, err := ev.typeWith(ctx, t.Union)
 		if err != nil {
 			return nil, err
 		}
@@ -743,28 +763,52 @@ func typeWith(ctx context, t Term) (Value, error) {
 			return nil, mkTypeError(mustMergeARecord)
 		}
 		unionType, ok := unionTypeV.(unionTypeVal)
+		if optional, isApp := unionTypeV.(AppValue); isApp && optional.Fn == Optional {
+			// merge treats `Optional T` as `< None | Some : T >`
+			unionType, ok = unionTypeVal{"None": nil, "Some": optional.Arg}, true
+		}
 		if !ok {
 			return nil, mkTypeError(mustMergeUnion)
 		}
+		var defaultType Value
+		if ev.mergeDefaultHandlers {
+			if t, ok := handlerType[DefaultHandler]; ok {
+				if _, ok := unionType[DefaultHandler]; ok {
+					return nil, mkTypeError(defaultHandlerCollision)
+				}
+				defaultType = t
+				explicit := make(RecordTypeVal, len(handlerType)-1)
+				for k, v := range handlerType {
+					if k != DefaultHandler {
+						explicit[k] = v
+					}
+				}
+				handlerType = explicit
+			}
+		}
 		if len(handlerType) > len(unionType) {
 			return nil, mkTypeError(unusedHandler)
 		}
 
-		if len(handlerType) == 0 {
+		if len(handlerType) == 0 && defaultType == nil {
 			if t.Annotation == nil {
 				return nil, mkTypeError(missingMergeType)
 			}
-			if _, err := typeWith(ctx, t.Annotation); err != nil {
+			if _, err := ev.typeWith(ctx, t.Annotation); err != nil {
 				return nil, err
 			}
-			return Eval(t.Annotation), nil
+			return ev.normalize(t.Annotation), nil
 		}
 
-		var result Value
+		result := defaultType
 		for altName, altType := range unionType {
 			fieldType, ok := handlerType[altName]
 			if !ok {
-				return nil, mkTypeError(missingHandler)
+				if defaultType == nil {
+					return nil, mkTypeError(missingHandler)
+				}
+				// the default handler ignores any payload
+				continue
 			}
 			if altType == nil {
 				if result == nil {
@@ -798,20 +842,20 @@ func typeWith(ctx context, t Term) (Value, error) {
 			}
 		}
 		if t.Annotation != nil {
-			if _, err := typeWith(ctx, t.Annotation); err != nil {
+			if _, err := ev.typeWith(ctx, t.Annotation); err != nil {
 				return nil, err
 			}
-			if !judgmentallyEqualVals(result, Eval(t.Annotation)) {
+			if !judgmentallyEqualVals(result, ev.normalize(t.Annotation)) {
 				return nil, mkTypeError(annotMismatch(t.Annotation, Quote(result)))
 			}
 		}
 		return result, nil
 	case Assert:
-		err := assertTypeIs(ctx, t.Annotation, Type, notAnEquivalence)
+		err := ev.assertTypeIs(ctx, t.Annotation, Type, notAnEquivalence)
 		if err != nil {
 			return nil, err
 		}
-		op, ok := Eval(t.Annotation).(opValue)
+		op, ok := ev.normalize(t.Annotation).(opValue)
 		if !ok || op.OpCode != EquivOp {
 			return nil, mkTypeError(notAnEquivalence)
 		}
@@ -819,6 +863,8 @@ func typeWith(ctx context, t Term) (Value, error) {
 			return nil, mkTypeError(assertionFailed(Quote(op.L), Quote(op.R)))
 		}
 		return op, nil
+	case Import:
+		return nil, &UnresolvedImportError{Import: t.Fetchable}
 	}
 	return nil, mkTypeError(unhandledTypeCase)
 }
@@ -1031,6 +1077,8 @@ var (
 	handlerNotAFunction   = staticTypeMessage{"Handler is not a function"}
 	disallowedHandlerType = staticTypeMessage{"Disallowed handler type"}
 
+	defaultHandlerCollision = staticTypeMessage{"Default handler ❰_❱ collides with a union alternative"}
+
 	cantInterpolate = staticTypeMessage{"You can only interpolate ❰Text❱"}
 
 	cantTextAppend     = staticTypeMessage{"❰++❱ only works on ❰Text❱"}
@@ -1046,6 +1094,7 @@ var (
 	cantProjectByExpression = staticTypeMessage{"Selector is not a record type"}
 	missingField            = staticTypeMessage{"Missing record field"}
 	missingConstructor      = staticTypeMessage{"Missing constructor"}
+	notAUnionType           = staticTypeMessage{"Not a union type"}
 
 	unhandledTypeCase = staticTypeMessage{"Internal error: unhandled case in TypeOf()"}
 
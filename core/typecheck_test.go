@@ -1,6 +1,8 @@
 package core
 
 import (
+	"testing"
+
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
@@ -74,8 +76,33 @@ var _ = Describe("TypeOf", func() {
 	DescribeTable("Others",
 		typecheckTest,
 		Entry(`3 : Natural`, NaturalLit(3), Natural),
+		Entry(`merge { None = 0, Some = λ(n : Natural) → n } (Some 5)`,
+			Merge{
+				Handler: RecordLit{"None": NaturalLit(0), "Some": NewLambda("n", Natural, NewVar("n"))},
+				Union:   Some{NaturalLit(5)},
+			},
+			Natural),
+		Entry(`merge { None = False, Some = λ(b : Bool) → b } (None Bool) : Bool`,
+			Merge{
+				Handler:    RecordLit{"None": False, "Some": NewLambda("b", Bool, NewVar("b"))},
+				Union:      Apply(None, Bool),
+				Annotation: Bool,
+			},
+			Bool),
 		Entry(`[] : List Natural : List Natural`,
 			EmptyList{Apply(List, Natural)}, AppValue{List, Natural}),
+		Entry(`toMap {a = 1} : List {mapKey : Text, mapValue : Natural}`,
+			ToMap{
+				Record: RecordLit{"a": NaturalLit(1)},
+				Type:   Apply(List, RecordType{"mapKey": Text, "mapValue": Natural}),
+			},
+			AppValue{List, RecordTypeVal{"mapKey": Text, "mapValue": Natural}}),
+		Entry(`toMap {=} : List {mapKey : Text, mapValue : Bool}`,
+			ToMap{
+				Record: RecordLit{},
+				Type:   Apply(List, RecordType{"mapKey": Text, "mapValue": Bool}),
+			},
+			AppValue{List, RecordTypeVal{"mapKey": Text, "mapValue": Bool}}),
 	)
 	DescribeTable("Expected failures",
 		func(t Term) {
@@ -100,5 +127,195 @@ var _ = Describe("TypeOf", func() {
 			Apply(List, NaturalLit(3))),
 		Entry(`Natural Natural -- Fn of AppTerm isn't of function type`,
 			Apply(Natural, Natural)),
+
+		// Merge
+		Entry(`merge { None = 0 } (Some 5) -- missing Some handler`,
+			Merge{Handler: RecordLit{"None": NaturalLit(0)}, Union: Some{NaturalLit(5)}}),
+		Entry(`merge { None = 0, Some = λ(b : Bool) → 1 } (Some 5) -- wrong Some handler type`,
+			Merge{
+				Handler: RecordLit{"None": NaturalLit(0), "Some": NewLambda("b", Bool, NaturalLit(1))},
+				Union:   Some{NaturalLit(5)},
+			}),
+
+		// ToMap
+		Entry(`toMap {a = 1} : List {mapKey : Text, mapValue : Bool} -- annotation doesn't match`,
+			ToMap{
+				Record: RecordLit{"a": NaturalLit(1)},
+				Type:   Apply(List, RecordType{"mapKey": Text, "mapValue": Bool}),
+			}),
+		Entry(`toMap {=} -- empty toMap needs an annotation`,
+			ToMap{Record: RecordLit{}}),
+		Entry(`toMap {=} : List Bool -- annotation isn't a list of map entries`,
+			ToMap{Record: RecordLit{}, Type: Apply(List, Bool)}),
+		Entry(`toMap {=} : List {mapKey : Bool, mapValue : Bool} -- mapKey isn't Text`,
+			ToMap{
+				Record: RecordLit{},
+				Type:   Apply(List, RecordType{"mapKey": Bool, "mapValue": Bool}),
+			}),
+	)
+})
+
+var _ = DescribeTable("Let",
+	typecheckTest,
+	Entry(`let id = λ(a : Type) → λ(x : a) → x in id Natural (id Natural 1) : Natural`,
+		NewLet(Apply(NewVar("id"), Natural, Apply(NewVar("id"), Natural, NaturalLit(1))),
+			Binding{Variable: "id", Value: NewLambda("a", Type, NewLambda("x", NewVar("a"), NewVar("x")))}),
+		Natural),
+	Entry(`let T = Natural let x : T = 1 in x : Natural`,
+		NewLet(NewVar("x"),
+			Binding{Variable: "T", Value: Natural},
+			Binding{Variable: "x", Annotation: NewVar("T"), Value: NaturalLit(1)}),
+		Natural),
+	Entry(`let x = 1 in let x = True in x@1 : Natural`,
+		NewLet(NewLet(Var{Name: "x", Index: 1}, Binding{Variable: "x", Value: True}),
+			Binding{Variable: "x", Value: NaturalLit(1)}),
+		Natural),
+	Entry(`λ(n : Natural) → let m = n in m : ∀(n : Natural) → Natural`,
+		NewLambda("n", Natural, NewLet(NewVar("m"), Binding{Variable: "m", Value: NewVar("n")})),
+		NewPiVal("n", Natural, func(Value) Value { return Natural })),
+)
+
+var _ = Describe("Equivalence", func() {
+	DescribeTable("typechecks as Type",
+		typecheckTest,
+		Entry(`1 ≡ 1`, OpTerm{OpCode: EquivOp, L: NaturalLit(1), R: NaturalLit(1)}, Type),
+		Entry(`1 ≡ 2 -- false equivalences are still types`,
+			OpTerm{OpCode: EquivOp, L: NaturalLit(1), R: NaturalLit(2)}, Type),
+		Entry(`λ(x : Bool) → x ≡ x`,
+			NewLambda("x", Bool, OpTerm{OpCode: EquivOp, L: NewVar("x"), R: NewVar("x")}),
+			NewPiVal("x", Bool, func(Value) Value { return Type })),
+	)
+	DescribeTable("rejects",
+		func(t Term, message typeMessage) {
+			_, err := TypeOf(t)
+			Expect(err).To(MatchError(mkTypeError(message)))
+		},
+		Entry(`1 ≡ True`, OpTerm{OpCode: EquivOp, L: NaturalLit(1), R: True}, equivalenceTypeMismatch),
+		Entry(`Natural ≡ Natural`, OpTerm{OpCode: EquivOp, L: Natural, R: Natural}, incomparableExpression),
+		Entry(`1 ≡ Natural`, OpTerm{OpCode: EquivOp, L: NaturalLit(1), R: Natural}, incomparableExpression),
+		Entry(`assert : 1 + 1 ≡ 3 -- shows both sides normalized`,
+			Assert{OpTerm{OpCode: EquivOp, L: NaturalPlus(NaturalLit(1), NaturalLit(1)), R: NaturalLit(3)}},
+			assertionFailed(NaturalLit(2), NaturalLit(3))),
+		Entry(`assert : 1 -- not an equivalence`, Assert{NaturalLit(1)}, notAnEquivalence),
 	)
+	It("typechecks a true assertion as its normalized equivalence", func() {
+		t, err := TypeOf(Assert{OpTerm{OpCode: EquivOp, L: NaturalPlus(NaturalLit(1), NaturalLit(1)), R: NaturalLit(2)}})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(Quote(t)).To(Equal(OpTerm{OpCode: EquivOp, L: NaturalLit(2), R: NaturalLit(2)}))
+	})
+	It("names both normalized sides of a failed assertion", func() {
+		_, err := TypeOf(Assert{OpTerm{OpCode: EquivOp, L: NaturalPlus(NaturalLit(1), NaturalLit(1)), R: NaturalLit(3)}})
+		Expect(err).To(MatchError(ContainSubstring("2 is not equivalent to 3")))
+	})
 })
+
+var _ = Describe("Record merges", func() {
+	// { a : { b : { c : Natural } } }
+	nested := func(t Term) Term {
+		return RecordType{"a": RecordType{"b": RecordType{"c": t}}}
+	}
+	It("reports the path to a collision in ⩓", func() {
+		_, err := TypeOf(OpTerm{OpCode: RecordTypeMergeOp, L: nested(Natural), R: nested(Bool)})
+		Expect(err).To(Equal(&MergeError{Path: []string{"a", "b", "c"}, Left: "Natural", Right: "Bool"}))
+	})
+	It("reports the path to a collision in ∧", func() {
+		_, err := TypeOf(OpTerm{OpCode: RecordMergeOp,
+			L: RecordLit{"a": RecordLit{"b": RecordLit{"c": NaturalLit(1)}}},
+			R: RecordLit{"a": RecordLit{"b": RecordLit{"c": RecordLit{}}}}})
+		Expect(err).To(Equal(&MergeError{Path: []string{"a", "b", "c"}, Left: "Natural", Right: "record type"}))
+	})
+})
+
+var _ = Describe("TypeOfTerm", func() {
+	It("returns the type as a Term", func() {
+		Expect(TypeOfTerm(NaturalLit(3))).To(Equal(Natural))
+	})
+	It("quotes function types", func() {
+		Expect(TypeOfTerm(NewLambda("x", Natural, NewVar("x")))).
+			To(Equal(NewPi("x", Natural, Natural)))
+	})
+	It("returns type errors", func() {
+		_, err := TypeOfTerm(Sort)
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("Merge with a default handler", func() {
+	union := UnionType{"A": Natural, "B": nil, "C": Text}
+	handlers := RecordLit{
+		"A": NewLambda("n", Natural, NewVar("n")),
+		"_": NaturalLit(0),
+	}
+	opts := EvalOptions{MergeDefaultHandlers: true}
+
+	It("typechecks when the default covers the remaining alternatives", func() {
+		Expect(opts.TypeOf(Merge{Handler: handlers, Union: Field{union, "B"}})).
+			To(Equal(Natural))
+	})
+	It("dispatches to an explicit handler", func() {
+		Expect(opts.Eval(Merge{
+			Handler: handlers,
+			Union:   Apply(Field{union, "A"}, NaturalLit(5)),
+		})).To(Equal(NaturalLit(5)))
+	})
+	It("dispatches to the default for an empty alternative", func() {
+		Expect(opts.Eval(Merge{Handler: handlers, Union: Field{union, "B"}})).
+			To(Equal(NaturalLit(0)))
+	})
+	It("dispatches to the default, ignoring the payload", func() {
+		Expect(opts.Eval(Merge{
+			Handler: handlers,
+			Union:   Apply(Field{union, "C"}, TextLitTerm{Suffix: "x"}),
+		})).To(Equal(NaturalLit(0)))
+	})
+	It("rejects a default whose type doesn't match the other handlers", func() {
+		_, err := opts.TypeOf(Merge{
+			Handler: RecordLit{
+				"A": NewLambda("n", Natural, NewVar("n")),
+				"_": BoolLit(true),
+			},
+			Union: Field{union, "B"},
+		})
+		Expect(err).To(HaveOccurred())
+	})
+	It("rejects a default which collides with an alternative", func() {
+		_, err := opts.TypeOf(Merge{
+			Handler: RecordLit{"_": NaturalLit(0)},
+			Union:   Field{UnionType{"_": nil}, "_"},
+		})
+		Expect(err).To(HaveOccurred())
+	})
+	It("is rejected when the extension is off", func() {
+		_, err := TypeOf(Merge{Handler: handlers, Union: Field{union, "B"}})
+		Expect(err).To(HaveOccurred())
+	})
+	It("isn't used by Eval", func() {
+		merge := Merge{Handler: handlers, Union: Field{union, "B"}}
+		Expect(Quote(Eval(merge))).To(Equal(merge))
+	})
+})
+
+// sharedLet binds a list of n Naturals and refers to it n times, as
+// Prelude-style code refers to earlier definitions.
+func sharedLet(n int) Term {
+	items := make([]Term, n)
+	for i := range items {
+		items[i] = NaturalLit(i)
+	}
+	var body Term = NaturalLit(0)
+	for i := 0; i < n; i++ {
+		body = NaturalPlus(body, Apply(ListLength, Natural, NewVar("xs")))
+	}
+	return NewLet(body, Binding{Variable: "xs", Value: NewList(items[0], items[1:]...)})
+}
+
+func BenchmarkTypeOfSharedLet(b *testing.B) {
+	t := sharedLet(500)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		if _, err := TypeOf(t); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
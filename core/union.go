@@ -0,0 +1,42 @@
+package core
+
+// Constructor takes a union type Term u and returns the constructor
+// for its alternative alt.  For an alternative with a payload, the
+// constructor is a function from the payload type to u; for an empty
+// alternative, it is a value of type u.
+//
+// It is an error if u is not a union type or has no alternative
+// named alt.
+func Constructor(u Term, alt string) (Term, error) {
+	if _, err := TypeOf(u); err != nil {
+		return nil, err
+	}
+	unionType, ok := Eval(u).(unionTypeVal)
+	if !ok {
+		return nil, mkTypeError(notAUnionType)
+	}
+	if _, ok := unionType[alt]; !ok {
+		return nil, mkTypeError(missingConstructor)
+	}
+	return Field{Record: u, FieldName: alt}, nil
+}
+
+// MatchUnion reports whether v is a value of a union type, as
+// produced by applying a constructor.  If so, it returns the name of
+// the alternative and its payload, which is nil for an empty
+// alternative.
+func MatchUnion(v Value) (alternative string, payload Value, ok bool) {
+	if app, isApp := v.(AppValue); isApp {
+		if ctor, isField := app.Fn.(fieldVal); isField {
+			if _, isUnion := ctor.Record.(unionTypeVal); isUnion {
+				return ctor.FieldName, app.Arg, true
+			}
+		}
+	}
+	if ctor, isField := v.(fieldVal); isField {
+		if _, isUnion := ctor.Record.(unionTypeVal); isUnion {
+			return ctor.FieldName, nil, true
+		}
+	}
+	return "", nil, false
+}
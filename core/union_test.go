@@ -0,0 +1,62 @@
+package core_test
+
+import (
+	. "github.com/philandstuff/dhall-golang/core"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Constructor", func() {
+	union := UnionType{"A": Natural, "B": nil}
+	It("builds a function for an alternative with a payload", func() {
+		ctor, err := Constructor(union, "A")
+		Expect(err).ToNot(HaveOccurred())
+
+		ctorType, err := TypeOf(ctor)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(Quote(ctorType)).To(Equal(NewPi("A", Natural, union)))
+
+		valType, err := TypeOf(Apply(ctor, NaturalLit(3)))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(Quote(valType)).To(Equal(union))
+	})
+	It("builds a value for an empty alternative", func() {
+		ctor, err := Constructor(union, "B")
+		Expect(err).ToNot(HaveOccurred())
+
+		valType, err := TypeOf(ctor)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(Quote(valType)).To(Equal(union))
+	})
+	It("fails for a missing alternative", func() {
+		_, err := Constructor(union, "C")
+		Expect(err).To(HaveOccurred())
+	})
+	It("fails for something which isn't a union type", func() {
+		_, err := Constructor(RecordType{"A": Natural}, "A")
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("MatchUnion", func() {
+	union := UnionType{"A": Natural, "B": nil}
+	It("matches an alternative with a payload", func() {
+		alt, payload, ok := MatchUnion(Eval(Apply(Field{union, "A"}, NaturalLit(3))))
+		Expect(ok).To(BeTrue())
+		Expect(alt).To(Equal("A"))
+		Expect(payload).To(Equal(NaturalLit(3)))
+	})
+	It("matches an empty alternative", func() {
+		alt, payload, ok := MatchUnion(Eval(Field{union, "B"}))
+		Expect(ok).To(BeTrue())
+		Expect(alt).To(Equal("B"))
+		Expect(payload).To(BeNil())
+	})
+	It("doesn't match other values", func() {
+		_, _, ok := MatchUnion(Eval(Field{RecordLit{"A": NaturalLit(3)}, "A"}))
+		Expect(ok).To(BeFalse())
+		_, _, ok = MatchUnion(Eval(Apply(NewVar("f"), NaturalLit(3))))
+		Expect(ok).To(BeFalse())
+	})
+})
@@ -24,6 +24,45 @@ handling skipped for brevity):
  dhallBytes, err := ioutil.ReadFile("foo.dhall")
  err = dhall.Unmarshal(dhallBytes, &m)
 
+Unmarshal doesn't resolve imports.  To resolve them, pass an
+imports.Loader, whose Cache says where resolved imports are cached:
+
+ err = dhall.UnmarshalOptions{
+	 Loader: &imports.Loader{Cache: imports.StandardCache{}},
+ }.Unmarshal(dhallBytes, &m)
+
+A struct field can be given a different record field name, or
+options, with a `dhall` struct tag.  The `number` option decodes a
+Double, Natural or Integer into a string field, such as a
+json.Number, exactly as Double/show would render it:
+
+ type Reading struct {
+	 Value json.Number `dhall:"value,number"`
+ }
+
+A list of `{ mapKey, mapValue }` records, such as toMap builds,
+decodes into a Go map.  Where order matters, it (or a list of
+`{ key, value }` records) can instead decode into a slice of a
+two-field struct, which keeps the list's order.  A struct field named
+after one of the record's fields is filled from it and the other
+struct field from the remaining one; otherwise the first is filled
+from the key and the second from the value:
+
+ type Header struct {
+	 Name  string
+	 Value string
+ }
+
+ToJSON renders an evaluated Dhall value as JSON, as dhall-to-json
+does.  For JavaScript consumers, which lose precision on integers
+beyond 2^53, ToJSONOptions.NaturalAsString renders Naturals and
+Integers as JSON strings instead.
+
+ToGo converts an evaluated Dhall value to plain Go values without a
+schema: records become maps, lists become slices, Optionals become
+pointers or nil, and union values become a Union holding the name of
+the alternative and its payload.
+
 This version supports Dhall standard 11.1.0, except that it doesn't
 support `using` directives.
 */
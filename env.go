@@ -0,0 +1,119 @@
+package dhall
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/philandstuff/dhall-golang/core"
+)
+
+// ToEnv typechecks and evaluates t, which must be a record, and
+// flattens it into environment-variable-style keys and values.  Keys
+// are the upper-cased path of field names joined by underscores,
+// preceded by prefix if it is not empty, so that with the prefix
+// "APP",
+//
+//	{ database = { host = "localhost", port = 5432 } }
+//
+// becomes APP_DATABASE_HOST=localhost and APP_DATABASE_PORT=5432.
+//
+// Lists are flattened with the index of each element as a key
+// segment, so `{ hosts = ["a", "b"] }` becomes HOSTS_0=a and
+// HOSTS_1=b.  An Optional which is None produces no key at all, and
+// a union alternative without a payload is rendered as its name.
+// Any other value, such as a function, is an error.
+func ToEnv(t core.Term, prefix string) (map[string]string, error) {
+	if _, err := core.TypeOf(t); err != nil {
+		return nil, err
+	}
+	value := core.Eval(t)
+	if _, ok := value.(core.RecordLitVal); !ok {
+		return nil, fmt.Errorf("can only flatten a record into environment variables, not %v", core.Quote(value))
+	}
+	env := map[string]string{}
+	if err := flattenEnv(env, envKey(prefix), value); err != nil {
+		return nil, err
+	}
+	return env, nil
+}
+
+// envKey upper-cases name and replaces any character which may not
+// appear in an environment variable name with an underscore.
+func envKey(name string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case 'a' <= r && r <= 'z':
+			return r - 'a' + 'A'
+		case 'A' <= r && r <= 'Z', '0' <= r && r <= '9':
+			return r
+		default:
+			return '_'
+		}
+	}, name)
+}
+
+func joinEnvKey(prefix, segment string) string {
+	if prefix == "" {
+		return segment
+	}
+	return prefix + "_" + segment
+}
+
+func flattenEnv(env map[string]string, key string, e core.Value) error {
+	if some, ok := core.MatchOptional(e); ok {
+		if some == nil {
+			return nil
+		}
+		return flattenEnv(env, key, some)
+	}
+	switch e := e.(type) {
+	case core.RecordLitVal:
+		for name, field := range e {
+			if err := flattenEnv(env, joinEnvKey(key, envKey(name)), field); err != nil {
+				return err
+			}
+		}
+		return nil
+	case core.NonEmptyListVal:
+		for i, item := range e {
+			if err := flattenEnv(env, joinEnvKey(key, strconv.Itoa(i)), item); err != nil {
+				return err
+			}
+		}
+		return nil
+	case core.EmptyListVal:
+		return nil
+	}
+
+	var str string
+	switch e := e.(type) {
+	case core.BoolLit:
+		str = strconv.FormatBool(bool(e))
+	case core.NaturalLit:
+		str = strconv.FormatUint(uint64(e), 10)
+	case core.IntegerLit:
+		str = strconv.Itoa(int(e))
+	case core.DoubleLit:
+		str = strconv.FormatFloat(float64(e), 'g', -1, 64)
+	case core.TextLitVal:
+		if len(e.Chunks) != 0 {
+			return fmt.Errorf("can't flatten %s: Text has unresolved interpolations", key)
+		}
+		str = e.Suffix
+	default:
+		alt, payload, ok := core.MatchUnion(e)
+		if !ok || payload != nil {
+			return fmt.Errorf("can't flatten %s: %v is not a scalar", key, core.Quote(e))
+		}
+		str = alt
+	}
+	if key == "" {
+		return fmt.Errorf("can't flatten a field with an empty name")
+	}
+	if _, ok := env[key]; ok {
+		return fmt.Errorf("more than one field flattens to %s", key)
+	}
+	env[key] = str
+	return nil
+}
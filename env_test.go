@@ -0,0 +1,68 @@
+package dhall_test
+
+import (
+	. "github.com/philandstuff/dhall-golang"
+	"github.com/philandstuff/dhall-golang/core"
+	"github.com/philandstuff/dhall-golang/parser"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+func parseTerm(source string) core.Term {
+	parsed, err := parser.Parse("-", []byte(source))
+	Expect(err).ToNot(HaveOccurred())
+	return parsed.(core.Term)
+}
+
+var _ = Describe("ToEnv", func() {
+	It("flattens a nested record", func() {
+		env, err := ToEnv(parseTerm(`
+{ database =
+    { host = "localhost"
+    , port = 5432
+    , replica = None Text
+    , timeout = Some 1.5
+    }
+, debug = False
+, offset = -3
+, mode = < Dev | Prod >.Prod
+, hosts = [ "a", "b" ]
+, servers = [ { name = "x" }, { name = "y" } ]
+, tags = [] : List Text
+}`), "APP")
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(env).To(Equal(map[string]string{
+			"APP_DATABASE_HOST":    "localhost",
+			"APP_DATABASE_PORT":    "5432",
+			"APP_DATABASE_TIMEOUT": "1.5",
+			"APP_DEBUG":            "false",
+			"APP_OFFSET":           "-3",
+			"APP_MODE":             "Prod",
+			"APP_HOSTS_0":          "a",
+			"APP_HOSTS_1":          "b",
+			"APP_SERVERS_0_NAME":   "x",
+			"APP_SERVERS_1_NAME":   "y",
+		}))
+	})
+	It("omits the prefix when it is empty", func() {
+		env, err := ToEnv(parseTerm(`{ log-level = "debug" }`), "")
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(env).To(Equal(map[string]string{"LOG_LEVEL": "debug"}))
+	})
+	DescribeTable("rejects values which can't be flattened",
+		func(source string) {
+			_, err := ToEnv(parseTerm(source), "APP")
+			Expect(err).To(HaveOccurred())
+		},
+		Entry("not a record", `1`),
+		Entry("function field", `{ f = λ(x : Natural) → x }`),
+		Entry("union with a payload", `{ u = < A : Natural >.A 1 }`),
+		Entry("type field", `{ t = Natural }`),
+		Entry("colliding keys", `{ a_b = 1, a = { b = 2 } }`),
+		Entry("ill-typed", `{ a = 1 + True }`),
+	)
+})
@@ -32,16 +32,37 @@ func dhallCacheDir() (string, error) {
 // Fetch searches the standard Dhall cache location for a term at the
 // index given by hash.  If the hash isn't in the cache, returns nil.
 func (StandardCache) Fetch(hash []byte) core.Term {
-	// FIXME: don't swallow these errors, maybe?
-	hash16 := fmt.Sprintf("%x", hash)
 	dir, err := dhallCacheDir()
 	if err != nil {
 		return nil
 	}
-	reader, err := os.Open(path.Join(dir, hash16))
+	return DirCache(dir).Fetch(hash)
+}
+
+// Save saves the given Term to the standard Dhall cache at the given
+// hash.
+func (StandardCache) Save(hash []byte, e core.Term) {
+	dir, err := dhallCacheDir()
+	if err != nil {
+		return
+	}
+	DirCache(dir).Save(hash, e)
+}
+
+// DirCache is a DhallCache laid out like the standard cache, but in
+// the given directory instead of the user's cache directory.
+type DirCache string
+
+// Fetch searches the directory for a term at the index given by hash.
+// If the hash isn't in the cache, returns nil.
+func (d DirCache) Fetch(hash []byte) core.Term {
+	// FIXME: don't swallow these errors, maybe?
+	hash16 := fmt.Sprintf("%x", hash)
+	reader, err := os.Open(path.Join(string(d), hash16))
 	if err != nil {
 		return nil
 	}
+	defer reader.Close()
 	expr, err := binary.DecodeAsCbor(reader)
 	if err != nil {
 		log.Println(err)
@@ -50,20 +71,19 @@ func (StandardCache) Fetch(hash []byte) core.Term {
 	return expr
 }
 
-// Save saves the given Term to the standard Dhall cache at the given
-// hash.
-func (StandardCache) Save(hash []byte, e core.Term) {
+// Save saves the given Term to the directory at the given hash,
+// creating the directory if need be.
+func (d DirCache) Save(hash []byte, e core.Term) {
 	hash16 := fmt.Sprintf("%x", hash)
-	dir, err := dhallCacheDir()
-	if err != nil {
+	if err := os.MkdirAll(string(d), 0755); err != nil {
 		return
 	}
-	file, err := os.Create(path.Join(dir, hash16))
+	file, err := os.Create(path.Join(string(d), hash16))
 	if err != nil {
 		return
 	}
 	defer file.Close()
-	err = binary.EncodeAsCbor(file, e)
+	binary.EncodeAsCbor(file, e)
 }
 
 // NoCache is a DhallCache which doesn't do any caching.  It might be
@@ -0,0 +1,272 @@
+package imports
+
+import (
+	"github.com/philandstuff/dhall-golang/binary"
+	. "github.com/philandstuff/dhall-golang/core"
+)
+
+// Freeze takes a Term and returns a copy of it with every import
+// annotated with the semantic hash of what it resolves to, so that
+// later resolution is protected by an integrity check.  Imports are
+// resolved relative to ancestors, as in Load.
+func Freeze(e Term, ancestors ...Fetchable) (Term, error) {
+	return FreezeWith(StandardCache{}, e, ancestors...)
+}
+
+// FreezeWith is like Freeze, but uses cache for saving and fetching
+// imports.
+//
+// Imports `as Location` are left unhashed.  For the alternative
+// operator `?`, an import which fails to resolve is left as it is, so
+// that the fallback still works.
+func FreezeWith(cache DhallCache, e Term, ancestors ...Fetchable) (Term, error) {
+	return mapImports(e, func(i Import) (Term, error) {
+		return freezeImport(cache, i, ancestors...)
+	})
+}
+
+func freezeImport(cache DhallCache, i Import, ancestors ...Fetchable) (Term, error) {
+	if i.ImportMode == Location {
+		return i, nil
+	}
+	unhashed := Import{
+		ImportHashed: ImportHashed{Fetchable: i.Fetchable},
+		ImportMode:   i.ImportMode,
+	}
+	expr, err := LoadWith(cache, unhashed, ancestors...)
+	if err != nil {
+		return nil, err
+	}
+	hash, err := binary.SemanticHash(expr)
+	if err != nil {
+		return nil, err
+	}
+	unhashed.Hash = hash
+	return unhashed, nil
+}
+
+// Unfreeze takes a Term and returns a copy of it with the hashes
+// removed from every import.
+func Unfreeze(e Term) Term {
+	result, _ := mapImports(e, func(i Import) (Term, error) {
+		i.Hash = nil
+		return i, nil
+	})
+	return result
+}
+
+// mapImports replaces every Import in e with the result of calling fn
+// on it.  Where fn fails on one side of an import alternative, that
+// side is left unchanged.
+func mapImports(e Term, fn func(Import) (Term, error)) (Term, error) {
+	walk := func(t Term) (Term, error) {
+		if t == nil {
+			return nil, nil
+		}
+		return mapImports(t, fn)
+	}
+	switch e := e.(type) {
+	case Import:
+		return fn(e)
+	case LambdaTerm:
+		typ, err := walk(e.Type)
+		if err != nil {
+			return nil, err
+		}
+		body, err := walk(e.Body)
+		if err != nil {
+			return nil, err
+		}
+		return LambdaTerm{Label: e.Label, Type: typ, Body: body}, nil
+	case PiTerm:
+		typ, err := walk(e.Type)
+		if err != nil {
+			return nil, err
+		}
+		body, err := walk(e.Body)
+		if err != nil {
+			return nil, err
+		}
+		return PiTerm{Label: e.Label, Type: typ, Body: body}, nil
+	case AppTerm:
+		f, err := walk(e.Fn)
+		if err != nil {
+			return nil, err
+		}
+		arg, err := walk(e.Arg)
+		if err != nil {
+			return nil, err
+		}
+		return AppTerm{Fn: f, Arg: arg}, nil
+	case Let:
+		bindings := make([]Binding, len(e.Bindings))
+		for i, binding := range e.Bindings {
+			var err error
+			bindings[i].Variable = binding.Variable
+			if bindings[i].Annotation, err = walk(binding.Annotation); err != nil {
+				return nil, err
+			}
+			if bindings[i].Value, err = walk(binding.Value); err != nil {
+				return nil, err
+			}
+		}
+		body, err := walk(e.Body)
+		if err != nil {
+			return nil, err
+		}
+		return Let{Bindings: bindings, Body: body}, nil
+	case Annot:
+		expr, err := walk(e.Expr)
+		if err != nil {
+			return nil, err
+		}
+		annotation, err := walk(e.Annotation)
+		if err != nil {
+			return nil, err
+		}
+		return Annot{Expr: expr, Annotation: annotation}, nil
+	case TextLitTerm:
+		var chunks Chunks
+		for _, chunk := range e.Chunks {
+			expr, err := walk(chunk.Expr)
+			if err != nil {
+				return nil, err
+			}
+			chunks = append(chunks, Chunk{Prefix: chunk.Prefix, Expr: expr})
+		}
+		return TextLitTerm{Chunks: chunks, Suffix: e.Suffix}, nil
+	case IfTerm:
+		cond, err := walk(e.Cond)
+		if err != nil {
+			return nil, err
+		}
+		t, err := walk(e.T)
+		if err != nil {
+			return nil, err
+		}
+		f, err := walk(e.F)
+		if err != nil {
+			return nil, err
+		}
+		return IfTerm{Cond: cond, T: t, F: f}, nil
+	case OpTerm:
+		l, err := walk(e.L)
+		if err != nil {
+			if e.OpCode != ImportAltOp {
+				return nil, err
+			}
+			l = e.L
+		}
+		r, err := walk(e.R)
+		if err != nil {
+			if e.OpCode != ImportAltOp {
+				return nil, err
+			}
+			r = e.R
+		}
+		return OpTerm{OpCode: e.OpCode, L: l, R: r}, nil
+	case EmptyList:
+		typ, err := walk(e.Type)
+		if err != nil {
+			return nil, err
+		}
+		return EmptyList{Type: typ}, nil
+	case NonEmptyList:
+		list := make(NonEmptyList, len(e))
+		for i, item := range e {
+			var err error
+			if list[i], err = walk(item); err != nil {
+				return nil, err
+			}
+		}
+		return list, nil
+	case Some:
+		val, err := walk(e.Val)
+		if err != nil {
+			return nil, err
+		}
+		return Some{Val: val}, nil
+	case RecordType:
+		record := make(RecordType, len(e))
+		for k, v := range e {
+			var err error
+			if record[k], err = walk(v); err != nil {
+				return nil, err
+			}
+		}
+		return record, nil
+	case RecordLit:
+		record := make(RecordLit, len(e))
+		for k, v := range e {
+			var err error
+			if record[k], err = walk(v); err != nil {
+				return nil, err
+			}
+		}
+		return record, nil
+	case ToMap:
+		record, err := walk(e.Record)
+		if err != nil {
+			return nil, err
+		}
+		typ, err := walk(e.Type)
+		if err != nil {
+			return nil, err
+		}
+		return ToMap{Record: record, Type: typ}, nil
+	case Field:
+		record, err := walk(e.Record)
+		if err != nil {
+			return nil, err
+		}
+		return Field{Record: record, FieldName: e.FieldName}, nil
+	case Project:
+		record, err := walk(e.Record)
+		if err != nil {
+			return nil, err
+		}
+		return Project{Record: record, FieldNames: e.FieldNames}, nil
+	case ProjectType:
+		record, err := walk(e.Record)
+		if err != nil {
+			return nil, err
+		}
+		selector, err := walk(e.Selector)
+		if err != nil {
+			return nil, err
+		}
+		return ProjectType{Record: record, Selector: selector}, nil
+	case UnionType:
+		union := make(UnionType, len(e))
+		for k, v := range e {
+			var err error
+			if union[k], err = walk(v); err != nil {
+				return nil, err
+			}
+		}
+		return union, nil
+	case Merge:
+		handler, err := walk(e.Handler)
+		if err != nil {
+			return nil, err
+		}
+		union, err := walk(e.Union)
+		if err != nil {
+			return nil, err
+		}
+		annotation, err := walk(e.Annotation)
+		if err != nil {
+			return nil, err
+		}
+		return Merge{Handler: handler, Union: union, Annotation: annotation}, nil
+	case Assert:
+		annotation, err := walk(e.Annotation)
+		if err != nil {
+			return nil, err
+		}
+		return Assert{Annotation: annotation}, nil
+	default:
+		// Const, NaturalLit, etc
+		return e, nil
+	}
+}
@@ -3,6 +3,9 @@ package imports
 import (
 	"bytes"
 	"fmt"
+	"net/http"
+	"strings"
+	"time"
 
 	"github.com/philandstuff/dhall-golang/binary"
 	"github.com/philandstuff/dhall-golang/core"
@@ -10,6 +13,39 @@ import (
 	"github.com/philandstuff/dhall-golang/parser"
 )
 
+// An ImportError is returned when an import fails to resolve.
+type ImportError struct {
+	// Import is the location of the import which failed.
+	Import Fetchable
+	// Chain is the imports which led to it, outermost first.
+	Chain []Fetchable
+	// Err is the reason it failed.
+	Err error
+}
+
+func (e *ImportError) Error() string {
+	if len(e.Chain) == 0 {
+		return fmt.Sprintf("importing %s: %v", e.Import, e.Err)
+	}
+	chain := make([]string, len(e.Chain))
+	for i, f := range e.Chain {
+		chain[i] = f.String()
+	}
+	return fmt.Sprintf("importing %s (via %s): %v",
+		e.Import, strings.Join(chain, " → "), e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *ImportError) Unwrap() error { return e.Err }
+
+func importError(here Fetchable, ancestors []Fetchable, err error) error {
+	// the chain must be copied, as ancestors shares its backing
+	// array with sibling imports
+	chain := make([]Fetchable, len(ancestors))
+	copy(chain, ancestors)
+	return &ImportError{Import: here, Chain: chain, Err: err}
+}
+
 func resolveStringAsExpr(name, content string) (Term, error) {
 	expr, err := parser.Parse(name, []byte(content))
 	if err != nil {
@@ -26,6 +62,128 @@ func Load(e Term, ancestors ...Fetchable) (Term, error) {
 // LoadWith takes a Term and resolves all imports, using cache for
 // saving and fetching imports
 func LoadWith(cache DhallCache, e Term, ancestors ...Fetchable) (Term, error) {
+	return Loader{Cache: cache}.Load(e, ancestors...)
+}
+
+// A Loader resolves imports.  The zero value uses NoCache.
+type Loader struct {
+	// Cache is used for saving and fetching imports by hash.
+	Cache DhallCache
+	// OnFetch, if not nil, is called after each import is fetched,
+	// with how long the fetch took.
+	OnFetch func(f Fetchable, elapsed time.Duration)
+	// DefaultHeaders holds headers to send with every remote import
+	// from an origin, such as an Authorization header for a private
+	// server.  It is keyed by origin, as in "https://example.com"
+	// (see Remote.Origin); imports from other origins get none of
+	// them.  Nor are they sent to a server imported from a
+	// different origin.
+	DefaultHeaders map[string]http.Header
+	// RemoteCache, if not nil, caches the responses to remote
+	// imports, so that fetching them again is made conditional on
+	// their ETag and Last-Modified headers.
+	RemoteCache HTTPCache
+	// Lockfile, if not nil, has the semantic hash of every resolved
+	// import recorded into it.
+	Lockfile Lockfile
+	// VerifyLockfile makes the Loader check each resolved import
+	// against Lockfile instead of recording it, failing if they
+	// differ.
+	VerifyLockfile bool
+	// MaxFoldSteps, if positive, limits the fold steps taken while
+	// typechecking each import, as for core.EvalOptions.
+	MaxFoldSteps int
+	// MergeDefaultHandlers enables default handlers in merge while
+	// typechecking each import, as for core.EvalOptions.
+	MergeDefaultHandlers bool
+
+	// resolved holds the imports already resolved during one call
+	// to Load, so that a file imported from several places is only
+	// fetched, parsed and typechecked once.
+	resolved map[resolvedKey]Term
+}
+
+// resolvedKey identifies a resolved import.  The origin it was
+// imported from is part of the key because it affects whether a
+// fetch is allowed at all.
+type resolvedKey struct {
+	location string
+	mode     ImportMode
+	origin   string
+}
+
+// Load takes a Term and resolves all imports.  Each distinct import
+// is fetched only once per call, so OnFetch is called once for it.
+func (l Loader) Load(e Term, ancestors ...Fetchable) (Term, error) {
+	if l.Cache == nil {
+		l.Cache = NoCache{}
+	}
+	l.resolved = make(map[resolvedKey]Term)
+	return l.load(e, ancestors...)
+}
+
+func (l Loader) fetch(f Fetchable, origin string) (string, error) {
+	if remote, ok := f.(Remote); ok && (l.DefaultHeaders != nil || l.RemoteCache != nil) {
+		return remote.FetchWith(origin, l.DefaultHeaders[remote.Origin()], l.RemoteCache)
+	}
+	return f.Fetch(origin)
+}
+
+// resolve fetches here, imported from origin, and resolves its
+// imports in turn.  Code is typechecked.
+func (l Loader) resolve(here Fetchable, mode ImportMode, origin string, ancestors []Fetchable) (Term, error) {
+	start := time.Now()
+	content, err := l.fetch(here, origin)
+	if err != nil {
+		return nil, importError(here, ancestors, err)
+	}
+	if l.OnFetch != nil {
+		l.OnFetch(here, time.Since(start))
+	}
+	if mode == RawText {
+		return TextLitTerm{Suffix: content}, nil
+	}
+	// dynamicExpr may contain more imports
+	dynamicExpr, err := resolveStringAsExpr(here.Name(), content)
+	if err != nil {
+		return nil, importError(here, ancestors, err)
+	}
+
+	// recursively load any more imports; errors are already
+	// ImportErrors
+	imports := append(ancestors[:len(ancestors):len(ancestors)], here)
+	expr, err := l.load(dynamicExpr, imports...)
+	if err != nil {
+		return nil, err
+	}
+
+	// ensure that expr typechecks in empty context
+	_, err = l.evalOptions().TypeOf(expr)
+	if err != nil {
+		return nil, importError(here, ancestors, err)
+	}
+	return expr, nil
+}
+
+// evalOptions returns the options for typechecking imports.
+func (l Loader) evalOptions() core.EvalOptions {
+	return core.EvalOptions{
+		MaxFoldSteps:         l.MaxFoldSteps,
+		MergeDefaultHandlers: l.MergeDefaultHandlers,
+	}
+}
+
+// locationKey returns a string which is the same for two Fetchables
+// exactly when they refer to the same location, however their URLs
+// are spelled.
+func locationKey(f Fetchable) string {
+	if r, ok := f.(Remote); ok {
+		return r.Canonical().String()
+	}
+	return f.String()
+}
+
+func (l Loader) load(e Term, ancestors ...Fetchable) (Term, error) {
 	switch e := e.(type) {
 	case Import:
 		here := e.Fetchable
@@ -36,7 +194,7 @@ func LoadWith(cache DhallCache, e Term, ancestors ...Fetchable) (Term, error) {
 			var err error
 			here, err = here.ChainOnto(ancestors[len(ancestors)-1])
 			if err != nil {
-				return nil, err
+				return nil, importError(e.Fetchable, ancestors, err)
 			}
 		}
 		if e.ImportMode == Location {
@@ -44,62 +202,54 @@ func LoadWith(cache DhallCache, e Term, ancestors ...Fetchable) (Term, error) {
 		}
 
 		for _, ancestor := range ancestors {
-			if ancestor == here {
-				return nil, fmt.Errorf("Detected import cycle in %s", ancestor)
+			if locationKey(ancestor) == locationKey(here) {
+				return nil, importError(here, ancestors, fmt.Errorf("Detected import cycle in %s", ancestor))
 			}
 		}
 		if e.Hash != nil {
 			// fetch from cache if available
-			if expr := cache.Fetch(e.Hash); expr != nil {
+			if expr := l.Cache.Fetch(e.Hash); expr != nil {
+				if err := l.lock(here, e.ImportMode, e.Hash, expr); err != nil {
+					return nil, importError(here, ancestors, err)
+				}
 				return expr, nil
 			}
 		}
-		imports := append(ancestors, here)
-		content, err := here.Fetch(origin)
-		if err != nil {
-			return nil, err
-		}
-		var expr Term
-		if e.ImportMode == RawText {
-			expr = TextLitTerm{Suffix: content}
-		} else {
-			// dynamicExpr may contain more imports
-			dynamicExpr, err := resolveStringAsExpr(here.Name(), content)
-			if err != nil {
-				return nil, err
-			}
-
-			// recursively load any more imports
-			expr, err = LoadWith(cache, dynamicExpr, imports...)
-			if err != nil {
-				return nil, err
-			}
-
-			// ensure that expr typechecks in empty context
-			_, err = core.TypeOf(expr)
+		// a file imported more than once is only fetched and
+		// resolved once, but each import still has its own hash
+		// checked and is recorded in the Lockfile
+		key := resolvedKey{location: locationKey(here), mode: e.ImportMode, origin: origin}
+		expr, ok := l.resolved[key]
+		if !ok {
+			var err error
+			expr, err = l.resolve(here, e.ImportMode, origin, ancestors)
 			if err != nil {
 				return nil, err
 			}
+			l.resolved[key] = expr
 		}
 		// check hash, if supplied
 		if e.Hash != nil {
 			actualHash, err := binary.SemanticHash(expr)
 			if err != nil {
-				return nil, err
+				return nil, importError(here, ancestors, err)
 			}
 			if !bytes.Equal(e.Hash, actualHash[:]) {
-				return nil, fmt.Errorf("Failed integrity check: expected %x but saw %x", e.Hash, actualHash)
+				return nil, importError(here, ancestors, fmt.Errorf("Failed integrity check: expected %x but saw %x", e.Hash, actualHash))
 			}
 			// store in cache
-			cache.Save(actualHash, expr)
+			l.Cache.Save(actualHash, expr)
+		}
+		if err := l.lock(here, e.ImportMode, e.Hash, expr); err != nil {
+			return nil, importError(here, ancestors, err)
 		}
 		return expr, nil
 	case LambdaTerm:
-		resolvedType, err := LoadWith(cache, e.Type, ancestors...)
+		resolvedType, err := l.load(e.Type, ancestors...)
 		if err != nil {
 			return nil, err
 		}
-		resolvedBody, err := LoadWith(cache, e.Body, ancestors...)
+		resolvedBody, err := l.load(e.Body, ancestors...)
 		if err != nil {
 			return nil, err
 		}
@@ -109,11 +259,11 @@ func LoadWith(cache DhallCache, e Term, ancestors ...Fetchable) (Term, error) {
 			Body:  resolvedBody,
 		}, nil
 	case PiTerm:
-		resolvedType, err := LoadWith(cache, e.Type, ancestors...)
+		resolvedType, err := l.load(e.Type, ancestors...)
 		if err != nil {
 			return nil, err
 		}
-		resolvedBody, err := LoadWith(cache, e.Body, ancestors...)
+		resolvedBody, err := l.load(e.Body, ancestors...)
 		if err != nil {
 			return nil, err
 		}
@@ -123,11 +273,11 @@ func LoadWith(cache DhallCache, e Term, ancestors ...Fetchable) (Term, error) {
 			Body:  resolvedBody,
 		}, nil
 	case AppTerm:
-		resolvedFn, err := LoadWith(cache, e.Fn, ancestors...)
+		resolvedFn, err := l.load(e.Fn, ancestors...)
 		if err != nil {
 			return nil, err
 		}
-		resolvedArg, err := LoadWith(cache, e.Arg, ancestors...)
+		resolvedArg, err := l.load(e.Arg, ancestors...)
 		if err != nil {
 			return nil, err
 		}
@@ -141,27 +291,27 @@ func LoadWith(cache DhallCache, e Term, ancestors ...Fetchable) (Term, error) {
 			var err error
 			newBindings[i].Variable = binding.Variable
 			if binding.Annotation != nil {
-				newBindings[i].Annotation, err = LoadWith(cache, binding.Annotation, ancestors...)
+				newBindings[i].Annotation, err = l.load(binding.Annotation, ancestors...)
 				if err != nil {
 					return nil, err
 				}
 			}
-			newBindings[i].Value, err = LoadWith(cache, binding.Value, ancestors...)
+			newBindings[i].Value, err = l.load(binding.Value, ancestors...)
 			if err != nil {
 				return nil, err
 			}
 		}
-		resolvedBody, err := LoadWith(cache, e.Body, ancestors...)
+		resolvedBody, err := l.load(e.Body, ancestors...)
 		if err != nil {
 			return nil, err
 		}
 		return Let{Bindings: newBindings, Body: resolvedBody}, nil
 	case Annot:
-		resolvedExpr, err := LoadWith(cache, e.Expr, ancestors...)
+		resolvedExpr, err := l.load(e.Expr, ancestors...)
 		if err != nil {
 			return nil, err
 		}
-		resolvedAnnotation, err := LoadWith(cache, e.Annotation, ancestors...)
+		resolvedAnnotation, err := l.load(e.Annotation, ancestors...)
 		if err != nil {
 			return nil, err
 		}
@@ -169,7 +319,7 @@ func LoadWith(cache DhallCache, e Term, ancestors ...Fetchable) (Term, error) {
 	case TextLitTerm:
 		var newChunks Chunks
 		for _, chunk := range e.Chunks {
-			resolvedExpr, err := LoadWith(cache, chunk.Expr, ancestors...)
+			resolvedExpr, err := l.load(chunk.Expr, ancestors...)
 			if err != nil {
 				return nil, err
 			}
@@ -180,15 +330,15 @@ func LoadWith(cache DhallCache, e Term, ancestors ...Fetchable) (Term, error) {
 		}
 		return TextLitTerm{newChunks, e.Suffix}, nil
 	case IfTerm:
-		resolvedCond, err := LoadWith(cache, e.Cond, ancestors...)
+		resolvedCond, err := l.load(e.Cond, ancestors...)
 		if err != nil {
 			return nil, err
 		}
-		resolvedT, err := LoadWith(cache, e.T, ancestors...)
+		resolvedT, err := l.load(e.T, ancestors...)
 		if err != nil {
 			return nil, err
 		}
-		resolvedF, err := LoadWith(cache, e.F, ancestors...)
+		resolvedF, err := l.load(e.F, ancestors...)
 		if err != nil {
 			return nil, err
 		}
@@ -199,27 +349,27 @@ func LoadWith(cache DhallCache, e Term, ancestors ...Fetchable) (Term, error) {
 		}, nil
 	case OpTerm:
 		if e.OpCode == ImportAltOp {
-			resolvedL, err := LoadWith(cache, e.L, ancestors...)
+			resolvedL, err := l.load(e.L, ancestors...)
 			if err == nil {
 				return resolvedL, nil
 			}
-			resolvedR, err := LoadWith(cache, e.R, ancestors...)
+			resolvedR, err := l.load(e.R, ancestors...)
 			if err != nil {
 				return nil, err
 			}
 			return resolvedR, nil
 		}
-		resolvedL, err := LoadWith(cache, e.L, ancestors...)
+		resolvedL, err := l.load(e.L, ancestors...)
 		if err != nil {
 			return nil, err
 		}
-		resolvedR, err := LoadWith(cache, e.R, ancestors...)
+		resolvedR, err := l.load(e.R, ancestors...)
 		if err != nil {
 			return nil, err
 		}
 		return OpTerm{OpCode: e.OpCode, L: resolvedL, R: resolvedR}, nil
 	case EmptyList:
-		resolvedType, err := LoadWith(cache, e.Type, ancestors...)
+		resolvedType, err := l.load(e.Type, ancestors...)
 		if err != nil {
 			return nil, err
 		}
@@ -228,14 +378,14 @@ func LoadWith(cache DhallCache, e Term, ancestors ...Fetchable) (Term, error) {
 		newList := make(NonEmptyList, len(e))
 		for i, item := range e {
 			var err error
-			newList[i], err = LoadWith(cache, item, ancestors...)
+			newList[i], err = l.load(item, ancestors...)
 			if err != nil {
 				return nil, err
 			}
 		}
 		return newList, nil
 	case Some:
-		val, err := LoadWith(cache, e.Val, ancestors...)
+		val, err := l.load(e.Val, ancestors...)
 		if err != nil {
 			return nil, err
 		}
@@ -244,7 +394,7 @@ func LoadWith(cache DhallCache, e Term, ancestors ...Fetchable) (Term, error) {
 		newRecord := make(RecordType, len(e))
 		for k, v := range e {
 			var err error
-			newRecord[k], err = LoadWith(cache, v, ancestors...)
+			newRecord[k], err = l.load(v, ancestors...)
 			if err != nil {
 				return nil, err
 			}
@@ -254,40 +404,40 @@ func LoadWith(cache DhallCache, e Term, ancestors ...Fetchable) (Term, error) {
 		newRecord := make(RecordLit, len(e))
 		for k, v := range e {
 			var err error
-			newRecord[k], err = LoadWith(cache, v, ancestors...)
+			newRecord[k], err = l.load(v, ancestors...)
 			if err != nil {
 				return nil, err
 			}
 		}
 		return newRecord, nil
 	case ToMap:
-		record, err := LoadWith(cache, e.Record, ancestors...)
+		record, err := l.load(e.Record, ancestors...)
 		if err != nil {
 			return nil, err
 		}
-		typ, err := LoadWith(cache, e.Type, ancestors...)
+		typ, err := l.load(e.Type, ancestors...)
 		if err != nil {
 			return nil, err
 		}
 		return ToMap{Record: record, Type: typ}, nil
 	case Field:
-		newRecord, err := LoadWith(cache, e.Record, ancestors...)
+		newRecord, err := l.load(e.Record, ancestors...)
 		if err != nil {
 			return nil, err
 		}
 		return Field{Record: newRecord, FieldName: e.FieldName}, nil
 	case Project:
-		newRecord, err := LoadWith(cache, e.Record, ancestors...)
+		newRecord, err := l.load(e.Record, ancestors...)
 		if err != nil {
 			return nil, err
 		}
 		return Project{Record: newRecord, FieldNames: e.FieldNames}, nil
 	case ProjectType:
-		record, err := LoadWith(cache, e.Record, ancestors...)
+		record, err := l.load(e.Record, ancestors...)
 		if err != nil {
 			return nil, err
 		}
-		typ, err := LoadWith(cache, e.Selector, ancestors...)
+		typ, err := l.load(e.Selector, ancestors...)
 		if err != nil {
 			return nil, err
 		}
@@ -300,24 +450,31 @@ func LoadWith(cache DhallCache, e Term, ancestors ...Fetchable) (Term, error) {
 				result[k] = nil
 				continue
 			}
-			result[k], err = LoadWith(cache, v, ancestors...)
+			result[k], err = l.load(v, ancestors...)
 			if err != nil {
 				return nil, err
 			}
 		}
 		return result, nil
 	case Merge:
-		handler, err := LoadWith(cache, e.Handler, ancestors...)
+		handler, err := l.load(e.Handler, ancestors...)
 		if err != nil {
 			return nil, err
 		}
-		union, err := LoadWith(cache, e.Union, ancestors...)
+		union, err := l.load(e.Union, ancestors...)
 		if err != nil {
 			return nil, err
 		}
-		return Merge{Handler: handler, Union: union}, nil
+		result := Merge{Handler: handler, Union: union}
+		if e.Annotation != nil {
+			result.Annotation, err = l.load(e.Annotation, ancestors...)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return result, nil
 	case Assert:
-		annot, err := LoadWith(cache, e.Annotation, ancestors...)
+		annot, err := l.load(e.Annotation, ancestors...)
 		if err != nil {
 			return nil, err
 		}
@@ -1,10 +1,16 @@
 package imports_test
 
 import (
+	"bytes"
 	"io"
+	"io/ioutil"
 	"net/http"
 	"os"
+	"path/filepath"
+	"testing"
+	"time"
 
+	"github.com/philandstuff/dhall-golang/binary"
 	. "github.com/philandstuff/dhall-golang/core"
 	. "github.com/philandstuff/dhall-golang/imports"
 	. "github.com/philandstuff/dhall-golang/internal"
@@ -94,6 +100,41 @@ var _ = Describe("Import resolution", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(actual).To(Equal(Annot{Expr: NaturalLit(3), Annotation: Natural}))
 		})
+		It("revalidates imports cached in the Loader's RemoteCache", func() {
+			var ifNoneMatch []string
+			server.RouteToHandler("GET", "/etag.dhall",
+				func(w http.ResponseWriter, r *http.Request) {
+					ifNoneMatch = append(ifNoneMatch, r.Header.Get("If-None-Match"))
+					if r.Header.Get("If-None-Match") == `"v1"` {
+						w.WriteHeader(http.StatusNotModified)
+						return
+					}
+					w.Header().Set("ETag", `"v1"`)
+					io.WriteString(w, "3 : Natural")
+				},
+			)
+			loader := Loader{RemoteCache: NewMemoryHTTPCache()}
+			for i := 0; i < 2; i++ {
+				actual, err := loader.Load(NewRemoteImport(server.URL()+"/etag.dhall", Code))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(actual).To(Equal(Annot{Expr: NaturalLit(3), Annotation: Natural}))
+			}
+			Expect(ifNoneMatch).To(Equal([]string{"", `"v1"`}))
+		})
+		It("fetches equivalent spellings of a URL once", func() {
+			server.RouteToHandler("GET", "/~user/foo.dhall",
+				ghttp.RespondWith(http.StatusOK, "3 : Natural"),
+			)
+			fetches := 0
+			loader := Loader{OnFetch: func(Fetchable, time.Duration) { fetches++ }}
+			_, err := loader.Load(NewList(
+				NewRemoteImport(server.URL()+"/~user/foo.dhall", Code),
+				NewRemoteImport(server.URL()+"/%7euser/foo.dhall", Code)))
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fetches).To(Equal(1))
+			Expect(server.ReceivedRequests()).To(HaveLen(1))
+		})
 		It("Fails to resolve code with free variables", func() {
 			server.RouteToHandler("GET", "/foo.dhall",
 				ghttp.RespondWith(http.StatusOK, "x"),
@@ -102,6 +143,22 @@ var _ = Describe("Import resolution", func() {
 
 			Expect(err).To(HaveOccurred())
 		})
+		It("Rejects an absolute local import from a remote file", func() {
+			server.RouteToHandler("GET", "/foo.dhall",
+				ghttp.RespondWith(http.StatusOK, "/etc/passwd as Text"),
+			)
+			_, err := Load(NewRemoteImport(server.URL()+"/foo.dhall", Code))
+
+			Expect(err).To(MatchError(ContainSubstring("Can't get absolute path from remote import")))
+		})
+		It("Rejects a home-relative local import from a remote file", func() {
+			server.RouteToHandler("GET", "/foo.dhall",
+				ghttp.RespondWith(http.StatusOK, "~/.ssh/id_rsa as Text"),
+			)
+			_, err := Load(NewRemoteImport(server.URL()+"/foo.dhall", Code))
+
+			Expect(err).To(MatchError(ContainSubstring("Can't get home-relative path from remote import")))
+		})
 		Describe("CORS checks", func() {
 			BeforeEach(func() {
 				server.RouteToHandler("GET", "/no-cors.dhall",
@@ -177,6 +234,74 @@ var _ = Describe("Import resolution", func() {
 				})
 			})
 		})
+		Describe("default headers", func() {
+			var loader Loader
+			var authorization chan string
+			BeforeEach(func() {
+				loader = Loader{DefaultHeaders: map[string]http.Header{
+					server.URL(): {"Authorization": {"Bearer secret"}},
+				}}
+				authorization = make(chan string, 1)
+				server.RouteToHandler("GET", "/private.dhall",
+					func(w http.ResponseWriter, r *http.Request) {
+						authorization <- r.Header.Get("Authorization")
+						w.Header().Set("Access-Control-Allow-Origin", "*")
+						io.WriteString(w, "3 : Natural")
+					},
+				)
+			})
+			It("sends them to top-level remote imports", func() {
+				_, err := loader.Load(NewRemoteImport(server.URL()+"/private.dhall", Code))
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(authorization).To(Receive(Equal("Bearer secret")))
+			})
+			It("sends them to same-origin imports", func() {
+				server.RouteToHandler("GET", "/same-origin.dhall",
+					ghttp.RespondWith(http.StatusOK, "./private.dhall"),
+				)
+				_, err := loader.Load(NewRemoteImport(server.URL()+"/same-origin.dhall", Code))
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(authorization).To(Receive(Equal("Bearer secret")))
+			})
+			It("drops them for cross-origin imports", func() {
+				otherOrigin := ghttp.NewServer()
+				defer otherOrigin.Close()
+				otherOrigin.RouteToHandler("GET", "/other-origin.dhall",
+					ghttp.RespondWith(http.StatusOK, server.URL()+"/private.dhall"),
+				)
+				_, err := loader.Load(NewRemoteImport(otherOrigin.URL()+"/other-origin.dhall", Code))
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(authorization).To(Receive(Equal("")))
+			})
+			It("sends them only to their own origin from a local import", func() {
+				otherOrigin := ghttp.NewServer()
+				defer otherOrigin.Close()
+				otherAuthorization := make(chan string, 1)
+				otherOrigin.RouteToHandler("GET", "/public.dhall",
+					func(w http.ResponseWriter, r *http.Request) {
+						otherAuthorization <- r.Header.Get("Authorization")
+						io.WriteString(w, "3 : Natural")
+					},
+				)
+				dir, err := ioutil.TempDir("", "dhall-golang")
+				Expect(err).ToNot(HaveOccurred())
+				defer os.RemoveAll(dir)
+				local := filepath.Join(dir, "both.dhall")
+				err = ioutil.WriteFile(local, []byte(
+					"{ private = "+server.URL()+"/private.dhall, public = "+otherOrigin.URL()+"/public.dhall }",
+				), 0644)
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = loader.Load(NewLocalImport(local, Code))
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(authorization).To(Receive(Equal("Bearer secret")))
+				Expect(otherAuthorization).To(Receive(Equal("")))
+			})
+		})
 	})
 	Describe("local imports", func() {
 		It("Resolves as Text", func() {
@@ -212,6 +337,21 @@ var _ = Describe("Import resolution", func() {
 			}()
 			Eventually(result).Should(Receive())
 		})
+		It("Reports the chain of importers for a missing transitive import", func() {
+			_, err := Load(NewLocalImport("testdata/missing_transitive1.dhall", Code))
+
+			Expect(err).To(HaveOccurred())
+			importErr, ok := err.(*ImportError)
+			Expect(ok).To(BeTrue())
+			Expect(importErr.Import).To(Equal(Local("testdata/nonexistent.dhall")))
+			Expect(importErr.Chain).To(Equal([]Fetchable{
+				Local("testdata/missing_transitive1.dhall"),
+				Local("testdata/missing_transitive2.dhall"),
+			}))
+			Expect(os.IsNotExist(importErr.Unwrap())).To(BeTrue())
+			Expect(err.Error()).To(HavePrefix(
+				"importing ./testdata/nonexistent.dhall (via ./testdata/missing_transitive1.dhall → ./testdata/missing_transitive2.dhall): "))
+		})
 	})
 	DescribeTable("Other subexpressions", expectResolves,
 		Entry("Literal expression", NaturalLit(3), NaturalLit(3)),
@@ -281,6 +421,14 @@ var _ = Describe("Import resolution", func() {
 			IfTerm{Cond: importFooAsText},
 			IfTerm{Cond: resolvedFooAsText},
 		),
+		Entry("Import within merge annotation",
+			Merge{Handler: RecordLit{}, Union: NewVar("x"), Annotation: importFooAsText},
+			Merge{Handler: RecordLit{}, Union: NewVar("x"), Annotation: resolvedFooAsText},
+		),
+		Entry("Merge annotation is kept",
+			Merge{Handler: RecordLit{}, Union: NewVar("x"), Annotation: Natural},
+			Merge{Handler: RecordLit{}, Union: NewVar("x"), Annotation: Natural},
+		),
 		Entry("Import within if true branch",
 			IfTerm{T: importFooAsText},
 			IfTerm{T: resolvedFooAsText},
@@ -319,3 +467,131 @@ var _ = Describe("Import resolution", func() {
 		),
 	)
 })
+
+var _ = Describe("Freeze", func() {
+	naturalImport := NewLocalImport("./testdata/natural.dhall", Code)
+	It("annotates imports with their semantic hash", func() {
+		resolved, err := LoadWith(NoCache{}, naturalImport)
+		Expect(err).ToNot(HaveOccurred())
+		expectedHash, err := binary.SemanticHash(resolved)
+		Expect(err).ToNot(HaveOccurred())
+
+		frozen, err := FreezeWith(NoCache{}, NaturalPlus(naturalImport, NaturalLit(1)))
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(frozen).To(Equal(NaturalPlus(
+			Import{
+				ImportHashed: ImportHashed{
+					Fetchable: naturalImport.Fetchable,
+					Hash:      expectedHash,
+				},
+				ImportMode: Code,
+			},
+			NaturalLit(1),
+		)))
+	})
+	It("fails if an import can't be resolved", func() {
+		_, err := FreezeWith(NoCache{}, NewLocalImport("./testdata/nonexistent.dhall", Code))
+
+		Expect(err).To(HaveOccurred())
+	})
+	It("leaves a failing import alternative unfrozen", func() {
+		missing := NewLocalImport("./testdata/nonexistent.dhall", Code)
+		frozen, err := FreezeWith(NoCache{}, OpTerm{OpCode: ImportAltOp, L: missing, R: naturalImport})
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(frozen.(OpTerm).L).To(Equal(missing))
+		Expect(frozen.(OpTerm).R.(Import).Hash).ToNot(BeNil())
+	})
+	It("is undone by Unfreeze", func() {
+		frozen, err := FreezeWith(NoCache{}, NaturalPlus(naturalImport, NaturalLit(1)))
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(Unfreeze(frozen)).To(Equal(NaturalPlus(naturalImport, NaturalLit(1))))
+	})
+})
+
+var _ = Describe("Lockfile", func() {
+	naturalImport := NewLocalImport("./testdata/natural.dhall", Code)
+	It("records imports and then verifies them", func() {
+		os.Setenv("LOCKED", "2")
+		term := NaturalPlus(naturalImport, NewEnvVarImport("LOCKED", Code))
+		lock := Lockfile{}
+		_, err := Loader{Lockfile: lock}.Load(term)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(lock).To(HaveLen(2))
+		Expect(lock).To(HaveKey(naturalImport.Fetchable.String()))
+		Expect(lock["env:LOCKED"]).To(HavePrefix("sha256:"))
+
+		var buf bytes.Buffer
+		Expect(lock.Write(&buf)).To(Succeed())
+		read, err := ReadLockfile(&buf)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(read).To(Equal(lock))
+
+		_, err = Loader{Lockfile: read, VerifyLockfile: true}.Load(term)
+		Expect(err).ToNot(HaveOccurred())
+
+		os.Setenv("LOCKED", "3")
+		_, err = Loader{Lockfile: read, VerifyLockfile: true}.Load(term)
+		Expect(err).To(MatchError(ContainSubstring("lockfile mismatch")))
+	})
+	It("rejects imports missing from the lockfile", func() {
+		_, err := Loader{Lockfile: Lockfile{}, VerifyLockfile: true}.Load(naturalImport)
+		Expect(err).To(MatchError(ContainSubstring("not in the lockfile")))
+	})
+	It("keys imports as Text separately", func() {
+		lock := Lockfile{}
+		_, err := Loader{Lockfile: lock}.Load(NewLocalImport("./testdata/natural.dhall", RawText))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(lock).To(HaveKey(naturalImport.Fetchable.String() + " as Text"))
+	})
+})
+
+var _ = Describe("Loader", func() {
+	naturalImport := NewLocalImport("./testdata/natural.dhall", Code)
+	It("fetches each file of a project once", func() {
+		fetches := map[string]int{}
+		loader := Loader{OnFetch: func(f Fetchable, _ time.Duration) { fetches[f.String()]++ }}
+		_, err := loader.Load(NewLocalImport("testdata/project/package.dhall", Code))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(fetches).To(Equal(map[string]int{
+			"./testdata/project/package.dhall": 1,
+			"./testdata/project/types.dhall":   1,
+			"./testdata/project/util.dhall":    1,
+			"./testdata/project/api.dhall":     1,
+			"./testdata/project/web.dhall":     1,
+			"./testdata/project/worker.dhall":  1,
+		}))
+	})
+	It("checks the hash of an import it has already resolved", func() {
+		wrongHash := Import{
+			ImportHashed: ImportHashed{
+				Fetchable: naturalImport.Fetchable,
+				Hash:      make([]byte, 32),
+			},
+			ImportMode: Code,
+		}
+		_, err := Loader{}.Load(NewList(naturalImport, wrongHash))
+		Expect(err).To(MatchError(ContainSubstring("Failed integrity check")))
+	})
+	It("fetches a file again on the next Load", func() {
+		fetches := 0
+		loader := Loader{OnFetch: func(Fetchable, time.Duration) { fetches++ }}
+		for i := 0; i < 2; i++ {
+			_, err := loader.Load(NewLocalImport("./testdata/natural.dhall", Code))
+			Expect(err).ToNot(HaveOccurred())
+		}
+		Expect(fetches).To(Equal(2))
+	})
+})
+
+func BenchmarkLoadLocalProject(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, err := Load(NewLocalImport("testdata/project/package.dhall", Code))
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package imports
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/philandstuff/dhall-golang/binary"
+	. "github.com/philandstuff/dhall-golang/core"
+)
+
+// A Lockfile maps the location of each import resolved by a Loader to
+// the semantic hash of what it resolved to, written as
+// "sha256:<hex>".  Imports `as Text` are keyed by their location
+// followed by " as Text".
+//
+// Set Loader.Lockfile to an empty Lockfile to record one, then save it
+// with Write.  A later Loader with VerifyLockfile set fails if any
+// import resolves to something else, or is missing from the Lockfile.
+type Lockfile map[string]string
+
+// ReadLockfile reads a Lockfile written by Lockfile.Write.
+func ReadLockfile(r io.Reader) (Lockfile, error) {
+	var lock Lockfile
+	if err := json.NewDecoder(r).Decode(&lock); err != nil {
+		return nil, err
+	}
+	if lock == nil {
+		lock = Lockfile{}
+	}
+	return lock, nil
+}
+
+// Write writes the Lockfile as JSON, with imports in sorted order so
+// that it diffs well.
+func (lock Lockfile) Write(w io.Writer) error {
+	b, err := json.MarshalIndent(lock, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(b, '\n'))
+	return err
+}
+
+func lockKey(here Fetchable, mode ImportMode) string {
+	if mode == RawText {
+		return here.String() + " as Text"
+	}
+	return here.String()
+}
+
+// lock records the hash of an import into l.Lockfile, or checks it
+// against l.Lockfile if l.VerifyLockfile is set.  hash may be nil, in
+// which case it is computed from expr.
+func (l Loader) lock(here Fetchable, mode ImportMode, hash []byte, expr Term) error {
+	if l.Lockfile == nil {
+		return nil
+	}
+	if hash == nil {
+		var err error
+		hash, err = binary.SemanticHash(expr)
+		if err != nil {
+			return err
+		}
+	}
+	key := lockKey(here, mode)
+	// skip the multihash prefix 0x12 0x20
+	actual := fmt.Sprintf("sha256:%x", hash[2:])
+	if !l.VerifyLockfile {
+		l.Lockfile[key] = actual
+		return nil
+	}
+	expected, ok := l.Lockfile[key]
+	if !ok {
+		return fmt.Errorf("import %s is not in the lockfile", key)
+	}
+	if expected != actual {
+		return fmt.Errorf("lockfile mismatch: expected %s but saw %s", expected, actual)
+	}
+	return nil
+}
@@ -70,6 +70,10 @@ var g = &grammar{
 				expr: &seqExpr{
 					pos: position{line: 57, col: 13, offset: 1202},
 					exprs: []interface{}{
+						&andCodeExpr{
+							pos: position{line: 57, col: 13, offset: 1202},
+							run: (*parser).callonDhallFile3,
+						},
 						&labeledExpr{
 							pos:   position{line: 57, col: 13, offset: 1202},
 							label: "e",
@@ -79,9 +83,9 @@ var g = &grammar{
 							},
 						},
 						&notExpr{
-							pos: position{line: 725, col: 7, offset: 23100},
+							pos: position{line: 748, col: 7, offset: 24036},
 							expr: &anyMatcher{
-								line: 725, col: 8, offset: 23101,
+								line: 748, col: 8, offset: 24037,
 							},
 						},
 					},
@@ -127,6 +131,7 @@ var g = &grammar{
 						pos:        position{line: 83, col: 16, offset: 1883},
 						val:        "{-",
 						ignoreCase: false,
+						want:       "\"{-\"",
 					},
 					&ruleRefExpr{
 						pos:  position{line: 83, col: 21, offset: 1888},
@@ -145,6 +150,7 @@ var g = &grammar{
 						pos:        position{line: 92, col: 7, offset: 2014},
 						val:        "-}",
 						ignoreCase: false,
+						want:       "\"-}\"",
 					},
 					&seqExpr{
 						pos: position{line: 93, col: 7, offset: 2025},
@@ -167,9 +173,9 @@ var g = &grammar{
 								alternatives: []interface{}{
 									&charClassMatcher{
 										pos:        position{line: 86, col: 5, offset: 1935},
-										val:        "[𐀀D\\t\\n -\\u007f\\u0080-\\ud7ff\\ue000-�𐀀-\\U0001fffd𠀀-\\U0002fffd\\U00030000-\\U0003fffd\\U00040000-\\U0004fffd\\U00050000-\\U0005fffd\\U00060000-\\U0006fffd\\U00070000-\\U0007fffd\\U00080000-\\U0008fffd\\U00090000-\\U0009fffd\\U000a0000-\\U000afffd\\U000b0000-\\U000bfffd\\U000c0000-\\U000cfffd\\U000d0000-\\U000dfffd\\U000e0000-\\U000efffd\\U000f0000-\\U000ffffd0-\\U00010fff]",
+										val:        "[𐀀D\\t\\n -\\x7f\\u0080-\\ud7ff\\ue000-�𐀀-\\U0001fffd𠀀-\\U0002fffd𰀀-\\U0003fffd\\U00040000-\\U0004fffd\\U00050000-\\U0005fffd\\U00060000-\\U0006fffd\\U00070000-\\U0007fffd\\U00080000-\\U0008fffd\\U00090000-\\U0009fffd\\U000a0000-\\U000afffd\\U000b0000-\\U000bfffd\\U000c0000-\\U000cfffd\\U000d0000-\\U000dfffd\\U000e0000-\\U000efffd\\U000f0000-\\U000ffffd0-\\U00010fff]",
 										chars:      []rune{'𐀀', 'D', '\t', '\n'},
-										ranges:     []rune{' ', '\u007f', '\u0080', '\ud7ff', '\ue000', '�', '𐀀', '\U0001fffd', '𠀀', '\U0002fffd', '\U00030000', '\U0003fffd', '\U00040000', '\U0004fffd', '\U00050000', '\U0005fffd', '\U00060000', '\U0006fffd', '\U00070000', '\U0007fffd', '\U00080000', '\U0008fffd', '\U00090000', '\U0009fffd', '\U000a0000', '\U000afffd', '\U000b0000', '\U000bfffd', '\U000c0000', '\U000cfffd', '\U000d0000', '\U000dfffd', '\U000e0000', '\U000efffd', '\U000f0000', '\U000ffffd', '0', '\U00010fff'},
+										ranges:     []rune{' ', '\x7f', '\u0080', '\ud7ff', '\ue000', '�', '𐀀', '\U0001fffd', '𠀀', '\U0002fffd', '𰀀', '\U0003fffd', '\U00040000', '\U0004fffd', '\U00050000', '\U0005fffd', '\U00060000', '\U0006fffd', '\U00070000', '\U0007fffd', '\U00080000', '\U0008fffd', '\U00090000', '\U0009fffd', '\U000a0000', '\U000afffd', '\U000b0000', '\U000bfffd', '\U000c0000', '\U000cfffd', '\U000d0000', '\U000dfffd', '\U000e0000', '\U000efffd', '\U000f0000', '\U000ffffd', '0', '\U00010fff'},
 										ignoreCase: false,
 										inverted:   false,
 									},
@@ -180,6 +186,7 @@ var g = &grammar{
 											pos:        position{line: 61, col: 14, offset: 1320},
 											val:        "\r\n",
 											ignoreCase: false,
+											want:       "\"\\r\\n\"",
 										},
 									},
 								},
@@ -213,6 +220,7 @@ var g = &grammar{
 							pos:        position{line: 61, col: 14, offset: 1320},
 							val:        "\r\n",
 							ignoreCase: false,
+							want:       "\"\\r\\n\"",
 						},
 					},
 					&actionExpr{
@@ -225,6 +233,7 @@ var g = &grammar{
 									pos:        position{line: 98, col: 15, offset: 2167},
 									val:        "--",
 									ignoreCase: false,
+									want:       "\"--\"",
 								},
 								&labeledExpr{
 									pos:   position{line: 98, col: 20, offset: 2172},
@@ -236,9 +245,9 @@ var g = &grammar{
 											pos: position{line: 98, col: 29, offset: 2181},
 											expr: &charClassMatcher{
 												pos:        position{line: 96, col: 10, offset: 2115},
-												val:        "[𐀀D\\t -\\u007f\\u0080-\\ud7ff\\ue000-�𐀀-\\U0001fffd𠀀-\\U0002fffd\\U00030000-\\U0003fffd\\U00040000-\\U0004fffd\\U00050000-\\U0005fffd\\U00060000-\\U0006fffd\\U00070000-\\U0007fffd\\U00080000-\\U0008fffd\\U00090000-\\U0009fffd\\U000a0000-\\U000afffd\\U000b0000-\\U000bfffd\\U000c0000-\\U000cfffd\\U000d0000-\\U000dfffd\\U000e0000-\\U000efffd\\U000f0000-\\U000ffffd0-\\U00010fff]",
+												val:        "[𐀀D\\t -\\x7f\\u0080-\\ud7ff\\ue000-�𐀀-\\U0001fffd𠀀-\\U0002fffd𰀀-\\U0003fffd\\U00040000-\\U0004fffd\\U00050000-\\U0005fffd\\U00060000-\\U0006fffd\\U00070000-\\U0007fffd\\U00080000-\\U0008fffd\\U00090000-\\U0009fffd\\U000a0000-\\U000afffd\\U000b0000-\\U000bfffd\\U000c0000-\\U000cfffd\\U000d0000-\\U000dfffd\\U000e0000-\\U000efffd\\U000f0000-\\U000ffffd0-\\U00010fff]",
 												chars:      []rune{'𐀀', 'D', '\t'},
-												ranges:     []rune{' ', '\u007f', '\u0080', '\ud7ff', '\ue000', '�', '𐀀', '\U0001fffd', '𠀀', '\U0002fffd', '\U00030000', '\U0003fffd', '\U00040000', '\U0004fffd', '\U00050000', '\U0005fffd', '\U00060000', '\U0006fffd', '\U00070000', '\U0007fffd', '\U00080000', '\U0008fffd', '\U00090000', '\U0009fffd', '\U000a0000', '\U000afffd', '\U000b0000', '\U000bfffd', '\U000c0000', '\U000cfffd', '\U000d0000', '\U000dfffd', '\U000e0000', '\U000efffd', '\U000f0000', '\U000ffffd', '0', '\U00010fff'},
+												ranges:     []rune{' ', '\x7f', '\u0080', '\ud7ff', '\ue000', '�', '𐀀', '\U0001fffd', '𠀀', '\U0002fffd', '𰀀', '\U0003fffd', '\U00040000', '\U0004fffd', '\U00050000', '\U0005fffd', '\U00060000', '\U0006fffd', '\U00070000', '\U0007fffd', '\U00080000', '\U0008fffd', '\U00090000', '\U0009fffd', '\U000a0000', '\U000afffd', '\U000b0000', '\U000bfffd', '\U000c0000', '\U000cfffd', '\U000d0000', '\U000dfffd', '\U000e0000', '\U000efffd', '\U000f0000', '\U000ffffd', '0', '\U00010fff'},
 												ignoreCase: false,
 												inverted:   false,
 											},
@@ -252,6 +261,7 @@ var g = &grammar{
 											pos:        position{line: 61, col: 7, offset: 1313},
 											val:        "\n",
 											ignoreCase: false,
+											want:       "\"\\n\"",
 										},
 										&actionExpr{
 											pos: position{line: 61, col: 14, offset: 1320},
@@ -260,6 +270,7 @@ var g = &grammar{
 												pos:        position{line: 61, col: 14, offset: 1320},
 												val:        "\r\n",
 												ignoreCase: false,
+												want:       "\"\\r\\n\"",
 											},
 										},
 									},
@@ -316,6 +327,7 @@ var g = &grammar{
 									pos:        position{line: 132, col: 6, offset: 3115},
 									val:        "\\",
 									ignoreCase: false,
+									want:       "\"\\\\\"",
 								},
 								&labeledExpr{
 									pos:   position{line: 132, col: 11, offset: 3120},
@@ -337,6 +349,7 @@ var g = &grammar{
 													pos:        position{line: 140, col: 8, offset: 3256},
 													val:        "b",
 													ignoreCase: false,
+													want:       "\"b\"",
 												},
 											},
 											&actionExpr{
@@ -346,6 +359,7 @@ var g = &grammar{
 													pos:        position{line: 141, col: 8, offset: 3296},
 													val:        "f",
 													ignoreCase: false,
+													want:       "\"f\"",
 												},
 											},
 											&actionExpr{
@@ -355,6 +369,7 @@ var g = &grammar{
 													pos:        position{line: 142, col: 8, offset: 3336},
 													val:        "n",
 													ignoreCase: false,
+													want:       "\"n\"",
 												},
 											},
 											&actionExpr{
@@ -364,6 +379,7 @@ var g = &grammar{
 													pos:        position{line: 143, col: 8, offset: 3376},
 													val:        "r",
 													ignoreCase: false,
+													want:       "\"r\"",
 												},
 											},
 											&actionExpr{
@@ -373,6 +389,7 @@ var g = &grammar{
 													pos:        position{line: 144, col: 8, offset: 3416},
 													val:        "t",
 													ignoreCase: false,
+													want:       "\"t\"",
 												},
 											},
 											&actionExpr{
@@ -385,6 +402,7 @@ var g = &grammar{
 															pos:        position{line: 145, col: 8, offset: 3456},
 															val:        "u",
 															ignoreCase: false,
+															want:       "\"u\"",
 														},
 														&labeledExpr{
 															pos:   position{line: 145, col: 12, offset: 3460},
@@ -487,6 +505,7 @@ var g = &grammar{
 																					pos:        position{line: 151, col: 9, offset: 3619},
 																					val:        "{",
 																					ignoreCase: false,
+																					want:       "\"{\"",
 																				},
 																				&oneOrMoreExpr{
 																					pos: position{line: 151, col: 13, offset: 3623},
@@ -514,6 +533,7 @@ var g = &grammar{
 																					pos:        position{line: 151, col: 21, offset: 3631},
 																					val:        "}",
 																					ignoreCase: false,
+																					want:       "\"}\"",
 																				},
 																			},
 																		},
@@ -532,9 +552,9 @@ var g = &grammar{
 					},
 					&charClassMatcher{
 						pos:        position{line: 156, col: 6, offset: 3740},
-						val:        "[𐀀D -!#-[]-\\u007f\\u0080-\\ud7ff\\ue000-�𐀀-\\U0001fffd𠀀-\\U0002fffd\\U00030000-\\U0003fffd\\U00040000-\\U0004fffd\\U00050000-\\U0005fffd\\U00060000-\\U0006fffd\\U00070000-\\U0007fffd\\U00080000-\\U0008fffd\\U00090000-\\U0009fffd\\U000a0000-\\U000afffd\\U000b0000-\\U000bfffd\\U000c0000-\\U000cfffd\\U000d0000-\\U000dfffd\\U000e0000-\\U000efffd\\U000f0000-\\U000ffffd0-\\U00010fff]",
+						val:        "[𐀀D -!#-[]-\\x7f\\u0080-\\ud7ff\\ue000-�𐀀-\\U0001fffd𠀀-\\U0002fffd𰀀-\\U0003fffd\\U00040000-\\U0004fffd\\U00050000-\\U0005fffd\\U00060000-\\U0006fffd\\U00070000-\\U0007fffd\\U00080000-\\U0008fffd\\U00090000-\\U0009fffd\\U000a0000-\\U000afffd\\U000b0000-\\U000bfffd\\U000c0000-\\U000cfffd\\U000d0000-\\U000dfffd\\U000e0000-\\U000efffd\\U000f0000-\\U000ffffd0-\\U00010fff]",
 						chars:      []rune{'𐀀', 'D'},
-						ranges:     []rune{' ', '!', '#', '[', ']', '\u007f', '\u0080', '\ud7ff', '\ue000', '�', '𐀀', '\U0001fffd', '𠀀', '\U0002fffd', '\U00030000', '\U0003fffd', '\U00040000', '\U0004fffd', '\U00050000', '\U0005fffd', '\U00060000', '\U0006fffd', '\U00070000', '\U0007fffd', '\U00080000', '\U0008fffd', '\U00090000', '\U0009fffd', '\U000a0000', '\U000afffd', '\U000b0000', '\U000bfffd', '\U000c0000', '\U000cfffd', '\U000d0000', '\U000dfffd', '\U000e0000', '\U000efffd', '\U000f0000', '\U000ffffd', '0', '\U00010fff'},
+						ranges:     []rune{' ', '!', '#', '[', ']', '\x7f', '\u0080', '\ud7ff', '\ue000', '�', '𐀀', '\U0001fffd', '𠀀', '\U0002fffd', '𰀀', '\U0003fffd', '\U00040000', '\U0004fffd', '\U00050000', '\U0005fffd', '\U00060000', '\U0006fffd', '\U00070000', '\U0007fffd', '\U00080000', '\U0008fffd', '\U00090000', '\U0009fffd', '\U000a0000', '\U000afffd', '\U000b0000', '\U000bfffd', '\U000c0000', '\U000cfffd', '\U000d0000', '\U000dfffd', '\U000e0000', '\U000efffd', '\U000f0000', '\U000ffffd', '0', '\U00010fff'},
 						ignoreCase: false,
 						inverted:   false,
 					},
@@ -554,6 +574,7 @@ var g = &grammar{
 							pos:        position{line: 161, col: 22, offset: 3829},
 							val:        "\"",
 							ignoreCase: false,
+							want:       "\"\\\"\"",
 						},
 						&labeledExpr{
 							pos:   position{line: 161, col: 26, offset: 3833},
@@ -570,6 +591,7 @@ var g = &grammar{
 							pos:        position{line: 161, col: 51, offset: 3858},
 							val:        "\"",
 							ignoreCase: false,
+							want:       "\"\\\"\"",
 						},
 					},
 				},
@@ -604,6 +626,7 @@ var g = &grammar{
 									pos:        position{line: 185, col: 20, offset: 4559},
 									val:        "'''",
 									ignoreCase: false,
+									want:       "\"'''\"",
 								},
 							},
 							&ruleRefExpr{
@@ -622,6 +645,7 @@ var g = &grammar{
 									pos:        position{line: 189, col: 24, offset: 4719},
 									val:        "''${",
 									ignoreCase: false,
+									want:       "\"''${\"",
 								},
 							},
 							&ruleRefExpr{
@@ -638,9 +662,9 @@ var g = &grammar{
 								alternatives: []interface{}{
 									&charClassMatcher{
 										pos:        position{line: 192, col: 6, offset: 4786},
-										val:        "[𐀀D\\t\\n -\\u007f\\u0080-\\ud7ff\\ue000-�𐀀-\\U0001fffd𠀀-\\U0002fffd\\U00030000-\\U0003fffd\\U00040000-\\U0004fffd\\U00050000-\\U0005fffd\\U00060000-\\U0006fffd\\U00070000-\\U0007fffd\\U00080000-\\U0008fffd\\U00090000-\\U0009fffd\\U000a0000-\\U000afffd\\U000b0000-\\U000bfffd\\U000c0000-\\U000cfffd\\U000d0000-\\U000dfffd\\U000e0000-\\U000efffd\\U000f0000-\\U000ffffd0-\\U00010fff]",
+										val:        "[𐀀D\\t\\n -\\x7f\\u0080-\\ud7ff\\ue000-�𐀀-\\U0001fffd𠀀-\\U0002fffd𰀀-\\U0003fffd\\U00040000-\\U0004fffd\\U00050000-\\U0005fffd\\U00060000-\\U0006fffd\\U00070000-\\U0007fffd\\U00080000-\\U0008fffd\\U00090000-\\U0009fffd\\U000a0000-\\U000afffd\\U000b0000-\\U000bfffd\\U000c0000-\\U000cfffd\\U000d0000-\\U000dfffd\\U000e0000-\\U000efffd\\U000f0000-\\U000ffffd0-\\U00010fff]",
 										chars:      []rune{'𐀀', 'D', '\t', '\n'},
-										ranges:     []rune{' ', '\u007f', '\u0080', '\ud7ff', '\ue000', '�', '𐀀', '\U0001fffd', '𠀀', '\U0002fffd', '\U00030000', '\U0003fffd', '\U00040000', '\U0004fffd', '\U00050000', '\U0005fffd', '\U00060000', '\U0006fffd', '\U00070000', '\U0007fffd', '\U00080000', '\U0008fffd', '\U00090000', '\U0009fffd', '\U000a0000', '\U000afffd', '\U000b0000', '\U000bfffd', '\U000c0000', '\U000cfffd', '\U000d0000', '\U000dfffd', '\U000e0000', '\U000efffd', '\U000f0000', '\U000ffffd', '0', '\U00010fff'},
+										ranges:     []rune{' ', '\x7f', '\u0080', '\ud7ff', '\ue000', '�', '𐀀', '\U0001fffd', '𠀀', '\U0002fffd', '𰀀', '\U0003fffd', '\U00040000', '\U0004fffd', '\U00050000', '\U0005fffd', '\U00060000', '\U0006fffd', '\U00070000', '\U0007fffd', '\U00080000', '\U0008fffd', '\U00090000', '\U0009fffd', '\U000a0000', '\U000afffd', '\U000b0000', '\U000bfffd', '\U000c0000', '\U000cfffd', '\U000d0000', '\U000dfffd', '\U000e0000', '\U000efffd', '\U000f0000', '\U000ffffd', '0', '\U00010fff'},
 										ignoreCase: false,
 										inverted:   false,
 									},
@@ -651,6 +675,7 @@ var g = &grammar{
 											pos:        position{line: 61, col: 14, offset: 1320},
 											val:        "\r\n",
 											ignoreCase: false,
+											want:       "\"\\r\\n\"",
 										},
 									},
 								},
@@ -665,6 +690,7 @@ var g = &grammar{
 						pos:        position{line: 183, col: 7, offset: 4532},
 						val:        "''",
 						ignoreCase: false,
+						want:       "\"''\"",
 					},
 				},
 			},
@@ -682,6 +708,7 @@ var g = &grammar{
 							pos:        position{line: 197, col: 22, offset: 4860},
 							val:        "''",
 							ignoreCase: false,
+							want:       "\"''\"",
 						},
 						&choiceExpr{
 							pos: position{line: 61, col: 7, offset: 1313},
@@ -690,6 +717,7 @@ var g = &grammar{
 									pos:        position{line: 61, col: 7, offset: 1313},
 									val:        "\n",
 									ignoreCase: false,
+									want:       "\"\\n\"",
 								},
 								&actionExpr{
 									pos: position{line: 61, col: 14, offset: 1320},
@@ -698,6 +726,7 @@ var g = &grammar{
 										pos:        position{line: 61, col: 14, offset: 1320},
 										val:        "\r\n",
 										ignoreCase: false,
+										want:       "\"\\r\\n\"",
 									},
 								},
 							},
@@ -727,6 +756,7 @@ var g = &grammar{
 							pos:        position{line: 215, col: 17, offset: 5449},
 							val:        "${",
 							ignoreCase: false,
+							want:       "\"${\"",
 						},
 						&labeledExpr{
 							pos:   position{line: 215, col: 22, offset: 5454},
@@ -740,6 +770,7 @@ var g = &grammar{
 							pos:        position{line: 215, col: 43, offset: 5475},
 							val:        "}",
 							ignoreCase: false,
+							want:       "\"}\"",
 						},
 					},
 				},
@@ -764,34 +795,35 @@ var g = &grammar{
 		},
 		{
 			name: "DeBruijn",
-			pos:  position{line: 324, col: 1, offset: 8542},
+			pos:  position{line: 325, col: 1, offset: 8681},
 			expr: &actionExpr{
-				pos: position{line: 324, col: 12, offset: 8555},
+				pos: position{line: 325, col: 12, offset: 8694},
 				run: (*parser).callonDeBruijn1,
 				expr: &seqExpr{
-					pos: position{line: 324, col: 12, offset: 8555},
+					pos: position{line: 325, col: 12, offset: 8694},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 324, col: 12, offset: 8555},
+							pos:  position{line: 325, col: 12, offset: 8694},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 324, col: 14, offset: 8557},
+							pos:        position{line: 325, col: 14, offset: 8696},
 							val:        "@",
 							ignoreCase: false,
+							want:       "\"@\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 324, col: 18, offset: 8561},
+							pos:  position{line: 325, col: 18, offset: 8700},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 324, col: 20, offset: 8563},
+							pos:   position{line: 325, col: 20, offset: 8702},
 							label: "index",
 							expr: &actionExpr{
-								pos: position{line: 311, col: 18, offset: 8278},
+								pos: position{line: 312, col: 18, offset: 8417},
 								run: (*parser).callonDeBruijn7,
 								expr: &oneOrMoreExpr{
-									pos: position{line: 311, col: 18, offset: 8278},
+									pos: position{line: 312, col: 18, offset: 8417},
 									expr: &charClassMatcher{
 										pos:        position{line: 106, col: 9, offset: 2375},
 										val:        "[0-9]",
@@ -808,15 +840,15 @@ var g = &grammar{
 		},
 		{
 			name: "Variable",
-			pos:  position{line: 326, col: 1, offset: 8625},
+			pos:  position{line: 327, col: 1, offset: 8764},
 			expr: &actionExpr{
-				pos: position{line: 326, col: 12, offset: 8638},
+				pos: position{line: 327, col: 12, offset: 8777},
 				run: (*parser).callonVariable1,
 				expr: &seqExpr{
-					pos: position{line: 326, col: 12, offset: 8638},
+					pos: position{line: 327, col: 12, offset: 8777},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 326, col: 12, offset: 8638},
+							pos:   position{line: 327, col: 12, offset: 8777},
 							label: "name",
 							expr: &choiceExpr{
 								pos: position{line: 124, col: 20, offset: 2920},
@@ -842,6 +874,7 @@ var g = &grammar{
 																			pos:        position{line: 221, col: 5, offset: 5657},
 																			val:        "Natural/build",
 																			ignoreCase: false,
+																			want:       "\"Natural/build\"",
 																		},
 																	},
 																	&actionExpr{
@@ -851,6 +884,7 @@ var g = &grammar{
 																			pos:        position{line: 222, col: 5, offset: 5706},
 																			val:        "Natural/fold",
 																			ignoreCase: false,
+																			want:       "\"Natural/fold\"",
 																		},
 																	},
 																	&actionExpr{
@@ -860,6 +894,7 @@ var g = &grammar{
 																			pos:        position{line: 223, col: 5, offset: 5753},
 																			val:        "Natural/isZero",
 																			ignoreCase: false,
+																			want:       "\"Natural/isZero\"",
 																		},
 																	},
 																	&actionExpr{
@@ -869,6 +904,7 @@ var g = &grammar{
 																			pos:        position{line: 224, col: 5, offset: 5804},
 																			val:        "Natural/even",
 																			ignoreCase: false,
+																			want:       "\"Natural/even\"",
 																		},
 																	},
 																	&actionExpr{
@@ -878,6 +914,7 @@ var g = &grammar{
 																			pos:        position{line: 225, col: 5, offset: 5851},
 																			val:        "Natural/odd",
 																			ignoreCase: false,
+																			want:       "\"Natural/odd\"",
 																		},
 																	},
 																	&actionExpr{
@@ -887,6 +924,7 @@ var g = &grammar{
 																			pos:        position{line: 226, col: 5, offset: 5896},
 																			val:        "Natural/toInteger",
 																			ignoreCase: false,
+																			want:       "\"Natural/toInteger\"",
 																		},
 																	},
 																	&actionExpr{
@@ -896,6 +934,7 @@ var g = &grammar{
 																			pos:        position{line: 227, col: 5, offset: 5953},
 																			val:        "Natural/show",
 																			ignoreCase: false,
+																			want:       "\"Natural/show\"",
 																		},
 																	},
 																	&actionExpr{
@@ -905,6 +944,7 @@ var g = &grammar{
 																			pos:        position{line: 228, col: 5, offset: 6000},
 																			val:        "Natural/subtract",
 																			ignoreCase: false,
+																			want:       "\"Natural/subtract\"",
 																		},
 																	},
 																	&actionExpr{
@@ -914,6 +954,7 @@ var g = &grammar{
 																			pos:        position{line: 229, col: 5, offset: 6055},
 																			val:        "Integer/toDouble",
 																			ignoreCase: false,
+																			want:       "\"Integer/toDouble\"",
 																		},
 																	},
 																	&actionExpr{
@@ -923,6 +964,7 @@ var g = &grammar{
 																			pos:        position{line: 230, col: 5, offset: 6110},
 																			val:        "Integer/show",
 																			ignoreCase: false,
+																			want:       "\"Integer/show\"",
 																		},
 																	},
 																	&actionExpr{
@@ -932,6 +974,7 @@ var g = &grammar{
 																			pos:        position{line: 231, col: 5, offset: 6157},
 																			val:        "Double/show",
 																			ignoreCase: false,
+																			want:       "\"Double/show\"",
 																		},
 																	},
 																	&actionExpr{
@@ -941,6 +984,7 @@ var g = &grammar{
 																			pos:        position{line: 232, col: 5, offset: 6202},
 																			val:        "List/build",
 																			ignoreCase: false,
+																			want:       "\"List/build\"",
 																		},
 																	},
 																	&actionExpr{
@@ -950,6 +994,7 @@ var g = &grammar{
 																			pos:        position{line: 233, col: 5, offset: 6245},
 																			val:        "List/fold",
 																			ignoreCase: false,
+																			want:       "\"List/fold\"",
 																		},
 																	},
 																	&actionExpr{
@@ -959,6 +1004,7 @@ var g = &grammar{
 																			pos:        position{line: 234, col: 5, offset: 6286},
 																			val:        "List/length",
 																			ignoreCase: false,
+																			want:       "\"List/length\"",
 																		},
 																	},
 																	&actionExpr{
@@ -968,6 +1014,7 @@ var g = &grammar{
 																			pos:        position{line: 235, col: 5, offset: 6331},
 																			val:        "List/head",
 																			ignoreCase: false,
+																			want:       "\"List/head\"",
 																		},
 																	},
 																	&actionExpr{
@@ -977,6 +1024,7 @@ var g = &grammar{
 																			pos:        position{line: 236, col: 5, offset: 6372},
 																			val:        "List/last",
 																			ignoreCase: false,
+																			want:       "\"List/last\"",
 																		},
 																	},
 																	&actionExpr{
@@ -986,6 +1034,7 @@ var g = &grammar{
 																			pos:        position{line: 237, col: 5, offset: 6413},
 																			val:        "List/indexed",
 																			ignoreCase: false,
+																			want:       "\"List/indexed\"",
 																		},
 																	},
 																	&actionExpr{
@@ -995,6 +1044,7 @@ var g = &grammar{
 																			pos:        position{line: 238, col: 5, offset: 6460},
 																			val:        "List/reverse",
 																			ignoreCase: false,
+																			want:       "\"List/reverse\"",
 																		},
 																	},
 																	&actionExpr{
@@ -1004,6 +1054,7 @@ var g = &grammar{
 																			pos:        position{line: 239, col: 5, offset: 6507},
 																			val:        "Optional/build",
 																			ignoreCase: false,
+																			want:       "\"Optional/build\"",
 																		},
 																	},
 																	&actionExpr{
@@ -1013,6 +1064,7 @@ var g = &grammar{
 																			pos:        position{line: 240, col: 5, offset: 6558},
 																			val:        "Optional/fold",
 																			ignoreCase: false,
+																			want:       "\"Optional/fold\"",
 																		},
 																	},
 																	&actionExpr{
@@ -1022,6 +1074,7 @@ var g = &grammar{
 																			pos:        position{line: 241, col: 5, offset: 6607},
 																			val:        "Text/show",
 																			ignoreCase: false,
+																			want:       "\"Text/show\"",
 																		},
 																	},
 																	&actionExpr{
@@ -1031,6 +1084,7 @@ var g = &grammar{
 																			pos:        position{line: 242, col: 5, offset: 6648},
 																			val:        "Bool",
 																			ignoreCase: false,
+																			want:       "\"Bool\"",
 																		},
 																	},
 																	&actionExpr{
@@ -1040,6 +1094,7 @@ var g = &grammar{
 																			pos:        position{line: 243, col: 5, offset: 6680},
 																			val:        "True",
 																			ignoreCase: false,
+																			want:       "\"True\"",
 																		},
 																	},
 																	&actionExpr{
@@ -1049,6 +1104,7 @@ var g = &grammar{
 																			pos:        position{line: 244, col: 5, offset: 6712},
 																			val:        "False",
 																			ignoreCase: false,
+																			want:       "\"False\"",
 																		},
 																	},
 																	&actionExpr{
@@ -1058,6 +1114,7 @@ var g = &grammar{
 																			pos:        position{line: 245, col: 5, offset: 6746},
 																			val:        "Optional",
 																			ignoreCase: false,
+																			want:       "\"Optional\"",
 																		},
 																	},
 																	&actionExpr{
@@ -1067,6 +1124,7 @@ var g = &grammar{
 																			pos:        position{line: 246, col: 5, offset: 6786},
 																			val:        "Natural",
 																			ignoreCase: false,
+																			want:       "\"Natural\"",
 																		},
 																	},
 																	&actionExpr{
@@ -1076,6 +1134,7 @@ var g = &grammar{
 																			pos:        position{line: 247, col: 5, offset: 6824},
 																			val:        "Integer",
 																			ignoreCase: false,
+																			want:       "\"Integer\"",
 																		},
 																	},
 																	&actionExpr{
@@ -1085,6 +1144,7 @@ var g = &grammar{
 																			pos:        position{line: 248, col: 5, offset: 6862},
 																			val:        "Double",
 																			ignoreCase: false,
+																			want:       "\"Double\"",
 																		},
 																	},
 																	&actionExpr{
@@ -1094,6 +1154,7 @@ var g = &grammar{
 																			pos:        position{line: 249, col: 5, offset: 6898},
 																			val:        "Text",
 																			ignoreCase: false,
+																			want:       "\"Text\"",
 																		},
 																	},
 																	&actionExpr{
@@ -1103,6 +1164,7 @@ var g = &grammar{
 																			pos:        position{line: 250, col: 5, offset: 6930},
 																			val:        "List",
 																			ignoreCase: false,
+																			want:       "\"List\"",
 																		},
 																	},
 																	&actionExpr{
@@ -1112,6 +1174,7 @@ var g = &grammar{
 																			pos:        position{line: 251, col: 5, offset: 6962},
 																			val:        "None",
 																			ignoreCase: false,
+																			want:       "\"None\"",
 																		},
 																	},
 																	&actionExpr{
@@ -1121,6 +1184,7 @@ var g = &grammar{
 																			pos:        position{line: 252, col: 5, offset: 6994},
 																			val:        "Type",
 																			ignoreCase: false,
+																			want:       "\"Type\"",
 																		},
 																	},
 																	&actionExpr{
@@ -1130,6 +1194,7 @@ var g = &grammar{
 																			pos:        position{line: 253, col: 5, offset: 7026},
 																			val:        "Kind",
 																			ignoreCase: false,
+																			want:       "\"Kind\"",
 																		},
 																	},
 																	&actionExpr{
@@ -1139,6 +1204,7 @@ var g = &grammar{
 																			pos:        position{line: 254, col: 5, offset: 7058},
 																			val:        "Sort",
 																			ignoreCase: false,
+																			want:       "\"Sort\"",
 																		},
 																	},
 																},
@@ -1170,6 +1236,7 @@ var g = &grammar{
 																			pos:        position{line: 121, col: 9, offset: 2802},
 																			val:        "`",
 																			ignoreCase: false,
+																			want:       "\"`\"",
 																		},
 																		&labeledExpr{
 																			pos:   position{line: 121, col: 13, offset: 2806},
@@ -1193,6 +1260,7 @@ var g = &grammar{
 																			pos:        position{line: 121, col: 31, offset: 2824},
 																			val:        "`",
 																			ignoreCase: false,
+																			want:       "\"`\"",
 																		},
 																	},
 																},
@@ -1213,37 +1281,43 @@ var g = &grammar{
 																					pos: position{line: 112, col: 15, offset: 2499},
 																					exprs: []interface{}{
 																						&choiceExpr{
-																							pos: position{line: 274, col: 5, offset: 7396},
+																							pos: position{line: 276, col: 5, offset: 7537},
 																							alternatives: []interface{}{
 																								&litMatcher{
 																									pos:        position{line: 256, col: 6, offset: 7094},
 																									val:        "if",
 																									ignoreCase: false,
+																									want:       "\"if\"",
 																								},
 																								&litMatcher{
 																									pos:        position{line: 257, col: 8, offset: 7108},
 																									val:        "then",
 																									ignoreCase: false,
+																									want:       "\"then\"",
 																								},
 																								&litMatcher{
 																									pos:        position{line: 258, col: 8, offset: 7124},
 																									val:        "else",
 																									ignoreCase: false,
+																									want:       "\"else\"",
 																								},
 																								&litMatcher{
 																									pos:        position{line: 259, col: 7, offset: 7139},
 																									val:        "let",
 																									ignoreCase: false,
+																									want:       "\"let\"",
 																								},
 																								&litMatcher{
 																									pos:        position{line: 260, col: 6, offset: 7152},
 																									val:        "in",
 																									ignoreCase: false,
+																									want:       "\"in\"",
 																								},
 																								&litMatcher{
 																									pos:        position{line: 262, col: 9, offset: 7179},
 																									val:        "using",
 																									ignoreCase: false,
+																									want:       "\"using\"",
 																								},
 																								&actionExpr{
 																									pos: position{line: 264, col: 11, offset: 7217},
@@ -1252,52 +1326,62 @@ var g = &grammar{
 																										pos:        position{line: 264, col: 11, offset: 7217},
 																										val:        "missing",
 																										ignoreCase: false,
+																										want:       "\"missing\"",
 																									},
 																								},
+																								&litMatcher{
+																									pos:        position{line: 271, col: 10, offset: 7370},
+																									val:        "assert",
+																									ignoreCase: false,
+																									want:       "\"assert\"",
+																								},
 																								&litMatcher{
 																									pos:        position{line: 261, col: 6, offset: 7164},
 																									val:        "as",
 																									ignoreCase: false,
+																									want:       "\"as\"",
 																								},
 																								&litMatcher{
 																									pos:        position{line: 265, col: 8, offset: 7262},
 																									val:        "True",
 																									ignoreCase: false,
+																									want:       "\"True\"",
 																								},
 																								&litMatcher{
 																									pos:        position{line: 266, col: 9, offset: 7279},
 																									val:        "False",
 																									ignoreCase: false,
+																									want:       "\"False\"",
 																								},
 																								&litMatcher{
 																									pos:        position{line: 267, col: 12, offset: 7300},
 																									val:        "Infinity",
 																									ignoreCase: false,
+																									want:       "\"Infinity\"",
 																								},
 																								&litMatcher{
 																									pos:        position{line: 268, col: 7, offset: 7319},
 																									val:        "NaN",
 																									ignoreCase: false,
+																									want:       "\"NaN\"",
 																								},
 																								&litMatcher{
 																									pos:        position{line: 263, col: 9, offset: 7197},
 																									val:        "merge",
 																									ignoreCase: false,
+																									want:       "\"merge\"",
 																								},
 																								&litMatcher{
 																									pos:        position{line: 269, col: 8, offset: 7334},
 																									val:        "Some",
 																									ignoreCase: false,
+																									want:       "\"Some\"",
 																								},
 																								&litMatcher{
 																									pos:        position{line: 270, col: 9, offset: 7351},
 																									val:        "toMap",
 																									ignoreCase: false,
-																								},
-																								&litMatcher{
-																									pos:        position{line: 271, col: 10, offset: 7370},
-																									val:        "assert",
-																									ignoreCase: false,
+																									want:       "\"toMap\"",
 																								},
 																							},
 																						},
@@ -1324,37 +1408,43 @@ var g = &grammar{
 																						&notExpr{
 																							pos: position{line: 113, col: 13, offset: 2571},
 																							expr: &choiceExpr{
-																								pos: position{line: 274, col: 5, offset: 7396},
+																								pos: position{line: 276, col: 5, offset: 7537},
 																								alternatives: []interface{}{
 																									&litMatcher{
 																										pos:        position{line: 256, col: 6, offset: 7094},
 																										val:        "if",
 																										ignoreCase: false,
+																										want:       "\"if\"",
 																									},
 																									&litMatcher{
 																										pos:        position{line: 257, col: 8, offset: 7108},
 																										val:        "then",
 																										ignoreCase: false,
+																										want:       "\"then\"",
 																									},
 																									&litMatcher{
 																										pos:        position{line: 258, col: 8, offset: 7124},
 																										val:        "else",
 																										ignoreCase: false,
+																										want:       "\"else\"",
 																									},
 																									&litMatcher{
 																										pos:        position{line: 259, col: 7, offset: 7139},
 																										val:        "let",
 																										ignoreCase: false,
+																										want:       "\"let\"",
 																									},
 																									&litMatcher{
 																										pos:        position{line: 260, col: 6, offset: 7152},
 																										val:        "in",
 																										ignoreCase: false,
+																										want:       "\"in\"",
 																									},
 																									&litMatcher{
 																										pos:        position{line: 262, col: 9, offset: 7179},
 																										val:        "using",
 																										ignoreCase: false,
+																										want:       "\"using\"",
 																									},
 																									&actionExpr{
 																										pos: position{line: 264, col: 11, offset: 7217},
@@ -1363,52 +1453,62 @@ var g = &grammar{
 																											pos:        position{line: 264, col: 11, offset: 7217},
 																											val:        "missing",
 																											ignoreCase: false,
+																											want:       "\"missing\"",
 																										},
 																									},
 																									&litMatcher{
+																										pos:        position{line: 271, col: 10, offset: 7370},
+																										val:        "assert",
+																										ignoreCase: false,
+																										want:       "\"assert\"",
+																									},
+																									&litMatcher{
 																										pos:        position{line: 261, col: 6, offset: 7164},
 																										val:        "as",
 																										ignoreCase: false,
+																										want:       "\"as\"",
 																									},
 																									&litMatcher{
 																										pos:        position{line: 265, col: 8, offset: 7262},
 																										val:        "True",
 																										ignoreCase: false,
+																										want:       "\"True\"",
 																									},
 																									&litMatcher{
 																										pos:        position{line: 266, col: 9, offset: 7279},
 																										val:        "False",
 																										ignoreCase: false,
+																										want:       "\"False\"",
 																									},
 																									&litMatcher{
 																										pos:        position{line: 267, col: 12, offset: 7300},
 																										val:        "Infinity",
 																										ignoreCase: false,
+																										want:       "\"Infinity\"",
 																									},
 																									&litMatcher{
 																										pos:        position{line: 268, col: 7, offset: 7319},
 																										val:        "NaN",
 																										ignoreCase: false,
+																										want:       "\"NaN\"",
 																									},
 																									&litMatcher{
 																										pos:        position{line: 263, col: 9, offset: 7197},
 																										val:        "merge",
 																										ignoreCase: false,
+																										want:       "\"merge\"",
 																									},
 																									&litMatcher{
 																										pos:        position{line: 269, col: 8, offset: 7334},
 																										val:        "Some",
 																										ignoreCase: false,
+																										want:       "\"Some\"",
 																									},
 																									&litMatcher{
 																										pos:        position{line: 270, col: 9, offset: 7351},
 																										val:        "toMap",
 																										ignoreCase: false,
-																									},
-																									&litMatcher{
-																										pos:        position{line: 271, col: 10, offset: 7370},
-																										val:        "assert",
-																										ignoreCase: false,
+																										want:       "\"toMap\"",
 																									},
 																								},
 																							},
@@ -1463,6 +1563,7 @@ var g = &grammar{
 																	pos:        position{line: 221, col: 5, offset: 5657},
 																	val:        "Natural/build",
 																	ignoreCase: false,
+																	want:       "\"Natural/build\"",
 																},
 															},
 															&actionExpr{
@@ -1472,6 +1573,7 @@ var g = &grammar{
 																	pos:        position{line: 222, col: 5, offset: 5706},
 																	val:        "Natural/fold",
 																	ignoreCase: false,
+																	want:       "\"Natural/fold\"",
 																},
 															},
 															&actionExpr{
@@ -1481,6 +1583,7 @@ var g = &grammar{
 																	pos:        position{line: 223, col: 5, offset: 5753},
 																	val:        "Natural/isZero",
 																	ignoreCase: false,
+																	want:       "\"Natural/isZero\"",
 																},
 															},
 															&actionExpr{
@@ -1490,6 +1593,7 @@ var g = &grammar{
 																	pos:        position{line: 224, col: 5, offset: 5804},
 																	val:        "Natural/even",
 																	ignoreCase: false,
+																	want:       "\"Natural/even\"",
 																},
 															},
 															&actionExpr{
@@ -1499,6 +1603,7 @@ var g = &grammar{
 																	pos:        position{line: 225, col: 5, offset: 5851},
 																	val:        "Natural/odd",
 																	ignoreCase: false,
+																	want:       "\"Natural/odd\"",
 																},
 															},
 															&actionExpr{
@@ -1508,6 +1613,7 @@ var g = &grammar{
 																	pos:        position{line: 226, col: 5, offset: 5896},
 																	val:        "Natural/toInteger",
 																	ignoreCase: false,
+																	want:       "\"Natural/toInteger\"",
 																},
 															},
 															&actionExpr{
@@ -1517,6 +1623,7 @@ var g = &grammar{
 																	pos:        position{line: 227, col: 5, offset: 5953},
 																	val:        "Natural/show",
 																	ignoreCase: false,
+																	want:       "\"Natural/show\"",
 																},
 															},
 															&actionExpr{
@@ -1526,6 +1633,7 @@ var g = &grammar{
 																	pos:        position{line: 228, col: 5, offset: 6000},
 																	val:        "Natural/subtract",
 																	ignoreCase: false,
+																	want:       "\"Natural/subtract\"",
 																},
 															},
 															&actionExpr{
@@ -1535,6 +1643,7 @@ var g = &grammar{
 																	pos:        position{line: 229, col: 5, offset: 6055},
 																	val:        "Integer/toDouble",
 																	ignoreCase: false,
+																	want:       "\"Integer/toDouble\"",
 																},
 															},
 															&actionExpr{
@@ -1544,6 +1653,7 @@ var g = &grammar{
 																	pos:        position{line: 230, col: 5, offset: 6110},
 																	val:        "Integer/show",
 																	ignoreCase: false,
+																	want:       "\"Integer/show\"",
 																},
 															},
 															&actionExpr{
@@ -1553,6 +1663,7 @@ var g = &grammar{
 																	pos:        position{line: 231, col: 5, offset: 6157},
 																	val:        "Double/show",
 																	ignoreCase: false,
+																	want:       "\"Double/show\"",
 																},
 															},
 															&actionExpr{
@@ -1562,6 +1673,7 @@ var g = &grammar{
 																	pos:        position{line: 232, col: 5, offset: 6202},
 																	val:        "List/build",
 																	ignoreCase: false,
+																	want:       "\"List/build\"",
 																},
 															},
 															&actionExpr{
@@ -1571,6 +1683,7 @@ var g = &grammar{
 																	pos:        position{line: 233, col: 5, offset: 6245},
 																	val:        "List/fold",
 																	ignoreCase: false,
+																	want:       "\"List/fold\"",
 																},
 															},
 															&actionExpr{
@@ -1580,6 +1693,7 @@ var g = &grammar{
 																	pos:        position{line: 234, col: 5, offset: 6286},
 																	val:        "List/length",
 																	ignoreCase: false,
+																	want:       "\"List/length\"",
 																},
 															},
 															&actionExpr{
@@ -1589,6 +1703,7 @@ var g = &grammar{
 																	pos:        position{line: 235, col: 5, offset: 6331},
 																	val:        "List/head",
 																	ignoreCase: false,
+																	want:       "\"List/head\"",
 																},
 															},
 															&actionExpr{
@@ -1598,6 +1713,7 @@ var g = &grammar{
 																	pos:        position{line: 236, col: 5, offset: 6372},
 																	val:        "List/last",
 																	ignoreCase: false,
+																	want:       "\"List/last\"",
 																},
 															},
 															&actionExpr{
@@ -1607,6 +1723,7 @@ var g = &grammar{
 																	pos:        position{line: 237, col: 5, offset: 6413},
 																	val:        "List/indexed",
 																	ignoreCase: false,
+																	want:       "\"List/indexed\"",
 																},
 															},
 															&actionExpr{
@@ -1616,6 +1733,7 @@ var g = &grammar{
 																	pos:        position{line: 238, col: 5, offset: 6460},
 																	val:        "List/reverse",
 																	ignoreCase: false,
+																	want:       "\"List/reverse\"",
 																},
 															},
 															&actionExpr{
@@ -1625,6 +1743,7 @@ var g = &grammar{
 																	pos:        position{line: 239, col: 5, offset: 6507},
 																	val:        "Optional/build",
 																	ignoreCase: false,
+																	want:       "\"Optional/build\"",
 																},
 															},
 															&actionExpr{
@@ -1634,6 +1753,7 @@ var g = &grammar{
 																	pos:        position{line: 240, col: 5, offset: 6558},
 																	val:        "Optional/fold",
 																	ignoreCase: false,
+																	want:       "\"Optional/fold\"",
 																},
 															},
 															&actionExpr{
@@ -1643,6 +1763,7 @@ var g = &grammar{
 																	pos:        position{line: 241, col: 5, offset: 6607},
 																	val:        "Text/show",
 																	ignoreCase: false,
+																	want:       "\"Text/show\"",
 																},
 															},
 															&actionExpr{
@@ -1652,6 +1773,7 @@ var g = &grammar{
 																	pos:        position{line: 242, col: 5, offset: 6648},
 																	val:        "Bool",
 																	ignoreCase: false,
+																	want:       "\"Bool\"",
 																},
 															},
 															&actionExpr{
@@ -1661,6 +1783,7 @@ var g = &grammar{
 																	pos:        position{line: 243, col: 5, offset: 6680},
 																	val:        "True",
 																	ignoreCase: false,
+																	want:       "\"True\"",
 																},
 															},
 															&actionExpr{
@@ -1670,6 +1793,7 @@ var g = &grammar{
 																	pos:        position{line: 244, col: 5, offset: 6712},
 																	val:        "False",
 																	ignoreCase: false,
+																	want:       "\"False\"",
 																},
 															},
 															&actionExpr{
@@ -1679,6 +1803,7 @@ var g = &grammar{
 																	pos:        position{line: 245, col: 5, offset: 6746},
 																	val:        "Optional",
 																	ignoreCase: false,
+																	want:       "\"Optional\"",
 																},
 															},
 															&actionExpr{
@@ -1688,6 +1813,7 @@ var g = &grammar{
 																	pos:        position{line: 246, col: 5, offset: 6786},
 																	val:        "Natural",
 																	ignoreCase: false,
+																	want:       "\"Natural\"",
 																},
 															},
 															&actionExpr{
@@ -1697,6 +1823,7 @@ var g = &grammar{
 																	pos:        position{line: 247, col: 5, offset: 6824},
 																	val:        "Integer",
 																	ignoreCase: false,
+																	want:       "\"Integer\"",
 																},
 															},
 															&actionExpr{
@@ -1706,6 +1833,7 @@ var g = &grammar{
 																	pos:        position{line: 248, col: 5, offset: 6862},
 																	val:        "Double",
 																	ignoreCase: false,
+																	want:       "\"Double\"",
 																},
 															},
 															&actionExpr{
@@ -1715,6 +1843,7 @@ var g = &grammar{
 																	pos:        position{line: 249, col: 5, offset: 6898},
 																	val:        "Text",
 																	ignoreCase: false,
+																	want:       "\"Text\"",
 																},
 															},
 															&actionExpr{
@@ -1724,6 +1853,7 @@ var g = &grammar{
 																	pos:        position{line: 250, col: 5, offset: 6930},
 																	val:        "List",
 																	ignoreCase: false,
+																	want:       "\"List\"",
 																},
 															},
 															&actionExpr{
@@ -1733,6 +1863,7 @@ var g = &grammar{
 																	pos:        position{line: 251, col: 5, offset: 6962},
 																	val:        "None",
 																	ignoreCase: false,
+																	want:       "\"None\"",
 																},
 															},
 															&actionExpr{
@@ -1742,6 +1873,7 @@ var g = &grammar{
 																	pos:        position{line: 252, col: 5, offset: 6994},
 																	val:        "Type",
 																	ignoreCase: false,
+																	want:       "\"Type\"",
 																},
 															},
 															&actionExpr{
@@ -1751,6 +1883,7 @@ var g = &grammar{
 																	pos:        position{line: 253, col: 5, offset: 7026},
 																	val:        "Kind",
 																	ignoreCase: false,
+																	want:       "\"Kind\"",
 																},
 															},
 															&actionExpr{
@@ -1760,6 +1893,7 @@ var g = &grammar{
 																	pos:        position{line: 254, col: 5, offset: 7058},
 																	val:        "Sort",
 																	ignoreCase: false,
+																	want:       "\"Sort\"",
 																},
 															},
 														},
@@ -1781,6 +1915,7 @@ var g = &grammar{
 																			pos:        position{line: 121, col: 9, offset: 2802},
 																			val:        "`",
 																			ignoreCase: false,
+																			want:       "\"`\"",
 																		},
 																		&labeledExpr{
 																			pos:   position{line: 121, col: 13, offset: 2806},
@@ -1804,6 +1939,7 @@ var g = &grammar{
 																			pos:        position{line: 121, col: 31, offset: 2824},
 																			val:        "`",
 																			ignoreCase: false,
+																			want:       "\"`\"",
 																		},
 																	},
 																},
@@ -1824,37 +1960,43 @@ var g = &grammar{
 																					pos: position{line: 112, col: 15, offset: 2499},
 																					exprs: []interface{}{
 																						&choiceExpr{
-																							pos: position{line: 274, col: 5, offset: 7396},
+																							pos: position{line: 276, col: 5, offset: 7537},
 																							alternatives: []interface{}{
 																								&litMatcher{
 																									pos:        position{line: 256, col: 6, offset: 7094},
 																									val:        "if",
 																									ignoreCase: false,
+																									want:       "\"if\"",
 																								},
 																								&litMatcher{
 																									pos:        position{line: 257, col: 8, offset: 7108},
 																									val:        "then",
 																									ignoreCase: false,
+																									want:       "\"then\"",
 																								},
 																								&litMatcher{
 																									pos:        position{line: 258, col: 8, offset: 7124},
 																									val:        "else",
 																									ignoreCase: false,
+																									want:       "\"else\"",
 																								},
 																								&litMatcher{
 																									pos:        position{line: 259, col: 7, offset: 7139},
 																									val:        "let",
 																									ignoreCase: false,
+																									want:       "\"let\"",
 																								},
 																								&litMatcher{
 																									pos:        position{line: 260, col: 6, offset: 7152},
 																									val:        "in",
 																									ignoreCase: false,
+																									want:       "\"in\"",
 																								},
 																								&litMatcher{
 																									pos:        position{line: 262, col: 9, offset: 7179},
 																									val:        "using",
 																									ignoreCase: false,
+																									want:       "\"using\"",
 																								},
 																								&actionExpr{
 																									pos: position{line: 264, col: 11, offset: 7217},
@@ -1863,52 +2005,62 @@ var g = &grammar{
 																										pos:        position{line: 264, col: 11, offset: 7217},
 																										val:        "missing",
 																										ignoreCase: false,
+																										want:       "\"missing\"",
 																									},
 																								},
+																								&litMatcher{
+																									pos:        position{line: 271, col: 10, offset: 7370},
+																									val:        "assert",
+																									ignoreCase: false,
+																									want:       "\"assert\"",
+																								},
 																								&litMatcher{
 																									pos:        position{line: 261, col: 6, offset: 7164},
 																									val:        "as",
 																									ignoreCase: false,
+																									want:       "\"as\"",
 																								},
 																								&litMatcher{
 																									pos:        position{line: 265, col: 8, offset: 7262},
 																									val:        "True",
 																									ignoreCase: false,
+																									want:       "\"True\"",
 																								},
 																								&litMatcher{
 																									pos:        position{line: 266, col: 9, offset: 7279},
 																									val:        "False",
 																									ignoreCase: false,
+																									want:       "\"False\"",
 																								},
 																								&litMatcher{
 																									pos:        position{line: 267, col: 12, offset: 7300},
 																									val:        "Infinity",
 																									ignoreCase: false,
+																									want:       "\"Infinity\"",
 																								},
 																								&litMatcher{
 																									pos:        position{line: 268, col: 7, offset: 7319},
 																									val:        "NaN",
 																									ignoreCase: false,
+																									want:       "\"NaN\"",
 																								},
 																								&litMatcher{
 																									pos:        position{line: 263, col: 9, offset: 7197},
 																									val:        "merge",
 																									ignoreCase: false,
+																									want:       "\"merge\"",
 																								},
 																								&litMatcher{
 																									pos:        position{line: 269, col: 8, offset: 7334},
 																									val:        "Some",
 																									ignoreCase: false,
+																									want:       "\"Some\"",
 																								},
 																								&litMatcher{
 																									pos:        position{line: 270, col: 9, offset: 7351},
 																									val:        "toMap",
 																									ignoreCase: false,
-																								},
-																								&litMatcher{
-																									pos:        position{line: 271, col: 10, offset: 7370},
-																									val:        "assert",
-																									ignoreCase: false,
+																									want:       "\"toMap\"",
 																								},
 																							},
 																						},
@@ -1935,37 +2087,43 @@ var g = &grammar{
 																						&notExpr{
 																							pos: position{line: 113, col: 13, offset: 2571},
 																							expr: &choiceExpr{
-																								pos: position{line: 274, col: 5, offset: 7396},
+																								pos: position{line: 276, col: 5, offset: 7537},
 																								alternatives: []interface{}{
 																									&litMatcher{
 																										pos:        position{line: 256, col: 6, offset: 7094},
 																										val:        "if",
 																										ignoreCase: false,
+																										want:       "\"if\"",
 																									},
 																									&litMatcher{
 																										pos:        position{line: 257, col: 8, offset: 7108},
 																										val:        "then",
 																										ignoreCase: false,
+																										want:       "\"then\"",
 																									},
 																									&litMatcher{
 																										pos:        position{line: 258, col: 8, offset: 7124},
 																										val:        "else",
 																										ignoreCase: false,
+																										want:       "\"else\"",
 																									},
 																									&litMatcher{
 																										pos:        position{line: 259, col: 7, offset: 7139},
 																										val:        "let",
 																										ignoreCase: false,
+																										want:       "\"let\"",
 																									},
 																									&litMatcher{
 																										pos:        position{line: 260, col: 6, offset: 7152},
 																										val:        "in",
 																										ignoreCase: false,
+																										want:       "\"in\"",
 																									},
 																									&litMatcher{
 																										pos:        position{line: 262, col: 9, offset: 7179},
 																										val:        "using",
 																										ignoreCase: false,
+																										want:       "\"using\"",
 																									},
 																									&actionExpr{
 																										pos: position{line: 264, col: 11, offset: 7217},
@@ -1974,52 +2132,62 @@ var g = &grammar{
 																											pos:        position{line: 264, col: 11, offset: 7217},
 																											val:        "missing",
 																											ignoreCase: false,
+																											want:       "\"missing\"",
 																										},
 																									},
 																									&litMatcher{
+																										pos:        position{line: 271, col: 10, offset: 7370},
+																										val:        "assert",
+																										ignoreCase: false,
+																										want:       "\"assert\"",
+																									},
+																									&litMatcher{
 																										pos:        position{line: 261, col: 6, offset: 7164},
 																										val:        "as",
 																										ignoreCase: false,
+																										want:       "\"as\"",
 																									},
 																									&litMatcher{
 																										pos:        position{line: 265, col: 8, offset: 7262},
 																										val:        "True",
 																										ignoreCase: false,
+																										want:       "\"True\"",
 																									},
 																									&litMatcher{
 																										pos:        position{line: 266, col: 9, offset: 7279},
 																										val:        "False",
 																										ignoreCase: false,
+																										want:       "\"False\"",
 																									},
 																									&litMatcher{
 																										pos:        position{line: 267, col: 12, offset: 7300},
 																										val:        "Infinity",
 																										ignoreCase: false,
+																										want:       "\"Infinity\"",
 																									},
 																									&litMatcher{
 																										pos:        position{line: 268, col: 7, offset: 7319},
 																										val:        "NaN",
 																										ignoreCase: false,
+																										want:       "\"NaN\"",
 																									},
 																									&litMatcher{
 																										pos:        position{line: 263, col: 9, offset: 7197},
 																										val:        "merge",
 																										ignoreCase: false,
+																										want:       "\"merge\"",
 																									},
 																									&litMatcher{
 																										pos:        position{line: 269, col: 8, offset: 7334},
 																										val:        "Some",
 																										ignoreCase: false,
+																										want:       "\"Some\"",
 																									},
 																									&litMatcher{
 																										pos:        position{line: 270, col: 9, offset: 7351},
 																										val:        "toMap",
 																										ignoreCase: false,
-																									},
-																									&litMatcher{
-																										pos:        position{line: 271, col: 10, offset: 7370},
-																										val:        "assert",
-																										ignoreCase: false,
+																										want:       "\"toMap\"",
 																									},
 																								},
 																							},
@@ -2060,12 +2228,12 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 326, col: 34, offset: 8660},
+							pos:   position{line: 327, col: 34, offset: 8799},
 							label: "index",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 326, col: 40, offset: 8666},
+								pos: position{line: 327, col: 40, offset: 8805},
 								expr: &ruleRefExpr{
-									pos:  position{line: 326, col: 40, offset: 8666},
+									pos:  position{line: 327, col: 40, offset: 8805},
 									name: "DeBruijn",
 								},
 							},
@@ -2076,12 +2244,12 @@ var g = &grammar{
 		},
 		{
 			name: "Identifier",
-			pos:  position{line: 334, col: 1, offset: 8829},
+			pos:  position{line: 335, col: 1, offset: 8968},
 			expr: &choiceExpr{
-				pos: position{line: 334, col: 14, offset: 8844},
+				pos: position{line: 335, col: 14, offset: 8983},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 334, col: 14, offset: 8844},
+						pos:  position{line: 335, col: 14, offset: 8983},
 						name: "Variable",
 					},
 					&actionExpr{
@@ -2091,6 +2259,7 @@ var g = &grammar{
 							pos:        position{line: 221, col: 5, offset: 5657},
 							val:        "Natural/build",
 							ignoreCase: false,
+							want:       "\"Natural/build\"",
 						},
 					},
 					&actionExpr{
@@ -2100,6 +2269,7 @@ var g = &grammar{
 							pos:        position{line: 222, col: 5, offset: 5706},
 							val:        "Natural/fold",
 							ignoreCase: false,
+							want:       "\"Natural/fold\"",
 						},
 					},
 					&actionExpr{
@@ -2109,6 +2279,7 @@ var g = &grammar{
 							pos:        position{line: 223, col: 5, offset: 5753},
 							val:        "Natural/isZero",
 							ignoreCase: false,
+							want:       "\"Natural/isZero\"",
 						},
 					},
 					&actionExpr{
@@ -2118,6 +2289,7 @@ var g = &grammar{
 							pos:        position{line: 224, col: 5, offset: 5804},
 							val:        "Natural/even",
 							ignoreCase: false,
+							want:       "\"Natural/even\"",
 						},
 					},
 					&actionExpr{
@@ -2127,6 +2299,7 @@ var g = &grammar{
 							pos:        position{line: 225, col: 5, offset: 5851},
 							val:        "Natural/odd",
 							ignoreCase: false,
+							want:       "\"Natural/odd\"",
 						},
 					},
 					&actionExpr{
@@ -2136,6 +2309,7 @@ var g = &grammar{
 							pos:        position{line: 226, col: 5, offset: 5896},
 							val:        "Natural/toInteger",
 							ignoreCase: false,
+							want:       "\"Natural/toInteger\"",
 						},
 					},
 					&actionExpr{
@@ -2145,6 +2319,7 @@ var g = &grammar{
 							pos:        position{line: 227, col: 5, offset: 5953},
 							val:        "Natural/show",
 							ignoreCase: false,
+							want:       "\"Natural/show\"",
 						},
 					},
 					&actionExpr{
@@ -2154,6 +2329,7 @@ var g = &grammar{
 							pos:        position{line: 228, col: 5, offset: 6000},
 							val:        "Natural/subtract",
 							ignoreCase: false,
+							want:       "\"Natural/subtract\"",
 						},
 					},
 					&actionExpr{
@@ -2163,6 +2339,7 @@ var g = &grammar{
 							pos:        position{line: 229, col: 5, offset: 6055},
 							val:        "Integer/toDouble",
 							ignoreCase: false,
+							want:       "\"Integer/toDouble\"",
 						},
 					},
 					&actionExpr{
@@ -2172,6 +2349,7 @@ var g = &grammar{
 							pos:        position{line: 230, col: 5, offset: 6110},
 							val:        "Integer/show",
 							ignoreCase: false,
+							want:       "\"Integer/show\"",
 						},
 					},
 					&actionExpr{
@@ -2181,6 +2359,7 @@ var g = &grammar{
 							pos:        position{line: 231, col: 5, offset: 6157},
 							val:        "Double/show",
 							ignoreCase: false,
+							want:       "\"Double/show\"",
 						},
 					},
 					&actionExpr{
@@ -2190,6 +2369,7 @@ var g = &grammar{
 							pos:        position{line: 232, col: 5, offset: 6202},
 							val:        "List/build",
 							ignoreCase: false,
+							want:       "\"List/build\"",
 						},
 					},
 					&actionExpr{
@@ -2199,6 +2379,7 @@ var g = &grammar{
 							pos:        position{line: 233, col: 5, offset: 6245},
 							val:        "List/fold",
 							ignoreCase: false,
+							want:       "\"List/fold\"",
 						},
 					},
 					&actionExpr{
@@ -2208,6 +2389,7 @@ var g = &grammar{
 							pos:        position{line: 234, col: 5, offset: 6286},
 							val:        "List/length",
 							ignoreCase: false,
+							want:       "\"List/length\"",
 						},
 					},
 					&actionExpr{
@@ -2217,6 +2399,7 @@ var g = &grammar{
 							pos:        position{line: 235, col: 5, offset: 6331},
 							val:        "List/head",
 							ignoreCase: false,
+							want:       "\"List/head\"",
 						},
 					},
 					&actionExpr{
@@ -2226,6 +2409,7 @@ var g = &grammar{
 							pos:        position{line: 236, col: 5, offset: 6372},
 							val:        "List/last",
 							ignoreCase: false,
+							want:       "\"List/last\"",
 						},
 					},
 					&actionExpr{
@@ -2235,6 +2419,7 @@ var g = &grammar{
 							pos:        position{line: 237, col: 5, offset: 6413},
 							val:        "List/indexed",
 							ignoreCase: false,
+							want:       "\"List/indexed\"",
 						},
 					},
 					&actionExpr{
@@ -2244,6 +2429,7 @@ var g = &grammar{
 							pos:        position{line: 238, col: 5, offset: 6460},
 							val:        "List/reverse",
 							ignoreCase: false,
+							want:       "\"List/reverse\"",
 						},
 					},
 					&actionExpr{
@@ -2253,6 +2439,7 @@ var g = &grammar{
 							pos:        position{line: 239, col: 5, offset: 6507},
 							val:        "Optional/build",
 							ignoreCase: false,
+							want:       "\"Optional/build\"",
 						},
 					},
 					&actionExpr{
@@ -2262,6 +2449,7 @@ var g = &grammar{
 							pos:        position{line: 240, col: 5, offset: 6558},
 							val:        "Optional/fold",
 							ignoreCase: false,
+							want:       "\"Optional/fold\"",
 						},
 					},
 					&actionExpr{
@@ -2271,6 +2459,7 @@ var g = &grammar{
 							pos:        position{line: 241, col: 5, offset: 6607},
 							val:        "Text/show",
 							ignoreCase: false,
+							want:       "\"Text/show\"",
 						},
 					},
 					&actionExpr{
@@ -2280,6 +2469,7 @@ var g = &grammar{
 							pos:        position{line: 242, col: 5, offset: 6648},
 							val:        "Bool",
 							ignoreCase: false,
+							want:       "\"Bool\"",
 						},
 					},
 					&actionExpr{
@@ -2289,6 +2479,7 @@ var g = &grammar{
 							pos:        position{line: 243, col: 5, offset: 6680},
 							val:        "True",
 							ignoreCase: false,
+							want:       "\"True\"",
 						},
 					},
 					&actionExpr{
@@ -2298,6 +2489,7 @@ var g = &grammar{
 							pos:        position{line: 244, col: 5, offset: 6712},
 							val:        "False",
 							ignoreCase: false,
+							want:       "\"False\"",
 						},
 					},
 					&actionExpr{
@@ -2307,6 +2499,7 @@ var g = &grammar{
 							pos:        position{line: 245, col: 5, offset: 6746},
 							val:        "Optional",
 							ignoreCase: false,
+							want:       "\"Optional\"",
 						},
 					},
 					&actionExpr{
@@ -2316,6 +2509,7 @@ var g = &grammar{
 							pos:        position{line: 246, col: 5, offset: 6786},
 							val:        "Natural",
 							ignoreCase: false,
+							want:       "\"Natural\"",
 						},
 					},
 					&actionExpr{
@@ -2325,6 +2519,7 @@ var g = &grammar{
 							pos:        position{line: 247, col: 5, offset: 6824},
 							val:        "Integer",
 							ignoreCase: false,
+							want:       "\"Integer\"",
 						},
 					},
 					&actionExpr{
@@ -2334,6 +2529,7 @@ var g = &grammar{
 							pos:        position{line: 248, col: 5, offset: 6862},
 							val:        "Double",
 							ignoreCase: false,
+							want:       "\"Double\"",
 						},
 					},
 					&actionExpr{
@@ -2343,6 +2539,7 @@ var g = &grammar{
 							pos:        position{line: 249, col: 5, offset: 6898},
 							val:        "Text",
 							ignoreCase: false,
+							want:       "\"Text\"",
 						},
 					},
 					&actionExpr{
@@ -2352,6 +2549,7 @@ var g = &grammar{
 							pos:        position{line: 250, col: 5, offset: 6930},
 							val:        "List",
 							ignoreCase: false,
+							want:       "\"List\"",
 						},
 					},
 					&actionExpr{
@@ -2361,6 +2559,7 @@ var g = &grammar{
 							pos:        position{line: 251, col: 5, offset: 6962},
 							val:        "None",
 							ignoreCase: false,
+							want:       "\"None\"",
 						},
 					},
 					&actionExpr{
@@ -2370,6 +2569,7 @@ var g = &grammar{
 							pos:        position{line: 252, col: 5, offset: 6994},
 							val:        "Type",
 							ignoreCase: false,
+							want:       "\"Type\"",
 						},
 					},
 					&actionExpr{
@@ -2379,6 +2579,7 @@ var g = &grammar{
 							pos:        position{line: 253, col: 5, offset: 7026},
 							val:        "Kind",
 							ignoreCase: false,
+							want:       "\"Kind\"",
 						},
 					},
 					&actionExpr{
@@ -2388,6 +2589,7 @@ var g = &grammar{
 							pos:        position{line: 254, col: 5, offset: 7058},
 							val:        "Sort",
 							ignoreCase: false,
+							want:       "\"Sort\"",
 						},
 					},
 				},
@@ -2395,332 +2597,290 @@ var g = &grammar{
 		},
 		{
 			name: "Http",
-			pos:  position{line: 412, col: 1, offset: 10886},
+			pos:  position{line: 434, col: 1, offset: 11710},
 			expr: &actionExpr{
-				pos: position{line: 412, col: 8, offset: 10895},
+				pos: position{line: 434, col: 8, offset: 11719},
 				run: (*parser).callonHttp1,
 				expr: &seqExpr{
-					pos: position{line: 412, col: 8, offset: 10895},
+					pos: position{line: 434, col: 8, offset: 11719},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 412, col: 8, offset: 10895},
+							pos:   position{line: 434, col: 8, offset: 11719},
 							label: "u",
 							expr: &actionExpr{
-								pos: position{line: 378, col: 11, offset: 10077},
+								pos: position{line: 379, col: 11, offset: 10216},
 								run: (*parser).callonHttp4,
 								expr: &seqExpr{
-									pos: position{line: 378, col: 11, offset: 10077},
+									pos: position{line: 379, col: 11, offset: 10216},
 									exprs: []interface{}{
-										&litMatcher{
-											pos:        position{line: 376, col: 10, offset: 10052},
-											val:        "http",
-											ignoreCase: false,
-										},
-										&zeroOrOneExpr{
-											pos: position{line: 376, col: 17, offset: 10059},
-											expr: &litMatcher{
-												pos:        position{line: 376, col: 17, offset: 10059},
-												val:        "s",
-												ignoreCase: false,
-											},
-										},
-										&litMatcher{
-											pos:        position{line: 378, col: 18, offset: 10084},
-											val:        "://",
-											ignoreCase: false,
-										},
-										&zeroOrOneExpr{
-											pos: position{line: 382, col: 13, offset: 10229},
-											expr: &seqExpr{
-												pos: position{line: 382, col: 14, offset: 10230},
-												exprs: []interface{}{
-													&zeroOrMoreExpr{
-														pos: position{line: 384, col: 12, offset: 10276},
-														expr: &choiceExpr{
-															pos: position{line: 384, col: 14, offset: 10278},
-															alternatives: []interface{}{
-																&charClassMatcher{
-																	pos:        position{line: 408, col: 14, offset: 10808},
-																	val:        "[._~-A-Za-z0-9]",
-																	chars:      []rune{'.', '_', '~', '-'},
-																	ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
-																	ignoreCase: false,
-																	inverted:   false,
-																},
-																&seqExpr{
-																	pos: position{line: 406, col: 14, offset: 10774},
-																	exprs: []interface{}{
-																		&litMatcher{
-																			pos:        position{line: 406, col: 14, offset: 10774},
-																			val:        "%",
-																			ignoreCase: false,
-																		},
-																		&choiceExpr{
-																			pos: position{line: 108, col: 10, offset: 2393},
-																			alternatives: []interface{}{
-																				&charClassMatcher{
-																					pos:        position{line: 106, col: 9, offset: 2375},
-																					val:        "[0-9]",
-																					ranges:     []rune{'0', '9'},
-																					ignoreCase: false,
-																					inverted:   false,
-																				},
-																				&charClassMatcher{
-																					pos:        position{line: 108, col: 18, offset: 2401},
-																					val:        "[a-f]i",
-																					ranges:     []rune{'a', 'f'},
-																					ignoreCase: true,
-																					inverted:   false,
-																				},
-																			},
-																		},
-																		&choiceExpr{
-																			pos: position{line: 108, col: 10, offset: 2393},
-																			alternatives: []interface{}{
-																				&charClassMatcher{
-																					pos:        position{line: 106, col: 9, offset: 2375},
-																					val:        "[0-9]",
-																					ranges:     []rune{'0', '9'},
-																					ignoreCase: false,
-																					inverted:   false,
-																				},
-																				&charClassMatcher{
-																					pos:        position{line: 108, col: 18, offset: 2401},
-																					val:        "[a-f]i",
-																					ranges:     []rune{'a', 'f'},
-																					ignoreCase: true,
-																					inverted:   false,
-																				},
-																			},
-																		},
-																	},
-																},
-																&charClassMatcher{
-																	pos:        position{line: 410, col: 13, offset: 10839},
-																	val:        "[!$&\\*+;=:]",
-																	chars:      []rune{'!', '$', '&', '\'', '*', '+', ';', '=', ':'},
-																	ignoreCase: false,
-																	inverted:   false,
-																},
+										&labeledExpr{
+											pos:   position{line: 379, col: 11, offset: 10216},
+											label: "prefix",
+											expr: &actionExpr{
+												pos: position{line: 387, col: 13, offset: 10418},
+												run: (*parser).callonHttp7,
+												expr: &seqExpr{
+													pos: position{line: 387, col: 13, offset: 10418},
+													exprs: []interface{}{
+														&litMatcher{
+															pos:        position{line: 377, col: 10, offset: 10191},
+															val:        "http",
+															ignoreCase: false,
+															want:       "\"http\"",
+														},
+														&zeroOrOneExpr{
+															pos: position{line: 377, col: 17, offset: 10198},
+															expr: &litMatcher{
+																pos:        position{line: 377, col: 17, offset: 10198},
+																val:        "s",
+																ignoreCase: false,
+																want:       "\"s\"",
 															},
 														},
-													},
-													&litMatcher{
-														pos:        position{line: 382, col: 23, offset: 10239},
-														val:        "@",
-														ignoreCase: false,
-													},
-												},
-											},
-										},
-										&choiceExpr{
-											pos: position{line: 386, col: 8, offset: 10333},
-											alternatives: []interface{}{
-												&seqExpr{
-													pos: position{line: 390, col: 13, offset: 10385},
-													exprs: []interface{}{
 														&litMatcher{
-															pos:        position{line: 390, col: 13, offset: 10385},
-															val:        "[",
+															pos:        position{line: 387, col: 20, offset: 10425},
+															val:        "://",
 															ignoreCase: false,
+															want:       "\"://\"",
 														},
-														&actionExpr{
-															pos: position{line: 392, col: 15, offset: 10422},
-															run: (*parser).callonHttp28,
+														&zeroOrOneExpr{
+															pos: position{line: 404, col: 13, offset: 11053},
 															expr: &seqExpr{
-																pos: position{line: 392, col: 15, offset: 10422},
+																pos: position{line: 404, col: 14, offset: 11054},
 																exprs: []interface{}{
 																	&zeroOrMoreExpr{
-																		pos: position{line: 392, col: 15, offset: 10422},
+																		pos: position{line: 406, col: 12, offset: 11100},
 																		expr: &choiceExpr{
-																			pos: position{line: 108, col: 10, offset: 2393},
+																			pos: position{line: 406, col: 14, offset: 11102},
 																			alternatives: []interface{}{
 																				&charClassMatcher{
-																					pos:        position{line: 106, col: 9, offset: 2375},
-																					val:        "[0-9]",
-																					ranges:     []rune{'0', '9'},
+																					pos:        position{line: 430, col: 14, offset: 11632},
+																					val:        "[._~-A-Za-z0-9]",
+																					chars:      []rune{'.', '_', '~', '-'},
+																					ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
 																					ignoreCase: false,
 																					inverted:   false,
 																				},
+																				&seqExpr{
+																					pos: position{line: 428, col: 14, offset: 11598},
+																					exprs: []interface{}{
+																						&litMatcher{
+																							pos:        position{line: 428, col: 14, offset: 11598},
+																							val:        "%",
+																							ignoreCase: false,
+																							want:       "\"%\"",
+																						},
+																						&choiceExpr{
+																							pos: position{line: 108, col: 10, offset: 2393},
+																							alternatives: []interface{}{
+																								&charClassMatcher{
+																									pos:        position{line: 106, col: 9, offset: 2375},
+																									val:        "[0-9]",
+																									ranges:     []rune{'0', '9'},
+																									ignoreCase: false,
+																									inverted:   false,
+																								},
+																								&charClassMatcher{
+																									pos:        position{line: 108, col: 18, offset: 2401},
+																									val:        "[a-f]i",
+																									ranges:     []rune{'a', 'f'},
+																									ignoreCase: true,
+																									inverted:   false,
+																								},
+																							},
+																						},
+																						&choiceExpr{
+																							pos: position{line: 108, col: 10, offset: 2393},
+																							alternatives: []interface{}{
+																								&charClassMatcher{
+																									pos:        position{line: 106, col: 9, offset: 2375},
+																									val:        "[0-9]",
+																									ranges:     []rune{'0', '9'},
+																									ignoreCase: false,
+																									inverted:   false,
+																								},
+																								&charClassMatcher{
+																									pos:        position{line: 108, col: 18, offset: 2401},
+																									val:        "[a-f]i",
+																									ranges:     []rune{'a', 'f'},
+																									ignoreCase: true,
+																									inverted:   false,
+																								},
+																							},
+																						},
+																					},
+																				},
 																				&charClassMatcher{
-																					pos:        position{line: 108, col: 18, offset: 2401},
-																					val:        "[a-f]i",
-																					ranges:     []rune{'a', 'f'},
-																					ignoreCase: true,
+																					pos:        position{line: 432, col: 13, offset: 11663},
+																					val:        "[!$&\\*+;=:]",
+																					chars:      []rune{'!', '$', '&', '\'', '*', '+', ';', '=', ':'},
+																					ignoreCase: false,
 																					inverted:   false,
 																				},
 																			},
 																		},
 																	},
 																	&litMatcher{
-																		pos:        position{line: 392, col: 25, offset: 10432},
-																		val:        ":",
+																		pos:        position{line: 404, col: 23, offset: 11063},
+																		val:        "@",
 																		ignoreCase: false,
-																	},
-																	&zeroOrMoreExpr{
-																		pos: position{line: 392, col: 29, offset: 10436},
-																		expr: &choiceExpr{
-																			pos: position{line: 392, col: 30, offset: 10437},
-																			alternatives: []interface{}{
-																				&charClassMatcher{
-																					pos:        position{line: 106, col: 9, offset: 2375},
-																					val:        "[0-9]",
-																					ranges:     []rune{'0', '9'},
-																					ignoreCase: false,
-																					inverted:   false,
-																				},
-																				&charClassMatcher{
-																					pos:        position{line: 108, col: 18, offset: 2401},
-																					val:        "[a-f]i",
-																					ranges:     []rune{'a', 'f'},
-																					ignoreCase: true,
-																					inverted:   false,
-																				},
-																				&charClassMatcher{
-																					pos:        position{line: 392, col: 39, offset: 10446},
-																					val:        "[:.]",
-																					chars:      []rune{':', '.'},
-																					ignoreCase: false,
-																					inverted:   false,
-																				},
-																			},
-																		},
+																		want:       "\"@\"",
 																	},
 																},
 															},
 														},
-														&litMatcher{
-															pos:        position{line: 390, col: 29, offset: 10401},
-															val:        "]",
-															ignoreCase: false,
-														},
-													},
-												},
-												&zeroOrMoreExpr{
-													pos: position{line: 398, col: 11, offset: 10618},
-													expr: &choiceExpr{
-														pos: position{line: 398, col: 12, offset: 10619},
-														alternatives: []interface{}{
-															&charClassMatcher{
-																pos:        position{line: 408, col: 14, offset: 10808},
-																val:        "[._~-A-Za-z0-9]",
-																chars:      []rune{'.', '_', '~', '-'},
-																ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
-																ignoreCase: false,
-																inverted:   false,
-															},
-															&seqExpr{
-																pos: position{line: 406, col: 14, offset: 10774},
-																exprs: []interface{}{
-																	&litMatcher{
-																		pos:        position{line: 406, col: 14, offset: 10774},
-																		val:        "%",
-																		ignoreCase: false,
-																	},
-																	&choiceExpr{
-																		pos: position{line: 108, col: 10, offset: 2393},
-																		alternatives: []interface{}{
-																			&charClassMatcher{
-																				pos:        position{line: 106, col: 9, offset: 2375},
-																				val:        "[0-9]",
-																				ranges:     []rune{'0', '9'},
-																				ignoreCase: false,
-																				inverted:   false,
-																			},
-																			&charClassMatcher{
-																				pos:        position{line: 108, col: 18, offset: 2401},
-																				val:        "[a-f]i",
-																				ranges:     []rune{'a', 'f'},
-																				ignoreCase: true,
-																				inverted:   false,
+														&choiceExpr{
+															pos: position{line: 408, col: 8, offset: 11157},
+															alternatives: []interface{}{
+																&seqExpr{
+																	pos: position{line: 412, col: 13, offset: 11209},
+																	exprs: []interface{}{
+																		&litMatcher{
+																			pos:        position{line: 412, col: 13, offset: 11209},
+																			val:        "[",
+																			ignoreCase: false,
+																			want:       "\"[\"",
+																		},
+																		&actionExpr{
+																			pos: position{line: 414, col: 15, offset: 11246},
+																			run: (*parser).callonHttp31,
+																			expr: &seqExpr{
+																				pos: position{line: 414, col: 15, offset: 11246},
+																				exprs: []interface{}{
+																					&zeroOrMoreExpr{
+																						pos: position{line: 414, col: 15, offset: 11246},
+																						expr: &choiceExpr{
+																							pos: position{line: 108, col: 10, offset: 2393},
+																							alternatives: []interface{}{
+																								&charClassMatcher{
+																									pos:        position{line: 106, col: 9, offset: 2375},
+																									val:        "[0-9]",
+																									ranges:     []rune{'0', '9'},
+																									ignoreCase: false,
+																									inverted:   false,
+																								},
+																								&charClassMatcher{
+																									pos:        position{line: 108, col: 18, offset: 2401},
+																									val:        "[a-f]i",
+																									ranges:     []rune{'a', 'f'},
+																									ignoreCase: true,
+																									inverted:   false,
+																								},
+																							},
+																						},
+																					},
+																					&litMatcher{
+																						pos:        position{line: 414, col: 25, offset: 11256},
+																						val:        ":",
+																						ignoreCase: false,
+																						want:       "\":\"",
+																					},
+																					&zeroOrMoreExpr{
+																						pos: position{line: 414, col: 29, offset: 11260},
+																						expr: &choiceExpr{
+																							pos: position{line: 414, col: 30, offset: 11261},
+																							alternatives: []interface{}{
+																								&charClassMatcher{
+																									pos:        position{line: 106, col: 9, offset: 2375},
+																									val:        "[0-9]",
+																									ranges:     []rune{'0', '9'},
+																									ignoreCase: false,
+																									inverted:   false,
+																								},
+																								&charClassMatcher{
+																									pos:        position{line: 108, col: 18, offset: 2401},
+																									val:        "[a-f]i",
+																									ranges:     []rune{'a', 'f'},
+																									ignoreCase: true,
+																									inverted:   false,
+																								},
+																								&charClassMatcher{
+																									pos:        position{line: 414, col: 39, offset: 11270},
+																									val:        "[:.]",
+																									chars:      []rune{':', '.'},
+																									ignoreCase: false,
+																									inverted:   false,
+																								},
+																							},
+																						},
+																					},
+																				},
 																			},
 																		},
+																		&litMatcher{
+																			pos:        position{line: 412, col: 29, offset: 11225},
+																			val:        "]",
+																			ignoreCase: false,
+																			want:       "\"]\"",
+																		},
 																	},
-																	&choiceExpr{
-																		pos: position{line: 108, col: 10, offset: 2393},
+																},
+																&zeroOrMoreExpr{
+																	pos: position{line: 420, col: 11, offset: 11442},
+																	expr: &choiceExpr{
+																		pos: position{line: 420, col: 12, offset: 11443},
 																		alternatives: []interface{}{
 																			&charClassMatcher{
-																				pos:        position{line: 106, col: 9, offset: 2375},
-																				val:        "[0-9]",
-																				ranges:     []rune{'0', '9'},
+																				pos:        position{line: 430, col: 14, offset: 11632},
+																				val:        "[._~-A-Za-z0-9]",
+																				chars:      []rune{'.', '_', '~', '-'},
+																				ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
 																				ignoreCase: false,
 																				inverted:   false,
 																			},
-																			&charClassMatcher{
-																				pos:        position{line: 108, col: 18, offset: 2401},
-																				val:        "[a-f]i",
-																				ranges:     []rune{'a', 'f'},
-																				ignoreCase: true,
-																				inverted:   false,
-																			},
-																		},
-																	},
-																},
-															},
-															&charClassMatcher{
-																pos:        position{line: 410, col: 13, offset: 10839},
-																val:        "[!$&\\*+;=]",
-																chars:      []rune{'!', '$', '&', '\'', '*', '+', ';', '='},
-																ignoreCase: false,
-																inverted:   false,
-															},
-														},
-													},
-												},
-											},
-										},
-										&zeroOrOneExpr{
-											pos: position{line: 382, col: 34, offset: 10250},
-											expr: &seqExpr{
-												pos: position{line: 382, col: 35, offset: 10251},
-												exprs: []interface{}{
-													&litMatcher{
-														pos:        position{line: 382, col: 35, offset: 10251},
-														val:        ":",
-														ignoreCase: false,
-													},
-													&zeroOrMoreExpr{
-														pos: position{line: 388, col: 8, offset: 10363},
-														expr: &charClassMatcher{
-															pos:        position{line: 106, col: 9, offset: 2375},
-															val:        "[0-9]",
-															ranges:     []rune{'0', '9'},
-															ignoreCase: false,
-															inverted:   false,
-														},
-													},
-												},
-											},
-										},
-										&zeroOrMoreExpr{
-											pos: position{line: 380, col: 11, offset: 10183},
-											expr: &choiceExpr{
-												pos: position{line: 380, col: 12, offset: 10184},
-												alternatives: []interface{}{
-													&actionExpr{
-														pos: position{line: 357, col: 17, offset: 9296},
-														run: (*parser).callonHttp60,
-														expr: &seqExpr{
-															pos: position{line: 357, col: 17, offset: 9296},
-															exprs: []interface{}{
-																&litMatcher{
-																	pos:        position{line: 357, col: 17, offset: 9296},
-																	val:        "/",
-																	ignoreCase: false,
-																},
-																&labeledExpr{
-																	pos:   position{line: 357, col: 21, offset: 9300},
-																	label: "u",
-																	expr: &actionExpr{
-																		pos: position{line: 354, col: 25, offset: 9155},
-																		run: (*parser).callonHttp64,
-																		expr: &oneOrMoreExpr{
-																			pos: position{line: 354, col: 25, offset: 9155},
-																			expr: &charClassMatcher{
-																				pos:        position{line: 338, col: 6, offset: 8900},
-																				val:        "[!=|~$-\\*-+--.0-;@-Z^-z]",
-																				chars:      []rune{'!', '=', '|', '~'},
-																				ranges:     []rune{'$', '\'', '*', '+', '-', '.', '0', ';', '@', 'Z', '^', 'z'},
+																			&seqExpr{
+																				pos: position{line: 428, col: 14, offset: 11598},
+																				exprs: []interface{}{
+																					&litMatcher{
+																						pos:        position{line: 428, col: 14, offset: 11598},
+																						val:        "%",
+																						ignoreCase: false,
+																						want:       "\"%\"",
+																					},
+																					&choiceExpr{
+																						pos: position{line: 108, col: 10, offset: 2393},
+																						alternatives: []interface{}{
+																							&charClassMatcher{
+																								pos:        position{line: 106, col: 9, offset: 2375},
+																								val:        "[0-9]",
+																								ranges:     []rune{'0', '9'},
+																								ignoreCase: false,
+																								inverted:   false,
+																							},
+																							&charClassMatcher{
+																								pos:        position{line: 108, col: 18, offset: 2401},
+																								val:        "[a-f]i",
+																								ranges:     []rune{'a', 'f'},
+																								ignoreCase: true,
+																								inverted:   false,
+																							},
+																						},
+																					},
+																					&choiceExpr{
+																						pos: position{line: 108, col: 10, offset: 2393},
+																						alternatives: []interface{}{
+																							&charClassMatcher{
+																								pos:        position{line: 106, col: 9, offset: 2375},
+																								val:        "[0-9]",
+																								ranges:     []rune{'0', '9'},
+																								ignoreCase: false,
+																								inverted:   false,
+																							},
+																							&charClassMatcher{
+																								pos:        position{line: 108, col: 18, offset: 2401},
+																								val:        "[a-f]i",
+																								ranges:     []rune{'a', 'f'},
+																								ignoreCase: true,
+																								inverted:   false,
+																							},
+																						},
+																					},
+																				},
+																			},
+																			&charClassMatcher{
+																				pos:        position{line: 432, col: 13, offset: 11663},
+																				val:        "[!$&\\*+;=]",
+																				chars:      []rune{'!', '$', '&', '\'', '*', '+', ';', '='},
 																				ignoreCase: false,
 																				inverted:   false,
 																			},
@@ -2729,60 +2889,235 @@ var g = &grammar{
 																},
 															},
 														},
+														&zeroOrOneExpr{
+															pos: position{line: 404, col: 34, offset: 11074},
+															expr: &seqExpr{
+																pos: position{line: 404, col: 35, offset: 11075},
+																exprs: []interface{}{
+																	&litMatcher{
+																		pos:        position{line: 404, col: 35, offset: 11075},
+																		val:        ":",
+																		ignoreCase: false,
+																		want:       "\":\"",
+																	},
+																	&zeroOrMoreExpr{
+																		pos: position{line: 410, col: 8, offset: 11187},
+																		expr: &charClassMatcher{
+																			pos:        position{line: 106, col: 9, offset: 2375},
+																			val:        "[0-9]",
+																			ranges:     []rune{'0', '9'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																},
+															},
+														},
 													},
-													&actionExpr{
-														pos: position{line: 358, col: 17, offset: 9358},
-														run: (*parser).callonHttp67,
-														expr: &seqExpr{
-															pos: position{line: 358, col: 17, offset: 9358},
-															exprs: []interface{}{
-																&litMatcher{
-																	pos:        position{line: 358, col: 17, offset: 9358},
-																	val:        "/\"",
-																	ignoreCase: false,
+												},
+											},
+										},
+										&labeledExpr{
+											pos:   position{line: 379, col: 28, offset: 10233},
+											label: "path",
+											expr: &actionExpr{
+												pos: position{line: 391, col: 11, offset: 10540},
+												run: (*parser).callonHttp62,
+												expr: &labeledExpr{
+													pos:   position{line: 391, col: 11, offset: 10540},
+													label: "cs",
+													expr: &zeroOrMoreExpr{
+														pos: position{line: 391, col: 14, offset: 10543},
+														expr: &choiceExpr{
+															pos: position{line: 400, col: 20, offset: 10817},
+															alternatives: []interface{}{
+																&actionExpr{
+																	pos: position{line: 400, col: 20, offset: 10817},
+																	run: (*parser).callonHttp66,
+																	expr: &seqExpr{
+																		pos: position{line: 400, col: 20, offset: 10817},
+																		exprs: []interface{}{
+																			&litMatcher{
+																				pos:        position{line: 400, col: 20, offset: 10817},
+																				val:        "/",
+																				ignoreCase: false,
+																				want:       "\"/\"",
+																			},
+																			&actionExpr{
+																				pos: position{line: 355, col: 25, offset: 9294},
+																				run: (*parser).callonHttp69,
+																				expr: &oneOrMoreExpr{
+																					pos: position{line: 355, col: 25, offset: 9294},
+																					expr: &charClassMatcher{
+																						pos:        position{line: 339, col: 6, offset: 9039},
+																						val:        "[!=|~$-\\*-+--.0-;@-Z^-z]",
+																						chars:      []rune{'!', '=', '|', '~'},
+																						ranges:     []rune{'$', '\'', '*', '+', '-', '.', '0', ';', '@', 'Z', '^', 'z'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																			},
+																		},
+																	},
 																},
-																&labeledExpr{
-																	pos:   position{line: 358, col: 25, offset: 9366},
-																	label: "q",
-																	expr: &actionExpr{
-																		pos: position{line: 355, col: 23, offset: 9225},
-																		run: (*parser).callonHttp71,
-																		expr: &oneOrMoreExpr{
-																			pos: position{line: 355, col: 23, offset: 9225},
-																			expr: &charClassMatcher{
-																				pos:        position{line: 349, col: 6, offset: 9063},
-																				val:        "[𐀀D -!#-.0-\\u007f\\u0080-\\ud7ff\\ue000-�𐀀-\\U0001fffd𠀀-\\U0002fffd\\U00030000-\\U0003fffd\\U00040000-\\U0004fffd\\U00050000-\\U0005fffd\\U00060000-\\U0006fffd\\U00070000-\\U0007fffd\\U00080000-\\U0008fffd\\U00090000-\\U0009fffd\\U000a0000-\\U000afffd\\U000b0000-\\U000bfffd\\U000c0000-\\U000cfffd\\U000d0000-\\U000dfffd\\U000e0000-\\U000efffd\\U000f0000-\\U000ffffd0-\\U00010fff]",
-																				chars:      []rune{'𐀀', 'D'},
-																				ranges:     []rune{' ', '!', '#', '.', '0', '\u007f', '\u0080', '\ud7ff', '\ue000', '�', '𐀀', '\U0001fffd', '𠀀', '\U0002fffd', '\U00030000', '\U0003fffd', '\U00040000', '\U0004fffd', '\U00050000', '\U0005fffd', '\U00060000', '\U0006fffd', '\U00070000', '\U0007fffd', '\U00080000', '\U0008fffd', '\U00090000', '\U0009fffd', '\U000a0000', '\U000afffd', '\U000b0000', '\U000bfffd', '\U000c0000', '\U000cfffd', '\U000d0000', '\U000dfffd', '\U000e0000', '\U000efffd', '\U000f0000', '\U000ffffd', '0', '\U00010fff'},
+																&actionExpr{
+																	pos: position{line: 401, col: 20, offset: 10893},
+																	run: (*parser).callonHttp72,
+																	expr: &seqExpr{
+																		pos: position{line: 401, col: 20, offset: 10893},
+																		exprs: []interface{}{
+																			&litMatcher{
+																				pos:        position{line: 401, col: 20, offset: 10893},
+																				val:        "/\"",
 																				ignoreCase: false,
-																				inverted:   false,
+																				want:       "\"/\\\"\"",
+																			},
+																			&labeledExpr{
+																				pos:   position{line: 401, col: 28, offset: 10901},
+																				label: "q",
+																				expr: &actionExpr{
+																					pos: position{line: 356, col: 23, offset: 9364},
+																					run: (*parser).callonHttp76,
+																					expr: &oneOrMoreExpr{
+																						pos: position{line: 356, col: 23, offset: 9364},
+																						expr: &charClassMatcher{
+																							pos:        position{line: 350, col: 6, offset: 9202},
+																							val:        "[𐀀D -!#-.0-\\x7f\\u0080-\\ud7ff\\ue000-�𐀀-\\U0001fffd𠀀-\\U0002fffd𰀀-\\U0003fffd\\U00040000-\\U0004fffd\\U00050000-\\U0005fffd\\U00060000-\\U0006fffd\\U00070000-\\U0007fffd\\U00080000-\\U0008fffd\\U00090000-\\U0009fffd\\U000a0000-\\U000afffd\\U000b0000-\\U000bfffd\\U000c0000-\\U000cfffd\\U000d0000-\\U000dfffd\\U000e0000-\\U000efffd\\U000f0000-\\U000ffffd0-\\U00010fff]",
+																							chars:      []rune{'𐀀', 'D'},
+																							ranges:     []rune{' ', '!', '#', '.', '0', '\x7f', '\u0080', '\ud7ff', '\ue000', '�', '𐀀', '\U0001fffd', '𠀀', '\U0002fffd', '𰀀', '\U0003fffd', '\U00040000', '\U0004fffd', '\U00050000', '\U0005fffd', '\U00060000', '\U0006fffd', '\U00070000', '\U0007fffd', '\U00080000', '\U0008fffd', '\U00090000', '\U0009fffd', '\U000a0000', '\U000afffd', '\U000b0000', '\U000bfffd', '\U000c0000', '\U000cfffd', '\U000d0000', '\U000dfffd', '\U000e0000', '\U000efffd', '\U000f0000', '\U000ffffd', '0', '\U00010fff'},
+																							ignoreCase: false,
+																							inverted:   false,
+																						},
+																					},
+																				},
+																			},
+																			&litMatcher{
+																				pos:        position{line: 401, col: 50, offset: 10923},
+																				val:        "\"",
+																				ignoreCase: false,
+																				want:       "\"\\\"\"",
 																			},
 																		},
 																	},
 																},
-																&litMatcher{
-																	pos:        position{line: 358, col: 47, offset: 9388},
-																	val:        "\"",
-																	ignoreCase: false,
+																&actionExpr{
+																	pos: position{line: 402, col: 20, offset: 10995},
+																	run: (*parser).callonHttp80,
+																	expr: &seqExpr{
+																		pos: position{line: 402, col: 20, offset: 10995},
+																		exprs: []interface{}{
+																			&litMatcher{
+																				pos:        position{line: 402, col: 20, offset: 10995},
+																				val:        "/",
+																				ignoreCase: false,
+																				want:       "\"/\"",
+																			},
+																			&zeroOrMoreExpr{
+																				pos: position{line: 422, col: 11, offset: 11494},
+																				expr: &choiceExpr{
+																					pos: position{line: 424, col: 9, offset: 11512},
+																					alternatives: []interface{}{
+																						&charClassMatcher{
+																							pos:        position{line: 430, col: 14, offset: 11632},
+																							val:        "[._~-A-Za-z0-9]",
+																							chars:      []rune{'.', '_', '~', '-'},
+																							ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
+																							ignoreCase: false,
+																							inverted:   false,
+																						},
+																						&seqExpr{
+																							pos: position{line: 428, col: 14, offset: 11598},
+																							exprs: []interface{}{
+																								&litMatcher{
+																									pos:        position{line: 428, col: 14, offset: 11598},
+																									val:        "%",
+																									ignoreCase: false,
+																									want:       "\"%\"",
+																								},
+																								&choiceExpr{
+																									pos: position{line: 108, col: 10, offset: 2393},
+																									alternatives: []interface{}{
+																										&charClassMatcher{
+																											pos:        position{line: 106, col: 9, offset: 2375},
+																											val:        "[0-9]",
+																											ranges:     []rune{'0', '9'},
+																											ignoreCase: false,
+																											inverted:   false,
+																										},
+																										&charClassMatcher{
+																											pos:        position{line: 108, col: 18, offset: 2401},
+																											val:        "[a-f]i",
+																											ranges:     []rune{'a', 'f'},
+																											ignoreCase: true,
+																											inverted:   false,
+																										},
+																									},
+																								},
+																								&choiceExpr{
+																									pos: position{line: 108, col: 10, offset: 2393},
+																									alternatives: []interface{}{
+																										&charClassMatcher{
+																											pos:        position{line: 106, col: 9, offset: 2375},
+																											val:        "[0-9]",
+																											ranges:     []rune{'0', '9'},
+																											ignoreCase: false,
+																											inverted:   false,
+																										},
+																										&charClassMatcher{
+																											pos:        position{line: 108, col: 18, offset: 2401},
+																											val:        "[a-f]i",
+																											ranges:     []rune{'a', 'f'},
+																											ignoreCase: true,
+																											inverted:   false,
+																										},
+																									},
+																								},
+																							},
+																						},
+																						&charClassMatcher{
+																							pos:        position{line: 432, col: 13, offset: 11663},
+																							val:        "[!$&\\*+;=:@]",
+																							chars:      []rune{'!', '$', '&', '\'', '*', '+', ';', '=', ':', '@'},
+																							ignoreCase: false,
+																							inverted:   false,
+																						},
+																					},
+																				},
+																			},
+																		},
+																	},
 																},
 															},
 														},
 													},
-													&seqExpr{
-														pos: position{line: 380, col: 28, offset: 10200},
+												},
+											},
+										},
+										&labeledExpr{
+											pos:   position{line: 379, col: 41, offset: 10246},
+											label: "query",
+											expr: &zeroOrOneExpr{
+												pos: position{line: 379, col: 47, offset: 10252},
+												expr: &actionExpr{
+													pos: position{line: 389, col: 12, offset: 10486},
+													run: (*parser).callonHttp97,
+													expr: &seqExpr{
+														pos: position{line: 389, col: 12, offset: 10486},
 														exprs: []interface{}{
 															&litMatcher{
-																pos:        position{line: 380, col: 28, offset: 10200},
-																val:        "/",
+																pos:        position{line: 389, col: 12, offset: 10486},
+																val:        "?",
 																ignoreCase: false,
+																want:       "\"?\"",
 															},
 															&zeroOrMoreExpr{
-																pos: position{line: 400, col: 11, offset: 10670},
+																pos: position{line: 426, col: 9, offset: 11566},
 																expr: &choiceExpr{
-																	pos: position{line: 402, col: 9, offset: 10688},
+																	pos: position{line: 426, col: 10, offset: 11567},
 																	alternatives: []interface{}{
 																		&charClassMatcher{
-																			pos:        position{line: 408, col: 14, offset: 10808},
+																			pos:        position{line: 430, col: 14, offset: 11632},
 																			val:        "[._~-A-Za-z0-9]",
 																			chars:      []rune{'.', '_', '~', '-'},
 																			ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -2790,12 +3125,13 @@ var g = &grammar{
 																			inverted:   false,
 																		},
 																		&seqExpr{
-																			pos: position{line: 406, col: 14, offset: 10774},
+																			pos: position{line: 428, col: 14, offset: 11598},
 																			exprs: []interface{}{
 																				&litMatcher{
-																					pos:        position{line: 406, col: 14, offset: 10774},
+																					pos:        position{line: 428, col: 14, offset: 11598},
 																					val:        "%",
 																					ignoreCase: false,
+																					want:       "\"%\"",
 																				},
 																				&choiceExpr{
 																					pos: position{line: 108, col: 10, offset: 2393},
@@ -2838,9 +3174,9 @@ var g = &grammar{
 																			},
 																		},
 																		&charClassMatcher{
-																			pos:        position{line: 410, col: 13, offset: 10839},
-																			val:        "[!$&\\*+;=:@]",
-																			chars:      []rune{'!', '$', '&', '\'', '*', '+', ';', '=', ':', '@'},
+																			pos:        position{line: 432, col: 13, offset: 11663},
+																			val:        "[!$&\\*+;=:@/?]",
+																			chars:      []rune{'!', '$', '&', '\'', '*', '+', ';', '=', ':', '@', '/', '?'},
 																			ignoreCase: false,
 																			inverted:   false,
 																		},
@@ -2852,117 +3188,34 @@ var g = &grammar{
 												},
 											},
 										},
-										&zeroOrOneExpr{
-											pos: position{line: 378, col: 42, offset: 10108},
-											expr: &seqExpr{
-												pos: position{line: 378, col: 44, offset: 10110},
-												exprs: []interface{}{
-													&litMatcher{
-														pos:        position{line: 378, col: 44, offset: 10110},
-														val:        "?",
-														ignoreCase: false,
-													},
-													&zeroOrMoreExpr{
-														pos: position{line: 404, col: 9, offset: 10742},
-														expr: &choiceExpr{
-															pos: position{line: 404, col: 10, offset: 10743},
-															alternatives: []interface{}{
-																&charClassMatcher{
-																	pos:        position{line: 408, col: 14, offset: 10808},
-																	val:        "[._~-A-Za-z0-9]",
-																	chars:      []rune{'.', '_', '~', '-'},
-																	ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
-																	ignoreCase: false,
-																	inverted:   false,
-																},
-																&seqExpr{
-																	pos: position{line: 406, col: 14, offset: 10774},
-																	exprs: []interface{}{
-																		&litMatcher{
-																			pos:        position{line: 406, col: 14, offset: 10774},
-																			val:        "%",
-																			ignoreCase: false,
-																		},
-																		&choiceExpr{
-																			pos: position{line: 108, col: 10, offset: 2393},
-																			alternatives: []interface{}{
-																				&charClassMatcher{
-																					pos:        position{line: 106, col: 9, offset: 2375},
-																					val:        "[0-9]",
-																					ranges:     []rune{'0', '9'},
-																					ignoreCase: false,
-																					inverted:   false,
-																				},
-																				&charClassMatcher{
-																					pos:        position{line: 108, col: 18, offset: 2401},
-																					val:        "[a-f]i",
-																					ranges:     []rune{'a', 'f'},
-																					ignoreCase: true,
-																					inverted:   false,
-																				},
-																			},
-																		},
-																		&choiceExpr{
-																			pos: position{line: 108, col: 10, offset: 2393},
-																			alternatives: []interface{}{
-																				&charClassMatcher{
-																					pos:        position{line: 106, col: 9, offset: 2375},
-																					val:        "[0-9]",
-																					ranges:     []rune{'0', '9'},
-																					ignoreCase: false,
-																					inverted:   false,
-																				},
-																				&charClassMatcher{
-																					pos:        position{line: 108, col: 18, offset: 2401},
-																					val:        "[a-f]i",
-																					ranges:     []rune{'a', 'f'},
-																					ignoreCase: true,
-																					inverted:   false,
-																				},
-																			},
-																		},
-																	},
-																},
-																&charClassMatcher{
-																	pos:        position{line: 410, col: 13, offset: 10839},
-																	val:        "[!$&\\*+;=:@/?]",
-																	chars:      []rune{'!', '$', '&', '\'', '*', '+', ';', '=', ':', '@', '/', '?'},
-																	ignoreCase: false,
-																	inverted:   false,
-																},
-															},
-														},
-													},
-												},
-											},
-										},
 									},
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 412, col: 18, offset: 10905},
+							pos:   position{line: 434, col: 18, offset: 11729},
 							label: "usingClause",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 412, col: 30, offset: 10917},
+								pos: position{line: 434, col: 30, offset: 11741},
 								expr: &seqExpr{
-									pos: position{line: 412, col: 32, offset: 10919},
+									pos: position{line: 434, col: 32, offset: 11743},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 412, col: 32, offset: 10919},
+											pos:  position{line: 434, col: 32, offset: 11743},
 											name: "_",
 										},
 										&litMatcher{
 											pos:        position{line: 262, col: 9, offset: 7179},
 											val:        "using",
 											ignoreCase: false,
+											want:       "\"using\"",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 412, col: 40, offset: 10927},
+											pos:  position{line: 434, col: 40, offset: 11751},
 											name: "_1",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 412, col: 43, offset: 10930},
+											pos:  position{line: 434, col: 43, offset: 11754},
 											name: "ImportExpression",
 										},
 									},
@@ -2975,9 +3228,9 @@ var g = &grammar{
 		},
 		{
 			name: "ImportType",
-			pos:  position{line: 453, col: 1, offset: 12118},
+			pos:  position{line: 475, col: 1, offset: 12942},
 			expr: &choiceExpr{
-				pos: position{line: 453, col: 14, offset: 12133},
+				pos: position{line: 475, col: 14, offset: 12957},
 				alternatives: []interface{}{
 					&actionExpr{
 						pos: position{line: 264, col: 11, offset: 7217},
@@ -2986,54 +3239,57 @@ var g = &grammar{
 							pos:        position{line: 264, col: 11, offset: 7217},
 							val:        "missing",
 							ignoreCase: false,
+							want:       "\"missing\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 371, col: 14, offset: 9771},
+						pos: position{line: 372, col: 14, offset: 9910},
 						run: (*parser).callonImportType4,
 						expr: &seqExpr{
-							pos: position{line: 371, col: 14, offset: 9771},
+							pos: position{line: 372, col: 14, offset: 9910},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 371, col: 14, offset: 9771},
+									pos:        position{line: 372, col: 14, offset: 9910},
 									val:        "..",
 									ignoreCase: false,
+									want:       "\"..\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 371, col: 19, offset: 9776},
+									pos:   position{line: 372, col: 19, offset: 9915},
 									label: "p",
 									expr: &actionExpr{
-										pos: position{line: 360, col: 8, offset: 9420},
+										pos: position{line: 361, col: 8, offset: 9559},
 										run: (*parser).callonImportType8,
 										expr: &labeledExpr{
-											pos:   position{line: 360, col: 8, offset: 9420},
+											pos:   position{line: 361, col: 8, offset: 9559},
 											label: "cs",
 											expr: &oneOrMoreExpr{
-												pos: position{line: 360, col: 11, offset: 9423},
+												pos: position{line: 361, col: 11, offset: 9562},
 												expr: &choiceExpr{
-													pos: position{line: 357, col: 17, offset: 9296},
+													pos: position{line: 358, col: 17, offset: 9435},
 													alternatives: []interface{}{
 														&actionExpr{
-															pos: position{line: 357, col: 17, offset: 9296},
+															pos: position{line: 358, col: 17, offset: 9435},
 															run: (*parser).callonImportType12,
 															expr: &seqExpr{
-																pos: position{line: 357, col: 17, offset: 9296},
+																pos: position{line: 358, col: 17, offset: 9435},
 																exprs: []interface{}{
 																	&litMatcher{
-																		pos:        position{line: 357, col: 17, offset: 9296},
+																		pos:        position{line: 358, col: 17, offset: 9435},
 																		val:        "/",
 																		ignoreCase: false,
+																		want:       "\"/\"",
 																	},
 																	&labeledExpr{
-																		pos:   position{line: 357, col: 21, offset: 9300},
+																		pos:   position{line: 358, col: 21, offset: 9439},
 																		label: "u",
 																		expr: &actionExpr{
-																			pos: position{line: 354, col: 25, offset: 9155},
+																			pos: position{line: 355, col: 25, offset: 9294},
 																			run: (*parser).callonImportType16,
 																			expr: &oneOrMoreExpr{
-																				pos: position{line: 354, col: 25, offset: 9155},
+																				pos: position{line: 355, col: 25, offset: 9294},
 																				expr: &charClassMatcher{
-																					pos:        position{line: 338, col: 6, offset: 8900},
+																					pos:        position{line: 339, col: 6, offset: 9039},
 																					val:        "[!=|~$-\\*-+--.0-;@-Z^-z]",
 																					chars:      []rune{'!', '=', '|', '~'},
 																					ranges:     []rune{'$', '\'', '*', '+', '-', '.', '0', ';', '@', 'Z', '^', 'z'},
@@ -3047,29 +3303,30 @@ var g = &grammar{
 															},
 														},
 														&actionExpr{
-															pos: position{line: 358, col: 17, offset: 9358},
+															pos: position{line: 359, col: 17, offset: 9497},
 															run: (*parser).callonImportType19,
 															expr: &seqExpr{
-																pos: position{line: 358, col: 17, offset: 9358},
+																pos: position{line: 359, col: 17, offset: 9497},
 																exprs: []interface{}{
 																	&litMatcher{
-																		pos:        position{line: 358, col: 17, offset: 9358},
+																		pos:        position{line: 359, col: 17, offset: 9497},
 																		val:        "/\"",
 																		ignoreCase: false,
+																		want:       "\"/\\\"\"",
 																	},
 																	&labeledExpr{
-																		pos:   position{line: 358, col: 25, offset: 9366},
+																		pos:   position{line: 359, col: 25, offset: 9505},
 																		label: "q",
 																		expr: &actionExpr{
-																			pos: position{line: 355, col: 23, offset: 9225},
+																			pos: position{line: 356, col: 23, offset: 9364},
 																			run: (*parser).callonImportType23,
 																			expr: &oneOrMoreExpr{
-																				pos: position{line: 355, col: 23, offset: 9225},
+																				pos: position{line: 356, col: 23, offset: 9364},
 																				expr: &charClassMatcher{
-																					pos:        position{line: 349, col: 6, offset: 9063},
-																					val:        "[𐀀D -!#-.0-\\u007f\\u0080-\\ud7ff\\ue000-�𐀀-\\U0001fffd𠀀-\\U0002fffd\\U00030000-\\U0003fffd\\U00040000-\\U0004fffd\\U00050000-\\U0005fffd\\U00060000-\\U0006fffd\\U00070000-\\U0007fffd\\U00080000-\\U0008fffd\\U00090000-\\U0009fffd\\U000a0000-\\U000afffd\\U000b0000-\\U000bfffd\\U000c0000-\\U000cfffd\\U000d0000-\\U000dfffd\\U000e0000-\\U000efffd\\U000f0000-\\U000ffffd0-\\U00010fff]",
+																					pos:        position{line: 350, col: 6, offset: 9202},
+																					val:        "[𐀀D -!#-.0-\\x7f\\u0080-\\ud7ff\\ue000-�𐀀-\\U0001fffd𠀀-\\U0002fffd𰀀-\\U0003fffd\\U00040000-\\U0004fffd\\U00050000-\\U0005fffd\\U00060000-\\U0006fffd\\U00070000-\\U0007fffd\\U00080000-\\U0008fffd\\U00090000-\\U0009fffd\\U000a0000-\\U000afffd\\U000b0000-\\U000bfffd\\U000c0000-\\U000cfffd\\U000d0000-\\U000dfffd\\U000e0000-\\U000efffd\\U000f0000-\\U000ffffd0-\\U00010fff]",
 																					chars:      []rune{'𐀀', 'D'},
-																					ranges:     []rune{' ', '!', '#', '.', '0', '\u007f', '\u0080', '\ud7ff', '\ue000', '�', '𐀀', '\U0001fffd', '𠀀', '\U0002fffd', '\U00030000', '\U0003fffd', '\U00040000', '\U0004fffd', '\U00050000', '\U0005fffd', '\U00060000', '\U0006fffd', '\U00070000', '\U0007fffd', '\U00080000', '\U0008fffd', '\U00090000', '\U0009fffd', '\U000a0000', '\U000afffd', '\U000b0000', '\U000bfffd', '\U000c0000', '\U000cfffd', '\U000d0000', '\U000dfffd', '\U000e0000', '\U000efffd', '\U000f0000', '\U000ffffd', '0', '\U00010fff'},
+																					ranges:     []rune{' ', '!', '#', '.', '0', '\x7f', '\u0080', '\ud7ff', '\ue000', '�', '𐀀', '\U0001fffd', '𠀀', '\U0002fffd', '𰀀', '\U0003fffd', '\U00040000', '\U0004fffd', '\U00050000', '\U0005fffd', '\U00060000', '\U0006fffd', '\U00070000', '\U0007fffd', '\U00080000', '\U0008fffd', '\U00090000', '\U0009fffd', '\U000a0000', '\U000afffd', '\U000b0000', '\U000bfffd', '\U000c0000', '\U000cfffd', '\U000d0000', '\U000dfffd', '\U000e0000', '\U000efffd', '\U000f0000', '\U000ffffd', '0', '\U00010fff'},
 																					ignoreCase: false,
 																					inverted:   false,
 																				},
@@ -3077,9 +3334,10 @@ var g = &grammar{
 																		},
 																	},
 																	&litMatcher{
-																		pos:        position{line: 358, col: 47, offset: 9388},
+																		pos:        position{line: 359, col: 47, offset: 9527},
 																		val:        "\"",
 																		ignoreCase: false,
+																		want:       "\"\\\"\"",
 																	},
 																},
 															},
@@ -3094,51 +3352,53 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 372, col: 12, offset: 9847},
+						pos: position{line: 373, col: 12, offset: 9986},
 						run: (*parser).callonImportType27,
 						expr: &seqExpr{
-							pos: position{line: 372, col: 12, offset: 9847},
+							pos: position{line: 373, col: 12, offset: 9986},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 372, col: 12, offset: 9847},
+									pos:        position{line: 373, col: 12, offset: 9986},
 									val:        ".",
 									ignoreCase: false,
+									want:       "\".\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 372, col: 16, offset: 9851},
+									pos:   position{line: 373, col: 16, offset: 9990},
 									label: "p",
 									expr: &actionExpr{
-										pos: position{line: 360, col: 8, offset: 9420},
+										pos: position{line: 361, col: 8, offset: 9559},
 										run: (*parser).callonImportType31,
 										expr: &labeledExpr{
-											pos:   position{line: 360, col: 8, offset: 9420},
+											pos:   position{line: 361, col: 8, offset: 9559},
 											label: "cs",
 											expr: &oneOrMoreExpr{
-												pos: position{line: 360, col: 11, offset: 9423},
+												pos: position{line: 361, col: 11, offset: 9562},
 												expr: &choiceExpr{
-													pos: position{line: 357, col: 17, offset: 9296},
+													pos: position{line: 358, col: 17, offset: 9435},
 													alternatives: []interface{}{
 														&actionExpr{
-															pos: position{line: 357, col: 17, offset: 9296},
+															pos: position{line: 358, col: 17, offset: 9435},
 															run: (*parser).callonImportType35,
 															expr: &seqExpr{
-																pos: position{line: 357, col: 17, offset: 9296},
+																pos: position{line: 358, col: 17, offset: 9435},
 																exprs: []interface{}{
 																	&litMatcher{
-																		pos:        position{line: 357, col: 17, offset: 9296},
+																		pos:        position{line: 358, col: 17, offset: 9435},
 																		val:        "/",
 																		ignoreCase: false,
+																		want:       "\"/\"",
 																	},
 																	&labeledExpr{
-																		pos:   position{line: 357, col: 21, offset: 9300},
+																		pos:   position{line: 358, col: 21, offset: 9439},
 																		label: "u",
 																		expr: &actionExpr{
-																			pos: position{line: 354, col: 25, offset: 9155},
+																			pos: position{line: 355, col: 25, offset: 9294},
 																			run: (*parser).callonImportType39,
 																			expr: &oneOrMoreExpr{
-																				pos: position{line: 354, col: 25, offset: 9155},
+																				pos: position{line: 355, col: 25, offset: 9294},
 																				expr: &charClassMatcher{
-																					pos:        position{line: 338, col: 6, offset: 8900},
+																					pos:        position{line: 339, col: 6, offset: 9039},
 																					val:        "[!=|~$-\\*-+--.0-;@-Z^-z]",
 																					chars:      []rune{'!', '=', '|', '~'},
 																					ranges:     []rune{'$', '\'', '*', '+', '-', '.', '0', ';', '@', 'Z', '^', 'z'},
@@ -3152,29 +3412,30 @@ var g = &grammar{
 															},
 														},
 														&actionExpr{
-															pos: position{line: 358, col: 17, offset: 9358},
+															pos: position{line: 359, col: 17, offset: 9497},
 															run: (*parser).callonImportType42,
 															expr: &seqExpr{
-																pos: position{line: 358, col: 17, offset: 9358},
+																pos: position{line: 359, col: 17, offset: 9497},
 																exprs: []interface{}{
 																	&litMatcher{
-																		pos:        position{line: 358, col: 17, offset: 9358},
+																		pos:        position{line: 359, col: 17, offset: 9497},
 																		val:        "/\"",
 																		ignoreCase: false,
+																		want:       "\"/\\\"\"",
 																	},
 																	&labeledExpr{
-																		pos:   position{line: 358, col: 25, offset: 9366},
+																		pos:   position{line: 359, col: 25, offset: 9505},
 																		label: "q",
 																		expr: &actionExpr{
-																			pos: position{line: 355, col: 23, offset: 9225},
+																			pos: position{line: 356, col: 23, offset: 9364},
 																			run: (*parser).callonImportType46,
 																			expr: &oneOrMoreExpr{
-																				pos: position{line: 355, col: 23, offset: 9225},
+																				pos: position{line: 356, col: 23, offset: 9364},
 																				expr: &charClassMatcher{
-																					pos:        position{line: 349, col: 6, offset: 9063},
-																					val:        "[𐀀D -!#-.0-\\u007f\\u0080-\\ud7ff\\ue000-�𐀀-\\U0001fffd𠀀-\\U0002fffd\\U00030000-\\U0003fffd\\U00040000-\\U0004fffd\\U00050000-\\U0005fffd\\U00060000-\\U0006fffd\\U00070000-\\U0007fffd\\U00080000-\\U0008fffd\\U00090000-\\U0009fffd\\U000a0000-\\U000afffd\\U000b0000-\\U000bfffd\\U000c0000-\\U000cfffd\\U000d0000-\\U000dfffd\\U000e0000-\\U000efffd\\U000f0000-\\U000ffffd0-\\U00010fff]",
+																					pos:        position{line: 350, col: 6, offset: 9202},
+																					val:        "[𐀀D -!#-.0-\\x7f\\u0080-\\ud7ff\\ue000-�𐀀-\\U0001fffd𠀀-\\U0002fffd𰀀-\\U0003fffd\\U00040000-\\U0004fffd\\U00050000-\\U0005fffd\\U00060000-\\U0006fffd\\U00070000-\\U0007fffd\\U00080000-\\U0008fffd\\U00090000-\\U0009fffd\\U000a0000-\\U000afffd\\U000b0000-\\U000bfffd\\U000c0000-\\U000cfffd\\U000d0000-\\U000dfffd\\U000e0000-\\U000efffd\\U000f0000-\\U000ffffd0-\\U00010fff]",
 																					chars:      []rune{'𐀀', 'D'},
-																					ranges:     []rune{' ', '!', '#', '.', '0', '\u007f', '\u0080', '\ud7ff', '\ue000', '�', '𐀀', '\U0001fffd', '𠀀', '\U0002fffd', '\U00030000', '\U0003fffd', '\U00040000', '\U0004fffd', '\U00050000', '\U0005fffd', '\U00060000', '\U0006fffd', '\U00070000', '\U0007fffd', '\U00080000', '\U0008fffd', '\U00090000', '\U0009fffd', '\U000a0000', '\U000afffd', '\U000b0000', '\U000bfffd', '\U000c0000', '\U000cfffd', '\U000d0000', '\U000dfffd', '\U000e0000', '\U000efffd', '\U000f0000', '\U000ffffd', '0', '\U00010fff'},
+																					ranges:     []rune{' ', '!', '#', '.', '0', '\x7f', '\u0080', '\ud7ff', '\ue000', '�', '𐀀', '\U0001fffd', '𠀀', '\U0002fffd', '𰀀', '\U0003fffd', '\U00040000', '\U0004fffd', '\U00050000', '\U0005fffd', '\U00060000', '\U0006fffd', '\U00070000', '\U0007fffd', '\U00080000', '\U0008fffd', '\U00090000', '\U0009fffd', '\U000a0000', '\U000afffd', '\U000b0000', '\U000bfffd', '\U000c0000', '\U000cfffd', '\U000d0000', '\U000dfffd', '\U000e0000', '\U000efffd', '\U000f0000', '\U000ffffd', '0', '\U00010fff'},
 																					ignoreCase: false,
 																					inverted:   false,
 																				},
@@ -3182,9 +3443,10 @@ var g = &grammar{
 																		},
 																	},
 																	&litMatcher{
-																		pos:        position{line: 358, col: 47, offset: 9388},
+																		pos:        position{line: 359, col: 47, offset: 9527},
 																		val:        "\"",
 																		ignoreCase: false,
+																		want:       "\"\\\"\"",
 																	},
 																},
 															},
@@ -3199,51 +3461,53 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 373, col: 12, offset: 9905},
+						pos: position{line: 374, col: 12, offset: 10044},
 						run: (*parser).callonImportType50,
 						expr: &seqExpr{
-							pos: position{line: 373, col: 12, offset: 9905},
+							pos: position{line: 374, col: 12, offset: 10044},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 373, col: 12, offset: 9905},
+									pos:        position{line: 374, col: 12, offset: 10044},
 									val:        "~",
 									ignoreCase: false,
+									want:       "\"~\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 373, col: 16, offset: 9909},
+									pos:   position{line: 374, col: 16, offset: 10048},
 									label: "p",
 									expr: &actionExpr{
-										pos: position{line: 360, col: 8, offset: 9420},
+										pos: position{line: 361, col: 8, offset: 9559},
 										run: (*parser).callonImportType54,
 										expr: &labeledExpr{
-											pos:   position{line: 360, col: 8, offset: 9420},
+											pos:   position{line: 361, col: 8, offset: 9559},
 											label: "cs",
 											expr: &oneOrMoreExpr{
-												pos: position{line: 360, col: 11, offset: 9423},
+												pos: position{line: 361, col: 11, offset: 9562},
 												expr: &choiceExpr{
-													pos: position{line: 357, col: 17, offset: 9296},
+													pos: position{line: 358, col: 17, offset: 9435},
 													alternatives: []interface{}{
 														&actionExpr{
-															pos: position{line: 357, col: 17, offset: 9296},
+															pos: position{line: 358, col: 17, offset: 9435},
 															run: (*parser).callonImportType58,
 															expr: &seqExpr{
-																pos: position{line: 357, col: 17, offset: 9296},
+																pos: position{line: 358, col: 17, offset: 9435},
 																exprs: []interface{}{
 																	&litMatcher{
-																		pos:        position{line: 357, col: 17, offset: 9296},
+																		pos:        position{line: 358, col: 17, offset: 9435},
 																		val:        "/",
 																		ignoreCase: false,
+																		want:       "\"/\"",
 																	},
 																	&labeledExpr{
-																		pos:   position{line: 357, col: 21, offset: 9300},
+																		pos:   position{line: 358, col: 21, offset: 9439},
 																		label: "u",
 																		expr: &actionExpr{
-																			pos: position{line: 354, col: 25, offset: 9155},
+																			pos: position{line: 355, col: 25, offset: 9294},
 																			run: (*parser).callonImportType62,
 																			expr: &oneOrMoreExpr{
-																				pos: position{line: 354, col: 25, offset: 9155},
+																				pos: position{line: 355, col: 25, offset: 9294},
 																				expr: &charClassMatcher{
-																					pos:        position{line: 338, col: 6, offset: 8900},
+																					pos:        position{line: 339, col: 6, offset: 9039},
 																					val:        "[!=|~$-\\*-+--.0-;@-Z^-z]",
 																					chars:      []rune{'!', '=', '|', '~'},
 																					ranges:     []rune{'$', '\'', '*', '+', '-', '.', '0', ';', '@', 'Z', '^', 'z'},
@@ -3257,29 +3521,30 @@ var g = &grammar{
 															},
 														},
 														&actionExpr{
-															pos: position{line: 358, col: 17, offset: 9358},
+															pos: position{line: 359, col: 17, offset: 9497},
 															run: (*parser).callonImportType65,
 															expr: &seqExpr{
-																pos: position{line: 358, col: 17, offset: 9358},
+																pos: position{line: 359, col: 17, offset: 9497},
 																exprs: []interface{}{
 																	&litMatcher{
-																		pos:        position{line: 358, col: 17, offset: 9358},
+																		pos:        position{line: 359, col: 17, offset: 9497},
 																		val:        "/\"",
 																		ignoreCase: false,
+																		want:       "\"/\\\"\"",
 																	},
 																	&labeledExpr{
-																		pos:   position{line: 358, col: 25, offset: 9366},
+																		pos:   position{line: 359, col: 25, offset: 9505},
 																		label: "q",
 																		expr: &actionExpr{
-																			pos: position{line: 355, col: 23, offset: 9225},
+																			pos: position{line: 356, col: 23, offset: 9364},
 																			run: (*parser).callonImportType69,
 																			expr: &oneOrMoreExpr{
-																				pos: position{line: 355, col: 23, offset: 9225},
+																				pos: position{line: 356, col: 23, offset: 9364},
 																				expr: &charClassMatcher{
-																					pos:        position{line: 349, col: 6, offset: 9063},
-																					val:        "[𐀀D -!#-.0-\\u007f\\u0080-\\ud7ff\\ue000-�𐀀-\\U0001fffd𠀀-\\U0002fffd\\U00030000-\\U0003fffd\\U00040000-\\U0004fffd\\U00050000-\\U0005fffd\\U00060000-\\U0006fffd\\U00070000-\\U0007fffd\\U00080000-\\U0008fffd\\U00090000-\\U0009fffd\\U000a0000-\\U000afffd\\U000b0000-\\U000bfffd\\U000c0000-\\U000cfffd\\U000d0000-\\U000dfffd\\U000e0000-\\U000efffd\\U000f0000-\\U000ffffd0-\\U00010fff]",
+																					pos:        position{line: 350, col: 6, offset: 9202},
+																					val:        "[𐀀D -!#-.0-\\x7f\\u0080-\\ud7ff\\ue000-�𐀀-\\U0001fffd𠀀-\\U0002fffd𰀀-\\U0003fffd\\U00040000-\\U0004fffd\\U00050000-\\U0005fffd\\U00060000-\\U0006fffd\\U00070000-\\U0007fffd\\U00080000-\\U0008fffd\\U00090000-\\U0009fffd\\U000a0000-\\U000afffd\\U000b0000-\\U000bfffd\\U000c0000-\\U000cfffd\\U000d0000-\\U000dfffd\\U000e0000-\\U000efffd\\U000f0000-\\U000ffffd0-\\U00010fff]",
 																					chars:      []rune{'𐀀', 'D'},
-																					ranges:     []rune{' ', '!', '#', '.', '0', '\u007f', '\u0080', '\ud7ff', '\ue000', '�', '𐀀', '\U0001fffd', '𠀀', '\U0002fffd', '\U00030000', '\U0003fffd', '\U00040000', '\U0004fffd', '\U00050000', '\U0005fffd', '\U00060000', '\U0006fffd', '\U00070000', '\U0007fffd', '\U00080000', '\U0008fffd', '\U00090000', '\U0009fffd', '\U000a0000', '\U000afffd', '\U000b0000', '\U000bfffd', '\U000c0000', '\U000cfffd', '\U000d0000', '\U000dfffd', '\U000e0000', '\U000efffd', '\U000f0000', '\U000ffffd', '0', '\U00010fff'},
+																					ranges:     []rune{' ', '!', '#', '.', '0', '\x7f', '\u0080', '\ud7ff', '\ue000', '�', '𐀀', '\U0001fffd', '𠀀', '\U0002fffd', '𰀀', '\U0003fffd', '\U00040000', '\U0004fffd', '\U00050000', '\U0005fffd', '\U00060000', '\U0006fffd', '\U00070000', '\U0007fffd', '\U00080000', '\U0008fffd', '\U00090000', '\U0009fffd', '\U000a0000', '\U000afffd', '\U000b0000', '\U000bfffd', '\U000c0000', '\U000cfffd', '\U000d0000', '\U000dfffd', '\U000e0000', '\U000efffd', '\U000f0000', '\U000ffffd', '0', '\U00010fff'},
 																					ignoreCase: false,
 																					inverted:   false,
 																				},
@@ -3287,9 +3552,10 @@ var g = &grammar{
 																		},
 																	},
 																	&litMatcher{
-																		pos:        position{line: 358, col: 47, offset: 9388},
+																		pos:        position{line: 359, col: 47, offset: 9527},
 																		val:        "\"",
 																		ignoreCase: false,
+																		want:       "\"\\\"\"",
 																	},
 																},
 															},
@@ -3304,43 +3570,44 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 374, col: 16, offset: 9983},
+						pos: position{line: 375, col: 16, offset: 10122},
 						run: (*parser).callonImportType73,
 						expr: &labeledExpr{
-							pos:   position{line: 374, col: 16, offset: 9983},
+							pos:   position{line: 375, col: 16, offset: 10122},
 							label: "p",
 							expr: &actionExpr{
-								pos: position{line: 360, col: 8, offset: 9420},
+								pos: position{line: 361, col: 8, offset: 9559},
 								run: (*parser).callonImportType75,
 								expr: &labeledExpr{
-									pos:   position{line: 360, col: 8, offset: 9420},
+									pos:   position{line: 361, col: 8, offset: 9559},
 									label: "cs",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 360, col: 11, offset: 9423},
+										pos: position{line: 361, col: 11, offset: 9562},
 										expr: &choiceExpr{
-											pos: position{line: 357, col: 17, offset: 9296},
+											pos: position{line: 358, col: 17, offset: 9435},
 											alternatives: []interface{}{
 												&actionExpr{
-													pos: position{line: 357, col: 17, offset: 9296},
+													pos: position{line: 358, col: 17, offset: 9435},
 													run: (*parser).callonImportType79,
 													expr: &seqExpr{
-														pos: position{line: 357, col: 17, offset: 9296},
+														pos: position{line: 358, col: 17, offset: 9435},
 														exprs: []interface{}{
 															&litMatcher{
-																pos:        position{line: 357, col: 17, offset: 9296},
+																pos:        position{line: 358, col: 17, offset: 9435},
 																val:        "/",
 																ignoreCase: false,
+																want:       "\"/\"",
 															},
 															&labeledExpr{
-																pos:   position{line: 357, col: 21, offset: 9300},
+																pos:   position{line: 358, col: 21, offset: 9439},
 																label: "u",
 																expr: &actionExpr{
-																	pos: position{line: 354, col: 25, offset: 9155},
+																	pos: position{line: 355, col: 25, offset: 9294},
 																	run: (*parser).callonImportType83,
 																	expr: &oneOrMoreExpr{
-																		pos: position{line: 354, col: 25, offset: 9155},
+																		pos: position{line: 355, col: 25, offset: 9294},
 																		expr: &charClassMatcher{
-																			pos:        position{line: 338, col: 6, offset: 8900},
+																			pos:        position{line: 339, col: 6, offset: 9039},
 																			val:        "[!=|~$-\\*-+--.0-;@-Z^-z]",
 																			chars:      []rune{'!', '=', '|', '~'},
 																			ranges:     []rune{'$', '\'', '*', '+', '-', '.', '0', ';', '@', 'Z', '^', 'z'},
@@ -3354,29 +3621,30 @@ var g = &grammar{
 													},
 												},
 												&actionExpr{
-													pos: position{line: 358, col: 17, offset: 9358},
+													pos: position{line: 359, col: 17, offset: 9497},
 													run: (*parser).callonImportType86,
 													expr: &seqExpr{
-														pos: position{line: 358, col: 17, offset: 9358},
+														pos: position{line: 359, col: 17, offset: 9497},
 														exprs: []interface{}{
 															&litMatcher{
-																pos:        position{line: 358, col: 17, offset: 9358},
+																pos:        position{line: 359, col: 17, offset: 9497},
 																val:        "/\"",
 																ignoreCase: false,
+																want:       "\"/\\\"\"",
 															},
 															&labeledExpr{
-																pos:   position{line: 358, col: 25, offset: 9366},
+																pos:   position{line: 359, col: 25, offset: 9505},
 																label: "q",
 																expr: &actionExpr{
-																	pos: position{line: 355, col: 23, offset: 9225},
+																	pos: position{line: 356, col: 23, offset: 9364},
 																	run: (*parser).callonImportType90,
 																	expr: &oneOrMoreExpr{
-																		pos: position{line: 355, col: 23, offset: 9225},
+																		pos: position{line: 356, col: 23, offset: 9364},
 																		expr: &charClassMatcher{
-																			pos:        position{line: 349, col: 6, offset: 9063},
-																			val:        "[𐀀D -!#-.0-\\u007f\\u0080-\\ud7ff\\ue000-�𐀀-\\U0001fffd𠀀-\\U0002fffd\\U00030000-\\U0003fffd\\U00040000-\\U0004fffd\\U00050000-\\U0005fffd\\U00060000-\\U0006fffd\\U00070000-\\U0007fffd\\U00080000-\\U0008fffd\\U00090000-\\U0009fffd\\U000a0000-\\U000afffd\\U000b0000-\\U000bfffd\\U000c0000-\\U000cfffd\\U000d0000-\\U000dfffd\\U000e0000-\\U000efffd\\U000f0000-\\U000ffffd0-\\U00010fff]",
+																			pos:        position{line: 350, col: 6, offset: 9202},
+																			val:        "[𐀀D -!#-.0-\\x7f\\u0080-\\ud7ff\\ue000-�𐀀-\\U0001fffd𠀀-\\U0002fffd𰀀-\\U0003fffd\\U00040000-\\U0004fffd\\U00050000-\\U0005fffd\\U00060000-\\U0006fffd\\U00070000-\\U0007fffd\\U00080000-\\U0008fffd\\U00090000-\\U0009fffd\\U000a0000-\\U000afffd\\U000b0000-\\U000bfffd\\U000c0000-\\U000cfffd\\U000d0000-\\U000dfffd\\U000e0000-\\U000efffd\\U000f0000-\\U000ffffd0-\\U00010fff]",
 																			chars:      []rune{'𐀀', 'D'},
-																			ranges:     []rune{' ', '!', '#', '.', '0', '\u007f', '\u0080', '\ud7ff', '\ue000', '�', '𐀀', '\U0001fffd', '𠀀', '\U0002fffd', '\U00030000', '\U0003fffd', '\U00040000', '\U0004fffd', '\U00050000', '\U0005fffd', '\U00060000', '\U0006fffd', '\U00070000', '\U0007fffd', '\U00080000', '\U0008fffd', '\U00090000', '\U0009fffd', '\U000a0000', '\U000afffd', '\U000b0000', '\U000bfffd', '\U000c0000', '\U000cfffd', '\U000d0000', '\U000dfffd', '\U000e0000', '\U000efffd', '\U000f0000', '\U000ffffd', '0', '\U00010fff'},
+																			ranges:     []rune{' ', '!', '#', '.', '0', '\x7f', '\u0080', '\ud7ff', '\ue000', '�', '𐀀', '\U0001fffd', '𠀀', '\U0002fffd', '𰀀', '\U0003fffd', '\U00040000', '\U0004fffd', '\U00050000', '\U0005fffd', '\U00060000', '\U0006fffd', '\U00070000', '\U0007fffd', '\U00080000', '\U0008fffd', '\U00090000', '\U0009fffd', '\U000a0000', '\U000afffd', '\U000b0000', '\U000bfffd', '\U000c0000', '\U000cfffd', '\U000d0000', '\U000dfffd', '\U000e0000', '\U000efffd', '\U000f0000', '\U000ffffd', '0', '\U00010fff'},
 																			ignoreCase: false,
 																			inverted:   false,
 																		},
@@ -3384,9 +3652,10 @@ var g = &grammar{
 																},
 															},
 															&litMatcher{
-																pos:        position{line: 358, col: 47, offset: 9388},
+																pos:        position{line: 359, col: 47, offset: 9527},
 																val:        "\"",
 																ignoreCase: false,
+																want:       "\"\\\"\"",
 															},
 														},
 													},
@@ -3399,34 +3668,35 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 453, col: 32, offset: 12151},
+						pos:  position{line: 475, col: 32, offset: 12975},
 						name: "Http",
 					},
 					&actionExpr{
-						pos: position{line: 419, col: 7, offset: 11130},
+						pos: position{line: 441, col: 7, offset: 11954},
 						run: (*parser).callonImportType95,
 						expr: &seqExpr{
-							pos: position{line: 419, col: 7, offset: 11130},
+							pos: position{line: 441, col: 7, offset: 11954},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 419, col: 7, offset: 11130},
+									pos:        position{line: 441, col: 7, offset: 11954},
 									val:        "env:",
 									ignoreCase: false,
+									want:       "\"env:\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 419, col: 14, offset: 11137},
+									pos:   position{line: 441, col: 14, offset: 11961},
 									label: "v",
 									expr: &choiceExpr{
-										pos: position{line: 419, col: 17, offset: 11140},
+										pos: position{line: 441, col: 17, offset: 11964},
 										alternatives: []interface{}{
 											&actionExpr{
-												pos: position{line: 421, col: 27, offset: 11239},
+												pos: position{line: 443, col: 27, offset: 12063},
 												run: (*parser).callonImportType100,
 												expr: &seqExpr{
-													pos: position{line: 421, col: 27, offset: 11239},
+													pos: position{line: 443, col: 27, offset: 12063},
 													exprs: []interface{}{
 														&charClassMatcher{
-															pos:        position{line: 421, col: 27, offset: 11239},
+															pos:        position{line: 443, col: 27, offset: 12063},
 															val:        "[_A-Za-z]",
 															chars:      []rune{'_'},
 															ranges:     []rune{'A', 'Z', 'a', 'z'},
@@ -3434,9 +3704,9 @@ var g = &grammar{
 															inverted:   false,
 														},
 														&zeroOrMoreExpr{
-															pos: position{line: 421, col: 36, offset: 11248},
+															pos: position{line: 443, col: 36, offset: 12072},
 															expr: &charClassMatcher{
-																pos:        position{line: 421, col: 36, offset: 11248},
+																pos:        position{line: 443, col: 36, offset: 12072},
 																val:        "[_A-Za-z0-9]",
 																chars:      []rune{'_'},
 																ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -3448,113 +3718,123 @@ var g = &grammar{
 												},
 											},
 											&actionExpr{
-												pos: position{line: 425, col: 28, offset: 11333},
+												pos: position{line: 447, col: 28, offset: 12157},
 												run: (*parser).callonImportType105,
 												expr: &seqExpr{
-													pos: position{line: 425, col: 28, offset: 11333},
+													pos: position{line: 447, col: 28, offset: 12157},
 													exprs: []interface{}{
 														&litMatcher{
-															pos:        position{line: 425, col: 28, offset: 11333},
+															pos:        position{line: 447, col: 28, offset: 12157},
 															val:        "\"",
 															ignoreCase: false,
+															want:       "\"\\\"\"",
 														},
 														&labeledExpr{
-															pos:   position{line: 425, col: 32, offset: 11337},
+															pos:   position{line: 447, col: 32, offset: 12161},
 															label: "v",
 															expr: &actionExpr{
-																pos: position{line: 429, col: 35, offset: 11432},
+																pos: position{line: 451, col: 35, offset: 12256},
 																run: (*parser).callonImportType109,
 																expr: &labeledExpr{
-																	pos:   position{line: 429, col: 35, offset: 11432},
+																	pos:   position{line: 451, col: 35, offset: 12256},
 																	label: "v",
 																	expr: &oneOrMoreExpr{
-																		pos: position{line: 429, col: 37, offset: 11434},
+																		pos: position{line: 451, col: 37, offset: 12258},
 																		expr: &choiceExpr{
-																			pos: position{line: 439, col: 7, offset: 11691},
+																			pos: position{line: 461, col: 7, offset: 12515},
 																			alternatives: []interface{}{
 																				&actionExpr{
-																					pos: position{line: 439, col: 7, offset: 11691},
+																					pos: position{line: 461, col: 7, offset: 12515},
 																					run: (*parser).callonImportType113,
 																					expr: &litMatcher{
-																						pos:        position{line: 439, col: 7, offset: 11691},
+																						pos:        position{line: 461, col: 7, offset: 12515},
 																						val:        "\\\"",
 																						ignoreCase: false,
+																						want:       "\"\\\\\\\"\"",
 																					},
 																				},
 																				&actionExpr{
-																					pos: position{line: 440, col: 7, offset: 11731},
+																					pos: position{line: 462, col: 7, offset: 12555},
 																					run: (*parser).callonImportType115,
 																					expr: &litMatcher{
-																						pos:        position{line: 440, col: 7, offset: 11731},
+																						pos:        position{line: 462, col: 7, offset: 12555},
 																						val:        "\\\\",
 																						ignoreCase: false,
+																						want:       "\"\\\\\\\\\"",
 																					},
 																				},
 																				&actionExpr{
-																					pos: position{line: 441, col: 7, offset: 11771},
+																					pos: position{line: 463, col: 7, offset: 12595},
 																					run: (*parser).callonImportType117,
 																					expr: &litMatcher{
-																						pos:        position{line: 441, col: 7, offset: 11771},
+																						pos:        position{line: 463, col: 7, offset: 12595},
 																						val:        "\\a",
 																						ignoreCase: false,
+																						want:       "\"\\\\a\"",
 																					},
 																				},
 																				&actionExpr{
-																					pos: position{line: 442, col: 7, offset: 11811},
+																					pos: position{line: 464, col: 7, offset: 12635},
 																					run: (*parser).callonImportType119,
 																					expr: &litMatcher{
-																						pos:        position{line: 442, col: 7, offset: 11811},
+																						pos:        position{line: 464, col: 7, offset: 12635},
 																						val:        "\\b",
 																						ignoreCase: false,
+																						want:       "\"\\\\b\"",
 																					},
 																				},
 																				&actionExpr{
-																					pos: position{line: 443, col: 7, offset: 11851},
+																					pos: position{line: 465, col: 7, offset: 12675},
 																					run: (*parser).callonImportType121,
 																					expr: &litMatcher{
-																						pos:        position{line: 443, col: 7, offset: 11851},
+																						pos:        position{line: 465, col: 7, offset: 12675},
 																						val:        "\\f",
 																						ignoreCase: false,
+																						want:       "\"\\\\f\"",
 																					},
 																				},
 																				&actionExpr{
-																					pos: position{line: 444, col: 7, offset: 11891},
+																					pos: position{line: 466, col: 7, offset: 12715},
 																					run: (*parser).callonImportType123,
 																					expr: &litMatcher{
-																						pos:        position{line: 444, col: 7, offset: 11891},
+																						pos:        position{line: 466, col: 7, offset: 12715},
 																						val:        "\\n",
 																						ignoreCase: false,
+																						want:       "\"\\\\n\"",
 																					},
 																				},
 																				&actionExpr{
-																					pos: position{line: 445, col: 7, offset: 11931},
+																					pos: position{line: 467, col: 7, offset: 12755},
 																					run: (*parser).callonImportType125,
 																					expr: &litMatcher{
-																						pos:        position{line: 445, col: 7, offset: 11931},
+																						pos:        position{line: 467, col: 7, offset: 12755},
 																						val:        "\\r",
 																						ignoreCase: false,
+																						want:       "\"\\\\r\"",
 																					},
 																				},
 																				&actionExpr{
-																					pos: position{line: 446, col: 7, offset: 11971},
+																					pos: position{line: 468, col: 7, offset: 12795},
 																					run: (*parser).callonImportType127,
 																					expr: &litMatcher{
-																						pos:        position{line: 446, col: 7, offset: 11971},
+																						pos:        position{line: 468, col: 7, offset: 12795},
 																						val:        "\\t",
 																						ignoreCase: false,
+																						want:       "\"\\\\t\"",
 																					},
 																				},
 																				&actionExpr{
-																					pos: position{line: 447, col: 7, offset: 12011},
+																					pos: position{line: 469, col: 7, offset: 12835},
 																					run: (*parser).callonImportType129,
 																					expr: &litMatcher{
-																						pos:        position{line: 447, col: 7, offset: 12011},
+																						pos:        position{line: 469, col: 7, offset: 12835},
 																						val:        "\\v",
 																						ignoreCase: false,
+																						want:       "\"\\\\v\"",
 																					},
 																				},
 																				&charClassMatcher{
-																					pos:        position{line: 448, col: 7, offset: 12051},
+																					pos:        position{line: 470, col: 7, offset: 12875},
 																					val:        "[ -!#-<>-[]-~]",
 																					ranges:     []rune{' ', '!', '#', '<', '>', '[', ']', '~'},
 																					ignoreCase: false,
@@ -3567,9 +3847,10 @@ var g = &grammar{
 															},
 														},
 														&litMatcher{
-															pos:        position{line: 425, col: 66, offset: 11371},
+															pos:        position{line: 447, col: 66, offset: 12195},
 															val:        "\"",
 															ignoreCase: false,
+															want:       "\"\\\"\"",
 														},
 													},
 												},
@@ -3585,52 +3866,53 @@ var g = &grammar{
 		},
 		{
 			name: "ImportHashed",
-			pos:  position{line: 471, col: 1, offset: 13003},
+			pos:  position{line: 493, col: 1, offset: 13827},
 			expr: &actionExpr{
-				pos: position{line: 471, col: 16, offset: 13020},
+				pos: position{line: 493, col: 16, offset: 13844},
 				run: (*parser).callonImportHashed1,
 				expr: &seqExpr{
-					pos: position{line: 471, col: 16, offset: 13020},
+					pos: position{line: 493, col: 16, offset: 13844},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 471, col: 16, offset: 13020},
+							pos:   position{line: 493, col: 16, offset: 13844},
 							label: "i",
 							expr: &ruleRefExpr{
-								pos:  position{line: 471, col: 18, offset: 13022},
+								pos:  position{line: 493, col: 18, offset: 13846},
 								name: "ImportType",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 471, col: 29, offset: 13033},
+							pos:   position{line: 493, col: 29, offset: 13857},
 							label: "h",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 471, col: 31, offset: 13035},
+								pos: position{line: 493, col: 31, offset: 13859},
 								expr: &seqExpr{
-									pos: position{line: 471, col: 32, offset: 13036},
+									pos: position{line: 493, col: 32, offset: 13860},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 471, col: 32, offset: 13036},
+											pos:  position{line: 493, col: 32, offset: 13860},
 											name: "_1",
 										},
 										&actionExpr{
-											pos: position{line: 469, col: 8, offset: 12919},
+											pos: position{line: 491, col: 8, offset: 13743},
 											run: (*parser).callonImportHashed9,
 											expr: &seqExpr{
-												pos: position{line: 469, col: 8, offset: 12919},
+												pos: position{line: 491, col: 8, offset: 13743},
 												exprs: []interface{}{
 													&litMatcher{
-														pos:        position{line: 469, col: 8, offset: 12919},
+														pos:        position{line: 491, col: 8, offset: 13743},
 														val:        "sha256:",
 														ignoreCase: false,
+														want:       "\"sha256:\"",
 													},
 													&labeledExpr{
-														pos:   position{line: 469, col: 18, offset: 12929},
+														pos:   position{line: 491, col: 18, offset: 13753},
 														label: "val",
 														expr: &actionExpr{
-															pos: position{line: 456, col: 13, offset: 12243},
+															pos: position{line: 478, col: 13, offset: 13067},
 															run: (*parser).callonImportHashed13,
 															expr: &seqExpr{
-																pos: position{line: 456, col: 13, offset: 12243},
+																pos: position{line: 478, col: 13, offset: 13067},
 																exprs: []interface{}{
 																	&choiceExpr{
 																		pos: position{line: 108, col: 10, offset: 2393},
@@ -4865,88 +5147,92 @@ var g = &grammar{
 		},
 		{
 			name: "Import",
-			pos:  position{line: 479, col: 1, offset: 13194},
+			pos:  position{line: 501, col: 1, offset: 14018},
 			expr: &choiceExpr{
-				pos: position{line: 479, col: 10, offset: 13205},
+				pos: position{line: 501, col: 10, offset: 14029},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 479, col: 10, offset: 13205},
+						pos: position{line: 501, col: 10, offset: 14029},
 						run: (*parser).callonImport2,
 						expr: &seqExpr{
-							pos: position{line: 479, col: 10, offset: 13205},
+							pos: position{line: 501, col: 10, offset: 14029},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 479, col: 10, offset: 13205},
+									pos:   position{line: 501, col: 10, offset: 14029},
 									label: "i",
 									expr: &ruleRefExpr{
-										pos:  position{line: 479, col: 12, offset: 13207},
+										pos:  position{line: 501, col: 12, offset: 14031},
 										name: "ImportHashed",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 479, col: 25, offset: 13220},
+									pos:  position{line: 501, col: 25, offset: 14044},
 									name: "_",
 								},
 								&litMatcher{
 									pos:        position{line: 261, col: 6, offset: 7164},
 									val:        "as",
 									ignoreCase: false,
+									want:       "\"as\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 479, col: 30, offset: 13225},
+									pos:  position{line: 501, col: 30, offset: 14049},
 									name: "_1",
 								},
 								&litMatcher{
-									pos:        position{line: 283, col: 8, offset: 7557},
+									pos:        position{line: 284, col: 8, offset: 7696},
 									val:        "Text",
 									ignoreCase: false,
+									want:       "\"Text\"",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 480, col: 10, offset: 13318},
+						pos: position{line: 502, col: 10, offset: 14142},
 						run: (*parser).callonImport10,
 						expr: &seqExpr{
-							pos: position{line: 480, col: 10, offset: 13318},
+							pos: position{line: 502, col: 10, offset: 14142},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 480, col: 10, offset: 13318},
+									pos:   position{line: 502, col: 10, offset: 14142},
 									label: "i",
 									expr: &ruleRefExpr{
-										pos:  position{line: 480, col: 12, offset: 13320},
+										pos:  position{line: 502, col: 12, offset: 14144},
 										name: "ImportHashed",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 480, col: 25, offset: 13333},
+									pos:  position{line: 502, col: 25, offset: 14157},
 									name: "_",
 								},
 								&litMatcher{
 									pos:        position{line: 261, col: 6, offset: 7164},
 									val:        "as",
 									ignoreCase: false,
+									want:       "\"as\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 480, col: 30, offset: 13338},
+									pos:  position{line: 502, col: 30, offset: 14162},
 									name: "_1",
 								},
 								&litMatcher{
-									pos:        position{line: 285, col: 12, offset: 7593},
+									pos:        position{line: 286, col: 12, offset: 7732},
 									val:        "Location",
 									ignoreCase: false,
+									want:       "\"Location\"",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 481, col: 10, offset: 13436},
+						pos: position{line: 503, col: 10, offset: 14260},
 						run: (*parser).callonImport18,
 						expr: &labeledExpr{
-							pos:   position{line: 481, col: 10, offset: 13436},
+							pos:   position{line: 503, col: 10, offset: 14260},
 							label: "i",
 							expr: &ruleRefExpr{
-								pos:  position{line: 481, col: 12, offset: 13438},
+								pos:  position{line: 503, col: 12, offset: 14262},
 								name: "ImportHashed",
 							},
 						},
@@ -4956,24 +5242,25 @@ var g = &grammar{
 		},
 		{
 			name: "LetBinding",
-			pos:  position{line: 484, col: 1, offset: 13526},
+			pos:  position{line: 506, col: 1, offset: 14350},
 			expr: &actionExpr{
-				pos: position{line: 484, col: 14, offset: 13541},
+				pos: position{line: 506, col: 14, offset: 14365},
 				run: (*parser).callonLetBinding1,
 				expr: &seqExpr{
-					pos: position{line: 484, col: 14, offset: 13541},
+					pos: position{line: 506, col: 14, offset: 14365},
 					exprs: []interface{}{
 						&litMatcher{
 							pos:        position{line: 259, col: 7, offset: 7139},
 							val:        "let",
 							ignoreCase: false,
+							want:       "\"let\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 484, col: 18, offset: 13545},
+							pos:  position{line: 506, col: 18, offset: 14369},
 							name: "_1",
 						},
 						&labeledExpr{
-							pos:   position{line: 484, col: 21, offset: 13548},
+							pos:   position{line: 506, col: 21, offset: 14372},
 							label: "label",
 							expr: &choiceExpr{
 								pos: position{line: 124, col: 20, offset: 2920},
@@ -4999,6 +5286,7 @@ var g = &grammar{
 																			pos:        position{line: 221, col: 5, offset: 5657},
 																			val:        "Natural/build",
 																			ignoreCase: false,
+																			want:       "\"Natural/build\"",
 																		},
 																	},
 																	&actionExpr{
@@ -5008,6 +5296,7 @@ var g = &grammar{
 																			pos:        position{line: 222, col: 5, offset: 5706},
 																			val:        "Natural/fold",
 																			ignoreCase: false,
+																			want:       "\"Natural/fold\"",
 																		},
 																	},
 																	&actionExpr{
@@ -5017,6 +5306,7 @@ var g = &grammar{
 																			pos:        position{line: 223, col: 5, offset: 5753},
 																			val:        "Natural/isZero",
 																			ignoreCase: false,
+																			want:       "\"Natural/isZero\"",
 																		},
 																	},
 																	&actionExpr{
@@ -5026,6 +5316,7 @@ var g = &grammar{
 																			pos:        position{line: 224, col: 5, offset: 5804},
 																			val:        "Natural/even",
 																			ignoreCase: false,
+																			want:       "\"Natural/even\"",
 																		},
 																	},
 																	&actionExpr{
@@ -5035,6 +5326,7 @@ var g = &grammar{
 																			pos:        position{line: 225, col: 5, offset: 5851},
 																			val:        "Natural/odd",
 																			ignoreCase: false,
+																			want:       "\"Natural/odd\"",
 																		},
 																	},
 																	&actionExpr{
@@ -5044,6 +5336,7 @@ var g = &grammar{
 																			pos:        position{line: 226, col: 5, offset: 5896},
 																			val:        "Natural/toInteger",
 																			ignoreCase: false,
+																			want:       "\"Natural/toInteger\"",
 																		},
 																	},
 																	&actionExpr{
@@ -5053,6 +5346,7 @@ var g = &grammar{
 																			pos:        position{line: 227, col: 5, offset: 5953},
 																			val:        "Natural/show",
 																			ignoreCase: false,
+																			want:       "\"Natural/show\"",
 																		},
 																	},
 																	&actionExpr{
@@ -5062,6 +5356,7 @@ var g = &grammar{
 																			pos:        position{line: 228, col: 5, offset: 6000},
 																			val:        "Natural/subtract",
 																			ignoreCase: false,
+																			want:       "\"Natural/subtract\"",
 																		},
 																	},
 																	&actionExpr{
@@ -5071,6 +5366,7 @@ var g = &grammar{
 																			pos:        position{line: 229, col: 5, offset: 6055},
 																			val:        "Integer/toDouble",
 																			ignoreCase: false,
+																			want:       "\"Integer/toDouble\"",
 																		},
 																	},
 																	&actionExpr{
@@ -5080,6 +5376,7 @@ var g = &grammar{
 																			pos:        position{line: 230, col: 5, offset: 6110},
 																			val:        "Integer/show",
 																			ignoreCase: false,
+																			want:       "\"Integer/show\"",
 																		},
 																	},
 																	&actionExpr{
@@ -5089,6 +5386,7 @@ var g = &grammar{
 																			pos:        position{line: 231, col: 5, offset: 6157},
 																			val:        "Double/show",
 																			ignoreCase: false,
+																			want:       "\"Double/show\"",
 																		},
 																	},
 																	&actionExpr{
@@ -5098,6 +5396,7 @@ var g = &grammar{
 																			pos:        position{line: 232, col: 5, offset: 6202},
 																			val:        "List/build",
 																			ignoreCase: false,
+																			want:       "\"List/build\"",
 																		},
 																	},
 																	&actionExpr{
@@ -5107,6 +5406,7 @@ var g = &grammar{
 																			pos:        position{line: 233, col: 5, offset: 6245},
 																			val:        "List/fold",
 																			ignoreCase: false,
+																			want:       "\"List/fold\"",
 																		},
 																	},
 																	&actionExpr{
@@ -5116,6 +5416,7 @@ var g = &grammar{
 																			pos:        position{line: 234, col: 5, offset: 6286},
 																			val:        "List/length",
 																			ignoreCase: false,
+																			want:       "\"List/length\"",
 																		},
 																	},
 																	&actionExpr{
@@ -5125,6 +5426,7 @@ var g = &grammar{
 																			pos:        position{line: 235, col: 5, offset: 6331},
 																			val:        "List/head",
 																			ignoreCase: false,
+																			want:       "\"List/head\"",
 																		},
 																	},
 																	&actionExpr{
@@ -5134,6 +5436,7 @@ var g = &grammar{
 																			pos:        position{line: 236, col: 5, offset: 6372},
 																			val:        "List/last",
 																			ignoreCase: false,
+																			want:       "\"List/last\"",
 																		},
 																	},
 																	&actionExpr{
@@ -5143,6 +5446,7 @@ var g = &grammar{
 																			pos:        position{line: 237, col: 5, offset: 6413},
 																			val:        "List/indexed",
 																			ignoreCase: false,
+																			want:       "\"List/indexed\"",
 																		},
 																	},
 																	&actionExpr{
@@ -5152,6 +5456,7 @@ var g = &grammar{
 																			pos:        position{line: 238, col: 5, offset: 6460},
 																			val:        "List/reverse",
 																			ignoreCase: false,
+																			want:       "\"List/reverse\"",
 																		},
 																	},
 																	&actionExpr{
@@ -5161,6 +5466,7 @@ var g = &grammar{
 																			pos:        position{line: 239, col: 5, offset: 6507},
 																			val:        "Optional/build",
 																			ignoreCase: false,
+																			want:       "\"Optional/build\"",
 																		},
 																	},
 																	&actionExpr{
@@ -5170,6 +5476,7 @@ var g = &grammar{
 																			pos:        position{line: 240, col: 5, offset: 6558},
 																			val:        "Optional/fold",
 																			ignoreCase: false,
+																			want:       "\"Optional/fold\"",
 																		},
 																	},
 																	&actionExpr{
@@ -5179,6 +5486,7 @@ var g = &grammar{
 																			pos:        position{line: 241, col: 5, offset: 6607},
 																			val:        "Text/show",
 																			ignoreCase: false,
+																			want:       "\"Text/show\"",
 																		},
 																	},
 																	&actionExpr{
@@ -5188,6 +5496,7 @@ var g = &grammar{
 																			pos:        position{line: 242, col: 5, offset: 6648},
 																			val:        "Bool",
 																			ignoreCase: false,
+																			want:       "\"Bool\"",
 																		},
 																	},
 																	&actionExpr{
@@ -5197,6 +5506,7 @@ var g = &grammar{
 																			pos:        position{line: 243, col: 5, offset: 6680},
 																			val:        "True",
 																			ignoreCase: false,
+																			want:       "\"True\"",
 																		},
 																	},
 																	&actionExpr{
@@ -5206,6 +5516,7 @@ var g = &grammar{
 																			pos:        position{line: 244, col: 5, offset: 6712},
 																			val:        "False",
 																			ignoreCase: false,
+																			want:       "\"False\"",
 																		},
 																	},
 																	&actionExpr{
@@ -5215,6 +5526,7 @@ var g = &grammar{
 																			pos:        position{line: 245, col: 5, offset: 6746},
 																			val:        "Optional",
 																			ignoreCase: false,
+																			want:       "\"Optional\"",
 																		},
 																	},
 																	&actionExpr{
@@ -5224,6 +5536,7 @@ var g = &grammar{
 																			pos:        position{line: 246, col: 5, offset: 6786},
 																			val:        "Natural",
 																			ignoreCase: false,
+																			want:       "\"Natural\"",
 																		},
 																	},
 																	&actionExpr{
@@ -5233,6 +5546,7 @@ var g = &grammar{
 																			pos:        position{line: 247, col: 5, offset: 6824},
 																			val:        "Integer",
 																			ignoreCase: false,
+																			want:       "\"Integer\"",
 																		},
 																	},
 																	&actionExpr{
@@ -5242,6 +5556,7 @@ var g = &grammar{
 																			pos:        position{line: 248, col: 5, offset: 6862},
 																			val:        "Double",
 																			ignoreCase: false,
+																			want:       "\"Double\"",
 																		},
 																	},
 																	&actionExpr{
@@ -5251,6 +5566,7 @@ var g = &grammar{
 																			pos:        position{line: 249, col: 5, offset: 6898},
 																			val:        "Text",
 																			ignoreCase: false,
+																			want:       "\"Text\"",
 																		},
 																	},
 																	&actionExpr{
@@ -5260,6 +5576,7 @@ var g = &grammar{
 																			pos:        position{line: 250, col: 5, offset: 6930},
 																			val:        "List",
 																			ignoreCase: false,
+																			want:       "\"List\"",
 																		},
 																	},
 																	&actionExpr{
@@ -5269,6 +5586,7 @@ var g = &grammar{
 																			pos:        position{line: 251, col: 5, offset: 6962},
 																			val:        "None",
 																			ignoreCase: false,
+																			want:       "\"None\"",
 																		},
 																	},
 																	&actionExpr{
@@ -5278,6 +5596,7 @@ var g = &grammar{
 																			pos:        position{line: 252, col: 5, offset: 6994},
 																			val:        "Type",
 																			ignoreCase: false,
+																			want:       "\"Type\"",
 																		},
 																	},
 																	&actionExpr{
@@ -5287,6 +5606,7 @@ var g = &grammar{
 																			pos:        position{line: 253, col: 5, offset: 7026},
 																			val:        "Kind",
 																			ignoreCase: false,
+																			want:       "\"Kind\"",
 																		},
 																	},
 																	&actionExpr{
@@ -5296,6 +5616,7 @@ var g = &grammar{
 																			pos:        position{line: 254, col: 5, offset: 7058},
 																			val:        "Sort",
 																			ignoreCase: false,
+																			want:       "\"Sort\"",
 																		},
 																	},
 																},
@@ -5327,6 +5648,7 @@ var g = &grammar{
 																			pos:        position{line: 121, col: 9, offset: 2802},
 																			val:        "`",
 																			ignoreCase: false,
+																			want:       "\"`\"",
 																		},
 																		&labeledExpr{
 																			pos:   position{line: 121, col: 13, offset: 2806},
@@ -5350,6 +5672,7 @@ var g = &grammar{
 																			pos:        position{line: 121, col: 31, offset: 2824},
 																			val:        "`",
 																			ignoreCase: false,
+																			want:       "\"`\"",
 																		},
 																	},
 																},
@@ -5370,37 +5693,43 @@ var g = &grammar{
 																					pos: position{line: 112, col: 15, offset: 2499},
 																					exprs: []interface{}{
 																						&choiceExpr{
-																							pos: position{line: 274, col: 5, offset: 7396},
+																							pos: position{line: 276, col: 5, offset: 7537},
 																							alternatives: []interface{}{
 																								&litMatcher{
 																									pos:        position{line: 256, col: 6, offset: 7094},
 																									val:        "if",
 																									ignoreCase: false,
+																									want:       "\"if\"",
 																								},
 																								&litMatcher{
 																									pos:        position{line: 257, col: 8, offset: 7108},
 																									val:        "then",
 																									ignoreCase: false,
+																									want:       "\"then\"",
 																								},
 																								&litMatcher{
 																									pos:        position{line: 258, col: 8, offset: 7124},
 																									val:        "else",
 																									ignoreCase: false,
+																									want:       "\"else\"",
 																								},
 																								&litMatcher{
 																									pos:        position{line: 259, col: 7, offset: 7139},
 																									val:        "let",
 																									ignoreCase: false,
+																									want:       "\"let\"",
 																								},
 																								&litMatcher{
 																									pos:        position{line: 260, col: 6, offset: 7152},
 																									val:        "in",
 																									ignoreCase: false,
+																									want:       "\"in\"",
 																								},
 																								&litMatcher{
 																									pos:        position{line: 262, col: 9, offset: 7179},
 																									val:        "using",
 																									ignoreCase: false,
+																									want:       "\"using\"",
 																								},
 																								&actionExpr{
 																									pos: position{line: 264, col: 11, offset: 7217},
@@ -5409,52 +5738,62 @@ var g = &grammar{
 																										pos:        position{line: 264, col: 11, offset: 7217},
 																										val:        "missing",
 																										ignoreCase: false,
+																										want:       "\"missing\"",
 																									},
 																								},
+																								&litMatcher{
+																									pos:        position{line: 271, col: 10, offset: 7370},
+																									val:        "assert",
+																									ignoreCase: false,
+																									want:       "\"assert\"",
+																								},
 																								&litMatcher{
 																									pos:        position{line: 261, col: 6, offset: 7164},
 																									val:        "as",
 																									ignoreCase: false,
+																									want:       "\"as\"",
 																								},
 																								&litMatcher{
 																									pos:        position{line: 265, col: 8, offset: 7262},
 																									val:        "True",
 																									ignoreCase: false,
+																									want:       "\"True\"",
 																								},
 																								&litMatcher{
 																									pos:        position{line: 266, col: 9, offset: 7279},
 																									val:        "False",
 																									ignoreCase: false,
+																									want:       "\"False\"",
 																								},
 																								&litMatcher{
 																									pos:        position{line: 267, col: 12, offset: 7300},
 																									val:        "Infinity",
 																									ignoreCase: false,
+																									want:       "\"Infinity\"",
 																								},
 																								&litMatcher{
 																									pos:        position{line: 268, col: 7, offset: 7319},
 																									val:        "NaN",
 																									ignoreCase: false,
+																									want:       "\"NaN\"",
 																								},
 																								&litMatcher{
 																									pos:        position{line: 263, col: 9, offset: 7197},
 																									val:        "merge",
 																									ignoreCase: false,
+																									want:       "\"merge\"",
 																								},
 																								&litMatcher{
 																									pos:        position{line: 269, col: 8, offset: 7334},
 																									val:        "Some",
 																									ignoreCase: false,
+																									want:       "\"Some\"",
 																								},
 																								&litMatcher{
 																									pos:        position{line: 270, col: 9, offset: 7351},
 																									val:        "toMap",
 																									ignoreCase: false,
-																								},
-																								&litMatcher{
-																									pos:        position{line: 271, col: 10, offset: 7370},
-																									val:        "assert",
-																									ignoreCase: false,
+																									want:       "\"toMap\"",
 																								},
 																							},
 																						},
@@ -5481,37 +5820,43 @@ var g = &grammar{
 																						&notExpr{
 																							pos: position{line: 113, col: 13, offset: 2571},
 																							expr: &choiceExpr{
-																								pos: position{line: 274, col: 5, offset: 7396},
+																								pos: position{line: 276, col: 5, offset: 7537},
 																								alternatives: []interface{}{
 																									&litMatcher{
 																										pos:        position{line: 256, col: 6, offset: 7094},
 																										val:        "if",
 																										ignoreCase: false,
+																										want:       "\"if\"",
 																									},
 																									&litMatcher{
 																										pos:        position{line: 257, col: 8, offset: 7108},
 																										val:        "then",
 																										ignoreCase: false,
+																										want:       "\"then\"",
 																									},
 																									&litMatcher{
 																										pos:        position{line: 258, col: 8, offset: 7124},
 																										val:        "else",
 																										ignoreCase: false,
+																										want:       "\"else\"",
 																									},
 																									&litMatcher{
 																										pos:        position{line: 259, col: 7, offset: 7139},
 																										val:        "let",
 																										ignoreCase: false,
+																										want:       "\"let\"",
 																									},
 																									&litMatcher{
 																										pos:        position{line: 260, col: 6, offset: 7152},
 																										val:        "in",
 																										ignoreCase: false,
+																										want:       "\"in\"",
 																									},
 																									&litMatcher{
 																										pos:        position{line: 262, col: 9, offset: 7179},
 																										val:        "using",
 																										ignoreCase: false,
+																										want:       "\"using\"",
 																									},
 																									&actionExpr{
 																										pos: position{line: 264, col: 11, offset: 7217},
@@ -5520,52 +5865,62 @@ var g = &grammar{
 																											pos:        position{line: 264, col: 11, offset: 7217},
 																											val:        "missing",
 																											ignoreCase: false,
+																											want:       "\"missing\"",
 																										},
 																									},
 																									&litMatcher{
+																										pos:        position{line: 271, col: 10, offset: 7370},
+																										val:        "assert",
+																										ignoreCase: false,
+																										want:       "\"assert\"",
+																									},
+																									&litMatcher{
 																										pos:        position{line: 261, col: 6, offset: 7164},
 																										val:        "as",
 																										ignoreCase: false,
+																										want:       "\"as\"",
 																									},
 																									&litMatcher{
 																										pos:        position{line: 265, col: 8, offset: 7262},
 																										val:        "True",
 																										ignoreCase: false,
+																										want:       "\"True\"",
 																									},
 																									&litMatcher{
 																										pos:        position{line: 266, col: 9, offset: 7279},
 																										val:        "False",
 																										ignoreCase: false,
+																										want:       "\"False\"",
 																									},
 																									&litMatcher{
 																										pos:        position{line: 267, col: 12, offset: 7300},
 																										val:        "Infinity",
 																										ignoreCase: false,
+																										want:       "\"Infinity\"",
 																									},
 																									&litMatcher{
 																										pos:        position{line: 268, col: 7, offset: 7319},
 																										val:        "NaN",
 																										ignoreCase: false,
+																										want:       "\"NaN\"",
 																									},
 																									&litMatcher{
 																										pos:        position{line: 263, col: 9, offset: 7197},
 																										val:        "merge",
 																										ignoreCase: false,
+																										want:       "\"merge\"",
 																									},
 																									&litMatcher{
 																										pos:        position{line: 269, col: 8, offset: 7334},
 																										val:        "Some",
 																										ignoreCase: false,
+																										want:       "\"Some\"",
 																									},
 																									&litMatcher{
 																										pos:        position{line: 270, col: 9, offset: 7351},
 																										val:        "toMap",
 																										ignoreCase: false,
-																									},
-																									&litMatcher{
-																										pos:        position{line: 271, col: 10, offset: 7370},
-																										val:        "assert",
-																										ignoreCase: false,
+																										want:       "\"toMap\"",
 																									},
 																								},
 																							},
@@ -5620,6 +5975,7 @@ var g = &grammar{
 																	pos:        position{line: 221, col: 5, offset: 5657},
 																	val:        "Natural/build",
 																	ignoreCase: false,
+																	want:       "\"Natural/build\"",
 																},
 															},
 															&actionExpr{
@@ -5629,6 +5985,7 @@ var g = &grammar{
 																	pos:        position{line: 222, col: 5, offset: 5706},
 																	val:        "Natural/fold",
 																	ignoreCase: false,
+																	want:       "\"Natural/fold\"",
 																},
 															},
 															&actionExpr{
@@ -5638,6 +5995,7 @@ var g = &grammar{
 																	pos:        position{line: 223, col: 5, offset: 5753},
 																	val:        "Natural/isZero",
 																	ignoreCase: false,
+																	want:       "\"Natural/isZero\"",
 																},
 															},
 															&actionExpr{
@@ -5647,6 +6005,7 @@ var g = &grammar{
 																	pos:        position{line: 224, col: 5, offset: 5804},
 																	val:        "Natural/even",
 																	ignoreCase: false,
+																	want:       "\"Natural/even\"",
 																},
 															},
 															&actionExpr{
@@ -5656,6 +6015,7 @@ var g = &grammar{
 																	pos:        position{line: 225, col: 5, offset: 5851},
 																	val:        "Natural/odd",
 																	ignoreCase: false,
+																	want:       "\"Natural/odd\"",
 																},
 															},
 															&actionExpr{
@@ -5665,6 +6025,7 @@ var g = &grammar{
 																	pos:        position{line: 226, col: 5, offset: 5896},
 																	val:        "Natural/toInteger",
 																	ignoreCase: false,
+																	want:       "\"Natural/toInteger\"",
 																},
 															},
 															&actionExpr{
@@ -5674,6 +6035,7 @@ var g = &grammar{
 																	pos:        position{line: 227, col: 5, offset: 5953},
 																	val:        "Natural/show",
 																	ignoreCase: false,
+																	want:       "\"Natural/show\"",
 																},
 															},
 															&actionExpr{
@@ -5683,6 +6045,7 @@ var g = &grammar{
 																	pos:        position{line: 228, col: 5, offset: 6000},
 																	val:        "Natural/subtract",
 																	ignoreCase: false,
+																	want:       "\"Natural/subtract\"",
 																},
 															},
 															&actionExpr{
@@ -5692,6 +6055,7 @@ var g = &grammar{
 																	pos:        position{line: 229, col: 5, offset: 6055},
 																	val:        "Integer/toDouble",
 																	ignoreCase: false,
+																	want:       "\"Integer/toDouble\"",
 																},
 															},
 															&actionExpr{
@@ -5701,6 +6065,7 @@ var g = &grammar{
 																	pos:        position{line: 230, col: 5, offset: 6110},
 																	val:        "Integer/show",
 																	ignoreCase: false,
+																	want:       "\"Integer/show\"",
 																},
 															},
 															&actionExpr{
@@ -5710,6 +6075,7 @@ var g = &grammar{
 																	pos:        position{line: 231, col: 5, offset: 6157},
 																	val:        "Double/show",
 																	ignoreCase: false,
+																	want:       "\"Double/show\"",
 																},
 															},
 															&actionExpr{
@@ -5719,6 +6085,7 @@ var g = &grammar{
 																	pos:        position{line: 232, col: 5, offset: 6202},
 																	val:        "List/build",
 																	ignoreCase: false,
+																	want:       "\"List/build\"",
 																},
 															},
 															&actionExpr{
@@ -5728,6 +6095,7 @@ var g = &grammar{
 																	pos:        position{line: 233, col: 5, offset: 6245},
 																	val:        "List/fold",
 																	ignoreCase: false,
+																	want:       "\"List/fold\"",
 																},
 															},
 															&actionExpr{
@@ -5737,6 +6105,7 @@ var g = &grammar{
 																	pos:        position{line: 234, col: 5, offset: 6286},
 																	val:        "List/length",
 																	ignoreCase: false,
+																	want:       "\"List/length\"",
 																},
 															},
 															&actionExpr{
@@ -5746,6 +6115,7 @@ var g = &grammar{
 																	pos:        position{line: 235, col: 5, offset: 6331},
 																	val:        "List/head",
 																	ignoreCase: false,
+																	want:       "\"List/head\"",
 																},
 															},
 															&actionExpr{
@@ -5755,6 +6125,7 @@ var g = &grammar{
 																	pos:        position{line: 236, col: 5, offset: 6372},
 																	val:        "List/last",
 																	ignoreCase: false,
+																	want:       "\"List/last\"",
 																},
 															},
 															&actionExpr{
@@ -5764,6 +6135,7 @@ var g = &grammar{
 																	pos:        position{line: 237, col: 5, offset: 6413},
 																	val:        "List/indexed",
 																	ignoreCase: false,
+																	want:       "\"List/indexed\"",
 																},
 															},
 															&actionExpr{
@@ -5773,6 +6145,7 @@ var g = &grammar{
 																	pos:        position{line: 238, col: 5, offset: 6460},
 																	val:        "List/reverse",
 																	ignoreCase: false,
+																	want:       "\"List/reverse\"",
 																},
 															},
 															&actionExpr{
@@ -5782,6 +6155,7 @@ var g = &grammar{
 																	pos:        position{line: 239, col: 5, offset: 6507},
 																	val:        "Optional/build",
 																	ignoreCase: false,
+																	want:       "\"Optional/build\"",
 																},
 															},
 															&actionExpr{
@@ -5791,6 +6165,7 @@ var g = &grammar{
 																	pos:        position{line: 240, col: 5, offset: 6558},
 																	val:        "Optional/fold",
 																	ignoreCase: false,
+																	want:       "\"Optional/fold\"",
 																},
 															},
 															&actionExpr{
@@ -5800,6 +6175,7 @@ var g = &grammar{
 																	pos:        position{line: 241, col: 5, offset: 6607},
 																	val:        "Text/show",
 																	ignoreCase: false,
+																	want:       "\"Text/show\"",
 																},
 															},
 															&actionExpr{
@@ -5809,6 +6185,7 @@ var g = &grammar{
 																	pos:        position{line: 242, col: 5, offset: 6648},
 																	val:        "Bool",
 																	ignoreCase: false,
+																	want:       "\"Bool\"",
 																},
 															},
 															&actionExpr{
@@ -5818,6 +6195,7 @@ var g = &grammar{
 																	pos:        position{line: 243, col: 5, offset: 6680},
 																	val:        "True",
 																	ignoreCase: false,
+																	want:       "\"True\"",
 																},
 															},
 															&actionExpr{
@@ -5827,6 +6205,7 @@ var g = &grammar{
 																	pos:        position{line: 244, col: 5, offset: 6712},
 																	val:        "False",
 																	ignoreCase: false,
+																	want:       "\"False\"",
 																},
 															},
 															&actionExpr{
@@ -5836,6 +6215,7 @@ var g = &grammar{
 																	pos:        position{line: 245, col: 5, offset: 6746},
 																	val:        "Optional",
 																	ignoreCase: false,
+																	want:       "\"Optional\"",
 																},
 															},
 															&actionExpr{
@@ -5845,6 +6225,7 @@ var g = &grammar{
 																	pos:        position{line: 246, col: 5, offset: 6786},
 																	val:        "Natural",
 																	ignoreCase: false,
+																	want:       "\"Natural\"",
 																},
 															},
 															&actionExpr{
@@ -5854,6 +6235,7 @@ var g = &grammar{
 																	pos:        position{line: 247, col: 5, offset: 6824},
 																	val:        "Integer",
 																	ignoreCase: false,
+																	want:       "\"Integer\"",
 																},
 															},
 															&actionExpr{
@@ -5863,6 +6245,7 @@ var g = &grammar{
 																	pos:        position{line: 248, col: 5, offset: 6862},
 																	val:        "Double",
 																	ignoreCase: false,
+																	want:       "\"Double\"",
 																},
 															},
 															&actionExpr{
@@ -5872,6 +6255,7 @@ var g = &grammar{
 																	pos:        position{line: 249, col: 5, offset: 6898},
 																	val:        "Text",
 																	ignoreCase: false,
+																	want:       "\"Text\"",
 																},
 															},
 															&actionExpr{
@@ -5881,6 +6265,7 @@ var g = &grammar{
 																	pos:        position{line: 250, col: 5, offset: 6930},
 																	val:        "List",
 																	ignoreCase: false,
+																	want:       "\"List\"",
 																},
 															},
 															&actionExpr{
@@ -5890,6 +6275,7 @@ var g = &grammar{
 																	pos:        position{line: 251, col: 5, offset: 6962},
 																	val:        "None",
 																	ignoreCase: false,
+																	want:       "\"None\"",
 																},
 															},
 															&actionExpr{
@@ -5899,6 +6285,7 @@ var g = &grammar{
 																	pos:        position{line: 252, col: 5, offset: 6994},
 																	val:        "Type",
 																	ignoreCase: false,
+																	want:       "\"Type\"",
 																},
 															},
 															&actionExpr{
@@ -5908,6 +6295,7 @@ var g = &grammar{
 																	pos:        position{line: 253, col: 5, offset: 7026},
 																	val:        "Kind",
 																	ignoreCase: false,
+																	want:       "\"Kind\"",
 																},
 															},
 															&actionExpr{
@@ -5917,6 +6305,7 @@ var g = &grammar{
 																	pos:        position{line: 254, col: 5, offset: 7058},
 																	val:        "Sort",
 																	ignoreCase: false,
+																	want:       "\"Sort\"",
 																},
 															},
 														},
@@ -5938,6 +6327,7 @@ var g = &grammar{
 																			pos:        position{line: 121, col: 9, offset: 2802},
 																			val:        "`",
 																			ignoreCase: false,
+																			want:       "\"`\"",
 																		},
 																		&labeledExpr{
 																			pos:   position{line: 121, col: 13, offset: 2806},
@@ -5961,6 +6351,7 @@ var g = &grammar{
 																			pos:        position{line: 121, col: 31, offset: 2824},
 																			val:        "`",
 																			ignoreCase: false,
+																			want:       "\"`\"",
 																		},
 																	},
 																},
@@ -5981,37 +6372,43 @@ var g = &grammar{
 																					pos: position{line: 112, col: 15, offset: 2499},
 																					exprs: []interface{}{
 																						&choiceExpr{
-																							pos: position{line: 274, col: 5, offset: 7396},
+																							pos: position{line: 276, col: 5, offset: 7537},
 																							alternatives: []interface{}{
 																								&litMatcher{
 																									pos:        position{line: 256, col: 6, offset: 7094},
 																									val:        "if",
 																									ignoreCase: false,
+																									want:       "\"if\"",
 																								},
 																								&litMatcher{
 																									pos:        position{line: 257, col: 8, offset: 7108},
 																									val:        "then",
 																									ignoreCase: false,
+																									want:       "\"then\"",
 																								},
 																								&litMatcher{
 																									pos:        position{line: 258, col: 8, offset: 7124},
 																									val:        "else",
 																									ignoreCase: false,
+																									want:       "\"else\"",
 																								},
 																								&litMatcher{
 																									pos:        position{line: 259, col: 7, offset: 7139},
 																									val:        "let",
 																									ignoreCase: false,
+																									want:       "\"let\"",
 																								},
 																								&litMatcher{
 																									pos:        position{line: 260, col: 6, offset: 7152},
 																									val:        "in",
 																									ignoreCase: false,
+																									want:       "\"in\"",
 																								},
 																								&litMatcher{
 																									pos:        position{line: 262, col: 9, offset: 7179},
 																									val:        "using",
 																									ignoreCase: false,
+																									want:       "\"using\"",
 																								},
 																								&actionExpr{
 																									pos: position{line: 264, col: 11, offset: 7217},
@@ -6020,52 +6417,62 @@ var g = &grammar{
 																										pos:        position{line: 264, col: 11, offset: 7217},
 																										val:        "missing",
 																										ignoreCase: false,
+																										want:       "\"missing\"",
 																									},
 																								},
+																								&litMatcher{
+																									pos:        position{line: 271, col: 10, offset: 7370},
+																									val:        "assert",
+																									ignoreCase: false,
+																									want:       "\"assert\"",
+																								},
 																								&litMatcher{
 																									pos:        position{line: 261, col: 6, offset: 7164},
 																									val:        "as",
 																									ignoreCase: false,
+																									want:       "\"as\"",
 																								},
 																								&litMatcher{
 																									pos:        position{line: 265, col: 8, offset: 7262},
 																									val:        "True",
 																									ignoreCase: false,
+																									want:       "\"True\"",
 																								},
 																								&litMatcher{
 																									pos:        position{line: 266, col: 9, offset: 7279},
 																									val:        "False",
 																									ignoreCase: false,
+																									want:       "\"False\"",
 																								},
 																								&litMatcher{
 																									pos:        position{line: 267, col: 12, offset: 7300},
 																									val:        "Infinity",
 																									ignoreCase: false,
+																									want:       "\"Infinity\"",
 																								},
 																								&litMatcher{
 																									pos:        position{line: 268, col: 7, offset: 7319},
 																									val:        "NaN",
 																									ignoreCase: false,
+																									want:       "\"NaN\"",
 																								},
 																								&litMatcher{
 																									pos:        position{line: 263, col: 9, offset: 7197},
 																									val:        "merge",
 																									ignoreCase: false,
+																									want:       "\"merge\"",
 																								},
 																								&litMatcher{
 																									pos:        position{line: 269, col: 8, offset: 7334},
 																									val:        "Some",
 																									ignoreCase: false,
+																									want:       "\"Some\"",
 																								},
 																								&litMatcher{
 																									pos:        position{line: 270, col: 9, offset: 7351},
 																									val:        "toMap",
 																									ignoreCase: false,
-																								},
-																								&litMatcher{
-																									pos:        position{line: 271, col: 10, offset: 7370},
-																									val:        "assert",
-																									ignoreCase: false,
+																									want:       "\"toMap\"",
 																								},
 																							},
 																						},
@@ -6092,37 +6499,43 @@ var g = &grammar{
 																						&notExpr{
 																							pos: position{line: 113, col: 13, offset: 2571},
 																							expr: &choiceExpr{
-																								pos: position{line: 274, col: 5, offset: 7396},
+																								pos: position{line: 276, col: 5, offset: 7537},
 																								alternatives: []interface{}{
 																									&litMatcher{
 																										pos:        position{line: 256, col: 6, offset: 7094},
 																										val:        "if",
 																										ignoreCase: false,
+																										want:       "\"if\"",
 																									},
 																									&litMatcher{
 																										pos:        position{line: 257, col: 8, offset: 7108},
 																										val:        "then",
 																										ignoreCase: false,
+																										want:       "\"then\"",
 																									},
 																									&litMatcher{
 																										pos:        position{line: 258, col: 8, offset: 7124},
 																										val:        "else",
 																										ignoreCase: false,
+																										want:       "\"else\"",
 																									},
 																									&litMatcher{
 																										pos:        position{line: 259, col: 7, offset: 7139},
 																										val:        "let",
 																										ignoreCase: false,
+																										want:       "\"let\"",
 																									},
 																									&litMatcher{
 																										pos:        position{line: 260, col: 6, offset: 7152},
 																										val:        "in",
 																										ignoreCase: false,
+																										want:       "\"in\"",
 																									},
 																									&litMatcher{
 																										pos:        position{line: 262, col: 9, offset: 7179},
 																										val:        "using",
 																										ignoreCase: false,
+																										want:       "\"using\"",
 																									},
 																									&actionExpr{
 																										pos: position{line: 264, col: 11, offset: 7217},
@@ -6131,52 +6544,62 @@ var g = &grammar{
 																											pos:        position{line: 264, col: 11, offset: 7217},
 																											val:        "missing",
 																											ignoreCase: false,
+																											want:       "\"missing\"",
 																										},
 																									},
 																									&litMatcher{
-																										pos:        position{line: 261, col: 6, offset: 7164},
+																										pos:        position{line: 271, col: 10, offset: 7370},
+																										val:        "assert",
+																										ignoreCase: false,
+																										want:       "\"assert\"",
+																									},
+																									&litMatcher{
+																										pos:        position{line: 261, col: 6, offset: 7164},
 																										val:        "as",
 																										ignoreCase: false,
+																										want:       "\"as\"",
 																									},
 																									&litMatcher{
 																										pos:        position{line: 265, col: 8, offset: 7262},
 																										val:        "True",
 																										ignoreCase: false,
+																										want:       "\"True\"",
 																									},
 																									&litMatcher{
 																										pos:        position{line: 266, col: 9, offset: 7279},
 																										val:        "False",
 																										ignoreCase: false,
+																										want:       "\"False\"",
 																									},
 																									&litMatcher{
 																										pos:        position{line: 267, col: 12, offset: 7300},
 																										val:        "Infinity",
 																										ignoreCase: false,
+																										want:       "\"Infinity\"",
 																									},
 																									&litMatcher{
 																										pos:        position{line: 268, col: 7, offset: 7319},
 																										val:        "NaN",
 																										ignoreCase: false,
+																										want:       "\"NaN\"",
 																									},
 																									&litMatcher{
 																										pos:        position{line: 263, col: 9, offset: 7197},
 																										val:        "merge",
 																										ignoreCase: false,
+																										want:       "\"merge\"",
 																									},
 																									&litMatcher{
 																										pos:        position{line: 269, col: 8, offset: 7334},
 																										val:        "Some",
 																										ignoreCase: false,
+																										want:       "\"Some\"",
 																									},
 																									&litMatcher{
 																										pos:        position{line: 270, col: 9, offset: 7351},
 																										val:        "toMap",
 																										ignoreCase: false,
-																									},
-																									&litMatcher{
-																										pos:        position{line: 271, col: 10, offset: 7370},
-																										val:        "assert",
-																										ignoreCase: false,
+																										want:       "\"toMap\"",
 																									},
 																								},
 																							},
@@ -6217,23 +6640,23 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 484, col: 44, offset: 13571},
+							pos:  position{line: 506, col: 44, offset: 14395},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 484, col: 46, offset: 13573},
+							pos:   position{line: 506, col: 46, offset: 14397},
 							label: "a",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 484, col: 48, offset: 13575},
+								pos: position{line: 506, col: 48, offset: 14399},
 								expr: &seqExpr{
-									pos: position{line: 484, col: 49, offset: 13576},
+									pos: position{line: 506, col: 49, offset: 14400},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 484, col: 49, offset: 13576},
+											pos:  position{line: 506, col: 49, offset: 14400},
 											name: "Annotation",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 484, col: 60, offset: 13587},
+											pos:  position{line: 506, col: 60, offset: 14411},
 											name: "_",
 										},
 									},
@@ -6241,24 +6664,25 @@ var g = &grammar{
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 485, col: 13, offset: 13603},
+							pos:        position{line: 507, col: 13, offset: 14427},
 							val:        "=",
 							ignoreCase: false,
+							want:       "\"=\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 485, col: 17, offset: 13607},
+							pos:  position{line: 507, col: 17, offset: 14431},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 485, col: 19, offset: 13609},
+							pos:   position{line: 507, col: 19, offset: 14433},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 485, col: 21, offset: 13611},
+								pos:  position{line: 507, col: 21, offset: 14435},
 								name: "Expression",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 485, col: 32, offset: 13622},
+							pos:  position{line: 507, col: 32, offset: 14446},
 							name: "_",
 						},
 					},
@@ -6267,651 +6691,1416 @@ var g = &grammar{
 		},
 		{
 			name: "Expression",
-			pos:  position{line: 500, col: 1, offset: 13931},
-			expr: &choiceExpr{
-				pos: position{line: 501, col: 7, offset: 13952},
-				alternatives: []interface{}{
-					&actionExpr{
-						pos: position{line: 501, col: 7, offset: 13952},
-						run: (*parser).callonExpression2,
-						expr: &seqExpr{
-							pos: position{line: 501, col: 7, offset: 13952},
-							exprs: []interface{}{
-								&charClassMatcher{
-									pos:        position{line: 291, col: 10, offset: 7729},
-									val:        "[\\\\λ]",
-									chars:      []rune{'\\', 'λ'},
-									ignoreCase: false,
-									inverted:   false,
-								},
-								&ruleRefExpr{
-									pos:  position{line: 501, col: 14, offset: 13959},
-									name: "_",
-								},
-								&litMatcher{
-									pos:        position{line: 501, col: 16, offset: 13961},
-									val:        "(",
-									ignoreCase: false,
-								},
-								&ruleRefExpr{
-									pos:  position{line: 501, col: 20, offset: 13965},
-									name: "_",
-								},
-								&labeledExpr{
-									pos:   position{line: 501, col: 22, offset: 13967},
-									label: "label",
-									expr: &choiceExpr{
-										pos: position{line: 124, col: 20, offset: 2920},
-										alternatives: []interface{}{
-											&actionExpr{
-												pos: position{line: 124, col: 20, offset: 2920},
-												run: (*parser).callonExpression10,
-												expr: &seqExpr{
-													pos: position{line: 124, col: 20, offset: 2920},
-													exprs: []interface{}{
-														&andExpr{
-															pos: position{line: 124, col: 20, offset: 2920},
-															expr: &seqExpr{
-																pos: position{line: 124, col: 22, offset: 2922},
-																exprs: []interface{}{
-																	&choiceExpr{
-																		pos: position{line: 221, col: 5, offset: 5657},
-																		alternatives: []interface{}{
-																			&actionExpr{
-																				pos: position{line: 221, col: 5, offset: 5657},
-																				run: (*parser).callonExpression15,
-																				expr: &litMatcher{
-																					pos:        position{line: 221, col: 5, offset: 5657},
-																					val:        "Natural/build",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 222, col: 5, offset: 5706},
-																				run: (*parser).callonExpression17,
-																				expr: &litMatcher{
-																					pos:        position{line: 222, col: 5, offset: 5706},
-																					val:        "Natural/fold",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 223, col: 5, offset: 5753},
-																				run: (*parser).callonExpression19,
-																				expr: &litMatcher{
-																					pos:        position{line: 223, col: 5, offset: 5753},
-																					val:        "Natural/isZero",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 224, col: 5, offset: 5804},
-																				run: (*parser).callonExpression21,
-																				expr: &litMatcher{
-																					pos:        position{line: 224, col: 5, offset: 5804},
-																					val:        "Natural/even",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 225, col: 5, offset: 5851},
-																				run: (*parser).callonExpression23,
-																				expr: &litMatcher{
-																					pos:        position{line: 225, col: 5, offset: 5851},
-																					val:        "Natural/odd",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 226, col: 5, offset: 5896},
-																				run: (*parser).callonExpression25,
-																				expr: &litMatcher{
-																					pos:        position{line: 226, col: 5, offset: 5896},
-																					val:        "Natural/toInteger",
-																					ignoreCase: false,
+			pos:  position{line: 522, col: 1, offset: 14755},
+			expr: &actionExpr{
+				pos: position{line: 522, col: 14, offset: 14770},
+				run: (*parser).callonExpression1,
+				expr: &seqExpr{
+					pos: position{line: 522, col: 14, offset: 14770},
+					exprs: []interface{}{
+						&andCodeExpr{
+							pos: position{line: 522, col: 14, offset: 14770},
+							run: (*parser).callonExpression3,
+						},
+						&labeledExpr{
+							pos:   position{line: 522, col: 42, offset: 14798},
+							label: "inner",
+							expr: &choiceExpr{
+								pos: position{line: 523, col: 9, offset: 14814},
+								alternatives: []interface{}{
+									&actionExpr{
+										pos: position{line: 523, col: 9, offset: 14814},
+										run: (*parser).callonExpression6,
+										expr: &seqExpr{
+											pos: position{line: 523, col: 9, offset: 14814},
+											exprs: []interface{}{
+												&charClassMatcher{
+													pos:        position{line: 292, col: 10, offset: 7868},
+													val:        "[\\\\λ]",
+													chars:      []rune{'\\', 'λ'},
+													ignoreCase: false,
+													inverted:   false,
+												},
+												&ruleRefExpr{
+													pos:  position{line: 523, col: 16, offset: 14821},
+													name: "_",
+												},
+												&litMatcher{
+													pos:        position{line: 523, col: 18, offset: 14823},
+													val:        "(",
+													ignoreCase: false,
+													want:       "\"(\"",
+												},
+												&ruleRefExpr{
+													pos:  position{line: 523, col: 22, offset: 14827},
+													name: "_",
+												},
+												&labeledExpr{
+													pos:   position{line: 523, col: 24, offset: 14829},
+													label: "label",
+													expr: &choiceExpr{
+														pos: position{line: 124, col: 20, offset: 2920},
+														alternatives: []interface{}{
+															&actionExpr{
+																pos: position{line: 124, col: 20, offset: 2920},
+																run: (*parser).callonExpression14,
+																expr: &seqExpr{
+																	pos: position{line: 124, col: 20, offset: 2920},
+																	exprs: []interface{}{
+																		&andExpr{
+																			pos: position{line: 124, col: 20, offset: 2920},
+																			expr: &seqExpr{
+																				pos: position{line: 124, col: 22, offset: 2922},
+																				exprs: []interface{}{
+																					&choiceExpr{
+																						pos: position{line: 221, col: 5, offset: 5657},
+																						alternatives: []interface{}{
+																							&actionExpr{
+																								pos: position{line: 221, col: 5, offset: 5657},
+																								run: (*parser).callonExpression19,
+																								expr: &litMatcher{
+																									pos:        position{line: 221, col: 5, offset: 5657},
+																									val:        "Natural/build",
+																									ignoreCase: false,
+																									want:       "\"Natural/build\"",
+																								},
+																							},
+																							&actionExpr{
+																								pos: position{line: 222, col: 5, offset: 5706},
+																								run: (*parser).callonExpression21,
+																								expr: &litMatcher{
+																									pos:        position{line: 222, col: 5, offset: 5706},
+																									val:        "Natural/fold",
+																									ignoreCase: false,
+																									want:       "\"Natural/fold\"",
+																								},
+																							},
+																							&actionExpr{
+																								pos: position{line: 223, col: 5, offset: 5753},
+																								run: (*parser).callonExpression23,
+																								expr: &litMatcher{
+																									pos:        position{line: 223, col: 5, offset: 5753},
+																									val:        "Natural/isZero",
+																									ignoreCase: false,
+																									want:       "\"Natural/isZero\"",
+																								},
+																							},
+																							&actionExpr{
+																								pos: position{line: 224, col: 5, offset: 5804},
+																								run: (*parser).callonExpression25,
+																								expr: &litMatcher{
+																									pos:        position{line: 224, col: 5, offset: 5804},
+																									val:        "Natural/even",
+																									ignoreCase: false,
+																									want:       "\"Natural/even\"",
+																								},
+																							},
+																							&actionExpr{
+																								pos: position{line: 225, col: 5, offset: 5851},
+																								run: (*parser).callonExpression27,
+																								expr: &litMatcher{
+																									pos:        position{line: 225, col: 5, offset: 5851},
+																									val:        "Natural/odd",
+																									ignoreCase: false,
+																									want:       "\"Natural/odd\"",
+																								},
+																							},
+																							&actionExpr{
+																								pos: position{line: 226, col: 5, offset: 5896},
+																								run: (*parser).callonExpression29,
+																								expr: &litMatcher{
+																									pos:        position{line: 226, col: 5, offset: 5896},
+																									val:        "Natural/toInteger",
+																									ignoreCase: false,
+																									want:       "\"Natural/toInteger\"",
+																								},
+																							},
+																							&actionExpr{
+																								pos: position{line: 227, col: 5, offset: 5953},
+																								run: (*parser).callonExpression31,
+																								expr: &litMatcher{
+																									pos:        position{line: 227, col: 5, offset: 5953},
+																									val:        "Natural/show",
+																									ignoreCase: false,
+																									want:       "\"Natural/show\"",
+																								},
+																							},
+																							&actionExpr{
+																								pos: position{line: 228, col: 5, offset: 6000},
+																								run: (*parser).callonExpression33,
+																								expr: &litMatcher{
+																									pos:        position{line: 228, col: 5, offset: 6000},
+																									val:        "Natural/subtract",
+																									ignoreCase: false,
+																									want:       "\"Natural/subtract\"",
+																								},
+																							},
+																							&actionExpr{
+																								pos: position{line: 229, col: 5, offset: 6055},
+																								run: (*parser).callonExpression35,
+																								expr: &litMatcher{
+																									pos:        position{line: 229, col: 5, offset: 6055},
+																									val:        "Integer/toDouble",
+																									ignoreCase: false,
+																									want:       "\"Integer/toDouble\"",
+																								},
+																							},
+																							&actionExpr{
+																								pos: position{line: 230, col: 5, offset: 6110},
+																								run: (*parser).callonExpression37,
+																								expr: &litMatcher{
+																									pos:        position{line: 230, col: 5, offset: 6110},
+																									val:        "Integer/show",
+																									ignoreCase: false,
+																									want:       "\"Integer/show\"",
+																								},
+																							},
+																							&actionExpr{
+																								pos: position{line: 231, col: 5, offset: 6157},
+																								run: (*parser).callonExpression39,
+																								expr: &litMatcher{
+																									pos:        position{line: 231, col: 5, offset: 6157},
+																									val:        "Double/show",
+																									ignoreCase: false,
+																									want:       "\"Double/show\"",
+																								},
+																							},
+																							&actionExpr{
+																								pos: position{line: 232, col: 5, offset: 6202},
+																								run: (*parser).callonExpression41,
+																								expr: &litMatcher{
+																									pos:        position{line: 232, col: 5, offset: 6202},
+																									val:        "List/build",
+																									ignoreCase: false,
+																									want:       "\"List/build\"",
+																								},
+																							},
+																							&actionExpr{
+																								pos: position{line: 233, col: 5, offset: 6245},
+																								run: (*parser).callonExpression43,
+																								expr: &litMatcher{
+																									pos:        position{line: 233, col: 5, offset: 6245},
+																									val:        "List/fold",
+																									ignoreCase: false,
+																									want:       "\"List/fold\"",
+																								},
+																							},
+																							&actionExpr{
+																								pos: position{line: 234, col: 5, offset: 6286},
+																								run: (*parser).callonExpression45,
+																								expr: &litMatcher{
+																									pos:        position{line: 234, col: 5, offset: 6286},
+																									val:        "List/length",
+																									ignoreCase: false,
+																									want:       "\"List/length\"",
+																								},
+																							},
+																							&actionExpr{
+																								pos: position{line: 235, col: 5, offset: 6331},
+																								run: (*parser).callonExpression47,
+																								expr: &litMatcher{
+																									pos:        position{line: 235, col: 5, offset: 6331},
+																									val:        "List/head",
+																									ignoreCase: false,
+																									want:       "\"List/head\"",
+																								},
+																							},
+																							&actionExpr{
+																								pos: position{line: 236, col: 5, offset: 6372},
+																								run: (*parser).callonExpression49,
+																								expr: &litMatcher{
+																									pos:        position{line: 236, col: 5, offset: 6372},
+																									val:        "List/last",
+																									ignoreCase: false,
+																									want:       "\"List/last\"",
+																								},
+																							},
+																							&actionExpr{
+																								pos: position{line: 237, col: 5, offset: 6413},
+																								run: (*parser).callonExpression51,
+																								expr: &litMatcher{
+																									pos:        position{line: 237, col: 5, offset: 6413},
+																									val:        "List/indexed",
+																									ignoreCase: false,
+																									want:       "\"List/indexed\"",
+																								},
+																							},
+																							&actionExpr{
+																								pos: position{line: 238, col: 5, offset: 6460},
+																								run: (*parser).callonExpression53,
+																								expr: &litMatcher{
+																									pos:        position{line: 238, col: 5, offset: 6460},
+																									val:        "List/reverse",
+																									ignoreCase: false,
+																									want:       "\"List/reverse\"",
+																								},
+																							},
+																							&actionExpr{
+																								pos: position{line: 239, col: 5, offset: 6507},
+																								run: (*parser).callonExpression55,
+																								expr: &litMatcher{
+																									pos:        position{line: 239, col: 5, offset: 6507},
+																									val:        "Optional/build",
+																									ignoreCase: false,
+																									want:       "\"Optional/build\"",
+																								},
+																							},
+																							&actionExpr{
+																								pos: position{line: 240, col: 5, offset: 6558},
+																								run: (*parser).callonExpression57,
+																								expr: &litMatcher{
+																									pos:        position{line: 240, col: 5, offset: 6558},
+																									val:        "Optional/fold",
+																									ignoreCase: false,
+																									want:       "\"Optional/fold\"",
+																								},
+																							},
+																							&actionExpr{
+																								pos: position{line: 241, col: 5, offset: 6607},
+																								run: (*parser).callonExpression59,
+																								expr: &litMatcher{
+																									pos:        position{line: 241, col: 5, offset: 6607},
+																									val:        "Text/show",
+																									ignoreCase: false,
+																									want:       "\"Text/show\"",
+																								},
+																							},
+																							&actionExpr{
+																								pos: position{line: 242, col: 5, offset: 6648},
+																								run: (*parser).callonExpression61,
+																								expr: &litMatcher{
+																									pos:        position{line: 242, col: 5, offset: 6648},
+																									val:        "Bool",
+																									ignoreCase: false,
+																									want:       "\"Bool\"",
+																								},
+																							},
+																							&actionExpr{
+																								pos: position{line: 243, col: 5, offset: 6680},
+																								run: (*parser).callonExpression63,
+																								expr: &litMatcher{
+																									pos:        position{line: 243, col: 5, offset: 6680},
+																									val:        "True",
+																									ignoreCase: false,
+																									want:       "\"True\"",
+																								},
+																							},
+																							&actionExpr{
+																								pos: position{line: 244, col: 5, offset: 6712},
+																								run: (*parser).callonExpression65,
+																								expr: &litMatcher{
+																									pos:        position{line: 244, col: 5, offset: 6712},
+																									val:        "False",
+																									ignoreCase: false,
+																									want:       "\"False\"",
+																								},
+																							},
+																							&actionExpr{
+																								pos: position{line: 245, col: 5, offset: 6746},
+																								run: (*parser).callonExpression67,
+																								expr: &litMatcher{
+																									pos:        position{line: 245, col: 5, offset: 6746},
+																									val:        "Optional",
+																									ignoreCase: false,
+																									want:       "\"Optional\"",
+																								},
+																							},
+																							&actionExpr{
+																								pos: position{line: 246, col: 5, offset: 6786},
+																								run: (*parser).callonExpression69,
+																								expr: &litMatcher{
+																									pos:        position{line: 246, col: 5, offset: 6786},
+																									val:        "Natural",
+																									ignoreCase: false,
+																									want:       "\"Natural\"",
+																								},
+																							},
+																							&actionExpr{
+																								pos: position{line: 247, col: 5, offset: 6824},
+																								run: (*parser).callonExpression71,
+																								expr: &litMatcher{
+																									pos:        position{line: 247, col: 5, offset: 6824},
+																									val:        "Integer",
+																									ignoreCase: false,
+																									want:       "\"Integer\"",
+																								},
+																							},
+																							&actionExpr{
+																								pos: position{line: 248, col: 5, offset: 6862},
+																								run: (*parser).callonExpression73,
+																								expr: &litMatcher{
+																									pos:        position{line: 248, col: 5, offset: 6862},
+																									val:        "Double",
+																									ignoreCase: false,
+																									want:       "\"Double\"",
+																								},
+																							},
+																							&actionExpr{
+																								pos: position{line: 249, col: 5, offset: 6898},
+																								run: (*parser).callonExpression75,
+																								expr: &litMatcher{
+																									pos:        position{line: 249, col: 5, offset: 6898},
+																									val:        "Text",
+																									ignoreCase: false,
+																									want:       "\"Text\"",
+																								},
+																							},
+																							&actionExpr{
+																								pos: position{line: 250, col: 5, offset: 6930},
+																								run: (*parser).callonExpression77,
+																								expr: &litMatcher{
+																									pos:        position{line: 250, col: 5, offset: 6930},
+																									val:        "List",
+																									ignoreCase: false,
+																									want:       "\"List\"",
+																								},
+																							},
+																							&actionExpr{
+																								pos: position{line: 251, col: 5, offset: 6962},
+																								run: (*parser).callonExpression79,
+																								expr: &litMatcher{
+																									pos:        position{line: 251, col: 5, offset: 6962},
+																									val:        "None",
+																									ignoreCase: false,
+																									want:       "\"None\"",
+																								},
+																							},
+																							&actionExpr{
+																								pos: position{line: 252, col: 5, offset: 6994},
+																								run: (*parser).callonExpression81,
+																								expr: &litMatcher{
+																									pos:        position{line: 252, col: 5, offset: 6994},
+																									val:        "Type",
+																									ignoreCase: false,
+																									want:       "\"Type\"",
+																								},
+																							},
+																							&actionExpr{
+																								pos: position{line: 253, col: 5, offset: 7026},
+																								run: (*parser).callonExpression83,
+																								expr: &litMatcher{
+																									pos:        position{line: 253, col: 5, offset: 7026},
+																									val:        "Kind",
+																									ignoreCase: false,
+																									want:       "\"Kind\"",
+																								},
+																							},
+																							&actionExpr{
+																								pos: position{line: 254, col: 5, offset: 7058},
+																								run: (*parser).callonExpression85,
+																								expr: &litMatcher{
+																									pos:        position{line: 254, col: 5, offset: 7058},
+																									val:        "Sort",
+																									ignoreCase: false,
+																									want:       "\"Sort\"",
+																								},
+																							},
+																						},
+																					},
+																					&charClassMatcher{
+																						pos:        position{line: 111, col: 23, offset: 2468},
+																						val:        "[_/-A-Za-z0-9]",
+																						chars:      []rune{'_', '/', '-'},
+																						ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
 																				},
 																			},
-																			&actionExpr{
-																				pos: position{line: 227, col: 5, offset: 5953},
-																				run: (*parser).callonExpression27,
-																				expr: &litMatcher{
-																					pos:        position{line: 227, col: 5, offset: 5953},
-																					val:        "Natural/show",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 228, col: 5, offset: 6000},
-																				run: (*parser).callonExpression29,
-																				expr: &litMatcher{
-																					pos:        position{line: 228, col: 5, offset: 6000},
-																					val:        "Natural/subtract",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 229, col: 5, offset: 6055},
-																				run: (*parser).callonExpression31,
-																				expr: &litMatcher{
-																					pos:        position{line: 229, col: 5, offset: 6055},
-																					val:        "Integer/toDouble",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 230, col: 5, offset: 6110},
-																				run: (*parser).callonExpression33,
-																				expr: &litMatcher{
-																					pos:        position{line: 230, col: 5, offset: 6110},
-																					val:        "Integer/show",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 231, col: 5, offset: 6157},
-																				run: (*parser).callonExpression35,
-																				expr: &litMatcher{
-																					pos:        position{line: 231, col: 5, offset: 6157},
-																					val:        "Double/show",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 232, col: 5, offset: 6202},
-																				run: (*parser).callonExpression37,
-																				expr: &litMatcher{
-																					pos:        position{line: 232, col: 5, offset: 6202},
-																					val:        "List/build",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 233, col: 5, offset: 6245},
-																				run: (*parser).callonExpression39,
-																				expr: &litMatcher{
-																					pos:        position{line: 233, col: 5, offset: 6245},
-																					val:        "List/fold",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 234, col: 5, offset: 6286},
-																				run: (*parser).callonExpression41,
-																				expr: &litMatcher{
-																					pos:        position{line: 234, col: 5, offset: 6286},
-																					val:        "List/length",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 235, col: 5, offset: 6331},
-																				run: (*parser).callonExpression43,
-																				expr: &litMatcher{
-																					pos:        position{line: 235, col: 5, offset: 6331},
-																					val:        "List/head",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 236, col: 5, offset: 6372},
-																				run: (*parser).callonExpression45,
-																				expr: &litMatcher{
-																					pos:        position{line: 236, col: 5, offset: 6372},
-																					val:        "List/last",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 237, col: 5, offset: 6413},
-																				run: (*parser).callonExpression47,
-																				expr: &litMatcher{
-																					pos:        position{line: 237, col: 5, offset: 6413},
-																					val:        "List/indexed",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 238, col: 5, offset: 6460},
-																				run: (*parser).callonExpression49,
-																				expr: &litMatcher{
-																					pos:        position{line: 238, col: 5, offset: 6460},
-																					val:        "List/reverse",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 239, col: 5, offset: 6507},
-																				run: (*parser).callonExpression51,
-																				expr: &litMatcher{
-																					pos:        position{line: 239, col: 5, offset: 6507},
-																					val:        "Optional/build",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 240, col: 5, offset: 6558},
-																				run: (*parser).callonExpression53,
-																				expr: &litMatcher{
-																					pos:        position{line: 240, col: 5, offset: 6558},
-																					val:        "Optional/fold",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 241, col: 5, offset: 6607},
-																				run: (*parser).callonExpression55,
-																				expr: &litMatcher{
-																					pos:        position{line: 241, col: 5, offset: 6607},
-																					val:        "Text/show",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 242, col: 5, offset: 6648},
-																				run: (*parser).callonExpression57,
-																				expr: &litMatcher{
-																					pos:        position{line: 242, col: 5, offset: 6648},
-																					val:        "Bool",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 243, col: 5, offset: 6680},
-																				run: (*parser).callonExpression59,
-																				expr: &litMatcher{
-																					pos:        position{line: 243, col: 5, offset: 6680},
-																					val:        "True",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 244, col: 5, offset: 6712},
-																				run: (*parser).callonExpression61,
-																				expr: &litMatcher{
-																					pos:        position{line: 244, col: 5, offset: 6712},
-																					val:        "False",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 245, col: 5, offset: 6746},
-																				run: (*parser).callonExpression63,
-																				expr: &litMatcher{
-																					pos:        position{line: 245, col: 5, offset: 6746},
-																					val:        "Optional",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 246, col: 5, offset: 6786},
-																				run: (*parser).callonExpression65,
-																				expr: &litMatcher{
-																					pos:        position{line: 246, col: 5, offset: 6786},
-																					val:        "Natural",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 247, col: 5, offset: 6824},
-																				run: (*parser).callonExpression67,
-																				expr: &litMatcher{
-																					pos:        position{line: 247, col: 5, offset: 6824},
-																					val:        "Integer",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 248, col: 5, offset: 6862},
-																				run: (*parser).callonExpression69,
-																				expr: &litMatcher{
-																					pos:        position{line: 248, col: 5, offset: 6862},
-																					val:        "Double",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 249, col: 5, offset: 6898},
-																				run: (*parser).callonExpression71,
-																				expr: &litMatcher{
-																					pos:        position{line: 249, col: 5, offset: 6898},
-																					val:        "Text",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 250, col: 5, offset: 6930},
-																				run: (*parser).callonExpression73,
-																				expr: &litMatcher{
-																					pos:        position{line: 250, col: 5, offset: 6930},
-																					val:        "List",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 251, col: 5, offset: 6962},
-																				run: (*parser).callonExpression75,
-																				expr: &litMatcher{
-																					pos:        position{line: 251, col: 5, offset: 6962},
-																					val:        "None",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 252, col: 5, offset: 6994},
-																				run: (*parser).callonExpression77,
-																				expr: &litMatcher{
-																					pos:        position{line: 252, col: 5, offset: 6994},
-																					val:        "Type",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 253, col: 5, offset: 7026},
-																				run: (*parser).callonExpression79,
-																				expr: &litMatcher{
-																					pos:        position{line: 253, col: 5, offset: 7026},
-																					val:        "Kind",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 254, col: 5, offset: 7058},
-																				run: (*parser).callonExpression81,
-																				expr: &litMatcher{
-																					pos:        position{line: 254, col: 5, offset: 7058},
-																					val:        "Sort",
-																					ignoreCase: false,
+																		},
+																		&labeledExpr{
+																			pos:   position{line: 124, col: 52, offset: 2952},
+																			label: "label",
+																			expr: &choiceExpr{
+																				pos: position{line: 121, col: 9, offset: 2802},
+																				alternatives: []interface{}{
+																					&actionExpr{
+																						pos: position{line: 121, col: 9, offset: 2802},
+																						run: (*parser).callonExpression90,
+																						expr: &seqExpr{
+																							pos: position{line: 121, col: 9, offset: 2802},
+																							exprs: []interface{}{
+																								&litMatcher{
+																									pos:        position{line: 121, col: 9, offset: 2802},
+																									val:        "`",
+																									ignoreCase: false,
+																									want:       "\"`\"",
+																								},
+																								&labeledExpr{
+																									pos:   position{line: 121, col: 13, offset: 2806},
+																									label: "label",
+																									expr: &actionExpr{
+																										pos: position{line: 119, col: 15, offset: 2743},
+																										run: (*parser).callonExpression94,
+																										expr: &oneOrMoreExpr{
+																											pos: position{line: 119, col: 15, offset: 2743},
+																											expr: &charClassMatcher{
+																												pos:        position{line: 118, col: 19, offset: 2706},
+																												val:        "[ -_a-~]",
+																												ranges:     []rune{' ', '_', 'a', '~'},
+																												ignoreCase: false,
+																												inverted:   false,
+																											},
+																										},
+																									},
+																								},
+																								&litMatcher{
+																									pos:        position{line: 121, col: 31, offset: 2824},
+																									val:        "`",
+																									ignoreCase: false,
+																									want:       "\"`\"",
+																								},
+																							},
+																						},
+																					},
+																					&actionExpr{
+																						pos: position{line: 122, col: 9, offset: 2858},
+																						run: (*parser).callonExpression98,
+																						expr: &labeledExpr{
+																							pos:   position{line: 122, col: 9, offset: 2858},
+																							label: "label",
+																							expr: &choiceExpr{
+																								pos: position{line: 112, col: 15, offset: 2499},
+																								alternatives: []interface{}{
+																									&actionExpr{
+																										pos: position{line: 112, col: 15, offset: 2499},
+																										run: (*parser).callonExpression101,
+																										expr: &seqExpr{
+																											pos: position{line: 112, col: 15, offset: 2499},
+																											exprs: []interface{}{
+																												&choiceExpr{
+																													pos: position{line: 276, col: 5, offset: 7537},
+																													alternatives: []interface{}{
+																														&litMatcher{
+																															pos:        position{line: 256, col: 6, offset: 7094},
+																															val:        "if",
+																															ignoreCase: false,
+																															want:       "\"if\"",
+																														},
+																														&litMatcher{
+																															pos:        position{line: 257, col: 8, offset: 7108},
+																															val:        "then",
+																															ignoreCase: false,
+																															want:       "\"then\"",
+																														},
+																														&litMatcher{
+																															pos:        position{line: 258, col: 8, offset: 7124},
+																															val:        "else",
+																															ignoreCase: false,
+																															want:       "\"else\"",
+																														},
+																														&litMatcher{
+																															pos:        position{line: 259, col: 7, offset: 7139},
+																															val:        "let",
+																															ignoreCase: false,
+																															want:       "\"let\"",
+																														},
+																														&litMatcher{
+																															pos:        position{line: 260, col: 6, offset: 7152},
+																															val:        "in",
+																															ignoreCase: false,
+																															want:       "\"in\"",
+																														},
+																														&litMatcher{
+																															pos:        position{line: 262, col: 9, offset: 7179},
+																															val:        "using",
+																															ignoreCase: false,
+																															want:       "\"using\"",
+																														},
+																														&actionExpr{
+																															pos: position{line: 264, col: 11, offset: 7217},
+																															run: (*parser).callonExpression110,
+																															expr: &litMatcher{
+																																pos:        position{line: 264, col: 11, offset: 7217},
+																																val:        "missing",
+																																ignoreCase: false,
+																																want:       "\"missing\"",
+																															},
+																														},
+																														&litMatcher{
+																															pos:        position{line: 271, col: 10, offset: 7370},
+																															val:        "assert",
+																															ignoreCase: false,
+																															want:       "\"assert\"",
+																														},
+																														&litMatcher{
+																															pos:        position{line: 261, col: 6, offset: 7164},
+																															val:        "as",
+																															ignoreCase: false,
+																															want:       "\"as\"",
+																														},
+																														&litMatcher{
+																															pos:        position{line: 265, col: 8, offset: 7262},
+																															val:        "True",
+																															ignoreCase: false,
+																															want:       "\"True\"",
+																														},
+																														&litMatcher{
+																															pos:        position{line: 266, col: 9, offset: 7279},
+																															val:        "False",
+																															ignoreCase: false,
+																															want:       "\"False\"",
+																														},
+																														&litMatcher{
+																															pos:        position{line: 267, col: 12, offset: 7300},
+																															val:        "Infinity",
+																															ignoreCase: false,
+																															want:       "\"Infinity\"",
+																														},
+																														&litMatcher{
+																															pos:        position{line: 268, col: 7, offset: 7319},
+																															val:        "NaN",
+																															ignoreCase: false,
+																															want:       "\"NaN\"",
+																														},
+																														&litMatcher{
+																															pos:        position{line: 263, col: 9, offset: 7197},
+																															val:        "merge",
+																															ignoreCase: false,
+																															want:       "\"merge\"",
+																														},
+																														&litMatcher{
+																															pos:        position{line: 269, col: 8, offset: 7334},
+																															val:        "Some",
+																															ignoreCase: false,
+																															want:       "\"Some\"",
+																														},
+																														&litMatcher{
+																															pos:        position{line: 270, col: 9, offset: 7351},
+																															val:        "toMap",
+																															ignoreCase: false,
+																															want:       "\"toMap\"",
+																														},
+																													},
+																												},
+																												&oneOrMoreExpr{
+																													pos: position{line: 112, col: 23, offset: 2507},
+																													expr: &charClassMatcher{
+																														pos:        position{line: 111, col: 23, offset: 2468},
+																														val:        "[_/-A-Za-z0-9]",
+																														chars:      []rune{'_', '/', '-'},
+																														ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
+																														ignoreCase: false,
+																														inverted:   false,
+																													},
+																												},
+																											},
+																										},
+																									},
+																									&actionExpr{
+																										pos: position{line: 113, col: 13, offset: 2571},
+																										run: (*parser).callonExpression123,
+																										expr: &seqExpr{
+																											pos: position{line: 113, col: 13, offset: 2571},
+																											exprs: []interface{}{
+																												&notExpr{
+																													pos: position{line: 113, col: 13, offset: 2571},
+																													expr: &choiceExpr{
+																														pos: position{line: 276, col: 5, offset: 7537},
+																														alternatives: []interface{}{
+																															&litMatcher{
+																																pos:        position{line: 256, col: 6, offset: 7094},
+																																val:        "if",
+																																ignoreCase: false,
+																																want:       "\"if\"",
+																															},
+																															&litMatcher{
+																																pos:        position{line: 257, col: 8, offset: 7108},
+																																val:        "then",
+																																ignoreCase: false,
+																																want:       "\"then\"",
+																															},
+																															&litMatcher{
+																																pos:        position{line: 258, col: 8, offset: 7124},
+																																val:        "else",
+																																ignoreCase: false,
+																																want:       "\"else\"",
+																															},
+																															&litMatcher{
+																																pos:        position{line: 259, col: 7, offset: 7139},
+																																val:        "let",
+																																ignoreCase: false,
+																																want:       "\"let\"",
+																															},
+																															&litMatcher{
+																																pos:        position{line: 260, col: 6, offset: 7152},
+																																val:        "in",
+																																ignoreCase: false,
+																																want:       "\"in\"",
+																															},
+																															&litMatcher{
+																																pos:        position{line: 262, col: 9, offset: 7179},
+																																val:        "using",
+																																ignoreCase: false,
+																																want:       "\"using\"",
+																															},
+																															&actionExpr{
+																																pos: position{line: 264, col: 11, offset: 7217},
+																																run: (*parser).callonExpression133,
+																																expr: &litMatcher{
+																																	pos:        position{line: 264, col: 11, offset: 7217},
+																																	val:        "missing",
+																																	ignoreCase: false,
+																																	want:       "\"missing\"",
+																																},
+																															},
+																															&litMatcher{
+																																pos:        position{line: 271, col: 10, offset: 7370},
+																																val:        "assert",
+																																ignoreCase: false,
+																																want:       "\"assert\"",
+																															},
+																															&litMatcher{
+																																pos:        position{line: 261, col: 6, offset: 7164},
+																																val:        "as",
+																																ignoreCase: false,
+																																want:       "\"as\"",
+																															},
+																															&litMatcher{
+																																pos:        position{line: 265, col: 8, offset: 7262},
+																																val:        "True",
+																																ignoreCase: false,
+																																want:       "\"True\"",
+																															},
+																															&litMatcher{
+																																pos:        position{line: 266, col: 9, offset: 7279},
+																																val:        "False",
+																																ignoreCase: false,
+																																want:       "\"False\"",
+																															},
+																															&litMatcher{
+																																pos:        position{line: 267, col: 12, offset: 7300},
+																																val:        "Infinity",
+																																ignoreCase: false,
+																																want:       "\"Infinity\"",
+																															},
+																															&litMatcher{
+																																pos:        position{line: 268, col: 7, offset: 7319},
+																																val:        "NaN",
+																																ignoreCase: false,
+																																want:       "\"NaN\"",
+																															},
+																															&litMatcher{
+																																pos:        position{line: 263, col: 9, offset: 7197},
+																																val:        "merge",
+																																ignoreCase: false,
+																																want:       "\"merge\"",
+																															},
+																															&litMatcher{
+																																pos:        position{line: 269, col: 8, offset: 7334},
+																																val:        "Some",
+																																ignoreCase: false,
+																																want:       "\"Some\"",
+																															},
+																															&litMatcher{
+																																pos:        position{line: 270, col: 9, offset: 7351},
+																																val:        "toMap",
+																																ignoreCase: false,
+																																want:       "\"toMap\"",
+																															},
+																														},
+																													},
+																												},
+																												&charClassMatcher{
+																													pos:        position{line: 110, col: 24, offset: 2434},
+																													val:        "[_A-Za-z]",
+																													chars:      []rune{'_'},
+																													ranges:     []rune{'A', 'Z', 'a', 'z'},
+																													ignoreCase: false,
+																													inverted:   false,
+																												},
+																												&zeroOrMoreExpr{
+																													pos: position{line: 113, col: 43, offset: 2601},
+																													expr: &charClassMatcher{
+																														pos:        position{line: 111, col: 23, offset: 2468},
+																														val:        "[_/-A-Za-z0-9]",
+																														chars:      []rune{'_', '/', '-'},
+																														ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
+																														ignoreCase: false,
+																														inverted:   false,
+																													},
+																												},
+																											},
+																										},
+																									},
+																								},
+																							},
+																						},
+																					},
 																				},
 																			},
 																		},
 																	},
-																	&charClassMatcher{
-																		pos:        position{line: 111, col: 23, offset: 2468},
-																		val:        "[_/-A-Za-z0-9]",
-																		chars:      []rune{'_', '/', '-'},
-																		ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
-																		ignoreCase: false,
-																		inverted:   false,
-																	},
 																},
 															},
-														},
-														&labeledExpr{
-															pos:   position{line: 124, col: 52, offset: 2952},
-															label: "label",
-															expr: &choiceExpr{
-																pos: position{line: 121, col: 9, offset: 2802},
-																alternatives: []interface{}{
-																	&actionExpr{
-																		pos: position{line: 121, col: 9, offset: 2802},
-																		run: (*parser).callonExpression86,
-																		expr: &seqExpr{
-																			pos: position{line: 121, col: 9, offset: 2802},
-																			exprs: []interface{}{
-																				&litMatcher{
-																					pos:        position{line: 121, col: 9, offset: 2802},
-																					val:        "`",
-																					ignoreCase: false,
-																				},
-																				&labeledExpr{
-																					pos:   position{line: 121, col: 13, offset: 2806},
-																					label: "label",
-																					expr: &actionExpr{
-																						pos: position{line: 119, col: 15, offset: 2743},
-																						run: (*parser).callonExpression90,
-																						expr: &oneOrMoreExpr{
-																							pos: position{line: 119, col: 15, offset: 2743},
-																							expr: &charClassMatcher{
-																								pos:        position{line: 118, col: 19, offset: 2706},
-																								val:        "[ -_a-~]",
-																								ranges:     []rune{' ', '_', 'a', '~'},
-																								ignoreCase: false,
-																								inverted:   false,
-																							},
-																						},
-																					},
-																				},
-																				&litMatcher{
-																					pos:        position{line: 121, col: 31, offset: 2824},
-																					val:        "`",
-																					ignoreCase: false,
-																				},
-																			},
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 122, col: 9, offset: 2858},
-																		run: (*parser).callonExpression94,
-																		expr: &labeledExpr{
-																			pos:   position{line: 122, col: 9, offset: 2858},
-																			label: "label",
+															&actionExpr{
+																pos: position{line: 125, col: 19, offset: 3004},
+																run: (*parser).callonExpression147,
+																expr: &seqExpr{
+																	pos: position{line: 125, col: 19, offset: 3004},
+																	exprs: []interface{}{
+																		&notExpr{
+																			pos: position{line: 125, col: 19, offset: 3004},
 																			expr: &choiceExpr{
-																				pos: position{line: 112, col: 15, offset: 2499},
+																				pos: position{line: 221, col: 5, offset: 5657},
 																				alternatives: []interface{}{
 																					&actionExpr{
-																						pos: position{line: 112, col: 15, offset: 2499},
-																						run: (*parser).callonExpression97,
-																						expr: &seqExpr{
-																							pos: position{line: 112, col: 15, offset: 2499},
-																							exprs: []interface{}{
-																								&choiceExpr{
-																									pos: position{line: 274, col: 5, offset: 7396},
-																									alternatives: []interface{}{
-																										&litMatcher{
-																											pos:        position{line: 256, col: 6, offset: 7094},
-																											val:        "if",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 257, col: 8, offset: 7108},
-																											val:        "then",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 258, col: 8, offset: 7124},
-																											val:        "else",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 259, col: 7, offset: 7139},
-																											val:        "let",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 260, col: 6, offset: 7152},
-																											val:        "in",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 262, col: 9, offset: 7179},
-																											val:        "using",
-																											ignoreCase: false,
-																										},
-																										&actionExpr{
-																											pos: position{line: 264, col: 11, offset: 7217},
-																											run: (*parser).callonExpression106,
-																											expr: &litMatcher{
-																												pos:        position{line: 264, col: 11, offset: 7217},
-																												val:        "missing",
-																												ignoreCase: false,
-																											},
-																										},
-																										&litMatcher{
-																											pos:        position{line: 261, col: 6, offset: 7164},
-																											val:        "as",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 265, col: 8, offset: 7262},
-																											val:        "True",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 266, col: 9, offset: 7279},
-																											val:        "False",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 267, col: 12, offset: 7300},
-																											val:        "Infinity",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 268, col: 7, offset: 7319},
-																											val:        "NaN",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 263, col: 9, offset: 7197},
-																											val:        "merge",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 269, col: 8, offset: 7334},
-																											val:        "Some",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 270, col: 9, offset: 7351},
-																											val:        "toMap",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 271, col: 10, offset: 7370},
-																											val:        "assert",
-																											ignoreCase: false,
-																										},
-																									},
-																								},
-																								&oneOrMoreExpr{
-																									pos: position{line: 112, col: 23, offset: 2507},
-																									expr: &charClassMatcher{
-																										pos:        position{line: 111, col: 23, offset: 2468},
-																										val:        "[_/-A-Za-z0-9]",
-																										chars:      []rune{'_', '/', '-'},
-																										ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
-																										ignoreCase: false,
-																										inverted:   false,
-																									},
-																								},
-																							},
+																						pos: position{line: 221, col: 5, offset: 5657},
+																						run: (*parser).callonExpression151,
+																						expr: &litMatcher{
+																							pos:        position{line: 221, col: 5, offset: 5657},
+																							val:        "Natural/build",
+																							ignoreCase: false,
+																							want:       "\"Natural/build\"",
 																						},
 																					},
 																					&actionExpr{
-																						pos: position{line: 113, col: 13, offset: 2571},
-																						run: (*parser).callonExpression119,
-																						expr: &seqExpr{
-																							pos: position{line: 113, col: 13, offset: 2571},
-																							exprs: []interface{}{
-																								&notExpr{
-																									pos: position{line: 113, col: 13, offset: 2571},
-																									expr: &choiceExpr{
-																										pos: position{line: 274, col: 5, offset: 7396},
-																										alternatives: []interface{}{
-																											&litMatcher{
-																												pos:        position{line: 256, col: 6, offset: 7094},
-																												val:        "if",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 257, col: 8, offset: 7108},
-																												val:        "then",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 258, col: 8, offset: 7124},
-																												val:        "else",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 259, col: 7, offset: 7139},
-																												val:        "let",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 260, col: 6, offset: 7152},
-																												val:        "in",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 262, col: 9, offset: 7179},
-																												val:        "using",
-																												ignoreCase: false,
-																											},
-																											&actionExpr{
-																												pos: position{line: 264, col: 11, offset: 7217},
-																												run: (*parser).callonExpression129,
-																												expr: &litMatcher{
-																													pos:        position{line: 264, col: 11, offset: 7217},
-																													val:        "missing",
-																													ignoreCase: false,
-																												},
-																											},
-																											&litMatcher{
-																												pos:        position{line: 261, col: 6, offset: 7164},
-																												val:        "as",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 265, col: 8, offset: 7262},
-																												val:        "True",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 266, col: 9, offset: 7279},
-																												val:        "False",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 267, col: 12, offset: 7300},
-																												val:        "Infinity",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 268, col: 7, offset: 7319},
-																												val:        "NaN",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 263, col: 9, offset: 7197},
-																												val:        "merge",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 269, col: 8, offset: 7334},
-																												val:        "Some",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 270, col: 9, offset: 7351},
-																												val:        "toMap",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 271, col: 10, offset: 7370},
-																												val:        "assert",
-																												ignoreCase: false,
-																											},
-																										},
+																						pos: position{line: 222, col: 5, offset: 5706},
+																						run: (*parser).callonExpression153,
+																						expr: &litMatcher{
+																							pos:        position{line: 222, col: 5, offset: 5706},
+																							val:        "Natural/fold",
+																							ignoreCase: false,
+																							want:       "\"Natural/fold\"",
+																						},
+																					},
+																					&actionExpr{
+																						pos: position{line: 223, col: 5, offset: 5753},
+																						run: (*parser).callonExpression155,
+																						expr: &litMatcher{
+																							pos:        position{line: 223, col: 5, offset: 5753},
+																							val:        "Natural/isZero",
+																							ignoreCase: false,
+																							want:       "\"Natural/isZero\"",
+																						},
+																					},
+																					&actionExpr{
+																						pos: position{line: 224, col: 5, offset: 5804},
+																						run: (*parser).callonExpression157,
+																						expr: &litMatcher{
+																							pos:        position{line: 224, col: 5, offset: 5804},
+																							val:        "Natural/even",
+																							ignoreCase: false,
+																							want:       "\"Natural/even\"",
+																						},
+																					},
+																					&actionExpr{
+																						pos: position{line: 225, col: 5, offset: 5851},
+																						run: (*parser).callonExpression159,
+																						expr: &litMatcher{
+																							pos:        position{line: 225, col: 5, offset: 5851},
+																							val:        "Natural/odd",
+																							ignoreCase: false,
+																							want:       "\"Natural/odd\"",
+																						},
+																					},
+																					&actionExpr{
+																						pos: position{line: 226, col: 5, offset: 5896},
+																						run: (*parser).callonExpression161,
+																						expr: &litMatcher{
+																							pos:        position{line: 226, col: 5, offset: 5896},
+																							val:        "Natural/toInteger",
+																							ignoreCase: false,
+																							want:       "\"Natural/toInteger\"",
+																						},
+																					},
+																					&actionExpr{
+																						pos: position{line: 227, col: 5, offset: 5953},
+																						run: (*parser).callonExpression163,
+																						expr: &litMatcher{
+																							pos:        position{line: 227, col: 5, offset: 5953},
+																							val:        "Natural/show",
+																							ignoreCase: false,
+																							want:       "\"Natural/show\"",
+																						},
+																					},
+																					&actionExpr{
+																						pos: position{line: 228, col: 5, offset: 6000},
+																						run: (*parser).callonExpression165,
+																						expr: &litMatcher{
+																							pos:        position{line: 228, col: 5, offset: 6000},
+																							val:        "Natural/subtract",
+																							ignoreCase: false,
+																							want:       "\"Natural/subtract\"",
+																						},
+																					},
+																					&actionExpr{
+																						pos: position{line: 229, col: 5, offset: 6055},
+																						run: (*parser).callonExpression167,
+																						expr: &litMatcher{
+																							pos:        position{line: 229, col: 5, offset: 6055},
+																							val:        "Integer/toDouble",
+																							ignoreCase: false,
+																							want:       "\"Integer/toDouble\"",
+																						},
+																					},
+																					&actionExpr{
+																						pos: position{line: 230, col: 5, offset: 6110},
+																						run: (*parser).callonExpression169,
+																						expr: &litMatcher{
+																							pos:        position{line: 230, col: 5, offset: 6110},
+																							val:        "Integer/show",
+																							ignoreCase: false,
+																							want:       "\"Integer/show\"",
+																						},
+																					},
+																					&actionExpr{
+																						pos: position{line: 231, col: 5, offset: 6157},
+																						run: (*parser).callonExpression171,
+																						expr: &litMatcher{
+																							pos:        position{line: 231, col: 5, offset: 6157},
+																							val:        "Double/show",
+																							ignoreCase: false,
+																							want:       "\"Double/show\"",
+																						},
+																					},
+																					&actionExpr{
+																						pos: position{line: 232, col: 5, offset: 6202},
+																						run: (*parser).callonExpression173,
+																						expr: &litMatcher{
+																							pos:        position{line: 232, col: 5, offset: 6202},
+																							val:        "List/build",
+																							ignoreCase: false,
+																							want:       "\"List/build\"",
+																						},
+																					},
+																					&actionExpr{
+																						pos: position{line: 233, col: 5, offset: 6245},
+																						run: (*parser).callonExpression175,
+																						expr: &litMatcher{
+																							pos:        position{line: 233, col: 5, offset: 6245},
+																							val:        "List/fold",
+																							ignoreCase: false,
+																							want:       "\"List/fold\"",
+																						},
+																					},
+																					&actionExpr{
+																						pos: position{line: 234, col: 5, offset: 6286},
+																						run: (*parser).callonExpression177,
+																						expr: &litMatcher{
+																							pos:        position{line: 234, col: 5, offset: 6286},
+																							val:        "List/length",
+																							ignoreCase: false,
+																							want:       "\"List/length\"",
+																						},
+																					},
+																					&actionExpr{
+																						pos: position{line: 235, col: 5, offset: 6331},
+																						run: (*parser).callonExpression179,
+																						expr: &litMatcher{
+																							pos:        position{line: 235, col: 5, offset: 6331},
+																							val:        "List/head",
+																							ignoreCase: false,
+																							want:       "\"List/head\"",
+																						},
+																					},
+																					&actionExpr{
+																						pos: position{line: 236, col: 5, offset: 6372},
+																						run: (*parser).callonExpression181,
+																						expr: &litMatcher{
+																							pos:        position{line: 236, col: 5, offset: 6372},
+																							val:        "List/last",
+																							ignoreCase: false,
+																							want:       "\"List/last\"",
+																						},
+																					},
+																					&actionExpr{
+																						pos: position{line: 237, col: 5, offset: 6413},
+																						run: (*parser).callonExpression183,
+																						expr: &litMatcher{
+																							pos:        position{line: 237, col: 5, offset: 6413},
+																							val:        "List/indexed",
+																							ignoreCase: false,
+																							want:       "\"List/indexed\"",
+																						},
+																					},
+																					&actionExpr{
+																						pos: position{line: 238, col: 5, offset: 6460},
+																						run: (*parser).callonExpression185,
+																						expr: &litMatcher{
+																							pos:        position{line: 238, col: 5, offset: 6460},
+																							val:        "List/reverse",
+																							ignoreCase: false,
+																							want:       "\"List/reverse\"",
+																						},
+																					},
+																					&actionExpr{
+																						pos: position{line: 239, col: 5, offset: 6507},
+																						run: (*parser).callonExpression187,
+																						expr: &litMatcher{
+																							pos:        position{line: 239, col: 5, offset: 6507},
+																							val:        "Optional/build",
+																							ignoreCase: false,
+																							want:       "\"Optional/build\"",
+																						},
+																					},
+																					&actionExpr{
+																						pos: position{line: 240, col: 5, offset: 6558},
+																						run: (*parser).callonExpression189,
+																						expr: &litMatcher{
+																							pos:        position{line: 240, col: 5, offset: 6558},
+																							val:        "Optional/fold",
+																							ignoreCase: false,
+																							want:       "\"Optional/fold\"",
+																						},
+																					},
+																					&actionExpr{
+																						pos: position{line: 241, col: 5, offset: 6607},
+																						run: (*parser).callonExpression191,
+																						expr: &litMatcher{
+																							pos:        position{line: 241, col: 5, offset: 6607},
+																							val:        "Text/show",
+																							ignoreCase: false,
+																							want:       "\"Text/show\"",
+																						},
+																					},
+																					&actionExpr{
+																						pos: position{line: 242, col: 5, offset: 6648},
+																						run: (*parser).callonExpression193,
+																						expr: &litMatcher{
+																							pos:        position{line: 242, col: 5, offset: 6648},
+																							val:        "Bool",
+																							ignoreCase: false,
+																							want:       "\"Bool\"",
+																						},
+																					},
+																					&actionExpr{
+																						pos: position{line: 243, col: 5, offset: 6680},
+																						run: (*parser).callonExpression195,
+																						expr: &litMatcher{
+																							pos:        position{line: 243, col: 5, offset: 6680},
+																							val:        "True",
+																							ignoreCase: false,
+																							want:       "\"True\"",
+																						},
+																					},
+																					&actionExpr{
+																						pos: position{line: 244, col: 5, offset: 6712},
+																						run: (*parser).callonExpression197,
+																						expr: &litMatcher{
+																							pos:        position{line: 244, col: 5, offset: 6712},
+																							val:        "False",
+																							ignoreCase: false,
+																							want:       "\"False\"",
+																						},
+																					},
+																					&actionExpr{
+																						pos: position{line: 245, col: 5, offset: 6746},
+																						run: (*parser).callonExpression199,
+																						expr: &litMatcher{
+																							pos:        position{line: 245, col: 5, offset: 6746},
+																							val:        "Optional",
+																							ignoreCase: false,
+																							want:       "\"Optional\"",
+																						},
+																					},
+																					&actionExpr{
+																						pos: position{line: 246, col: 5, offset: 6786},
+																						run: (*parser).callonExpression201,
+																						expr: &litMatcher{
+																							pos:        position{line: 246, col: 5, offset: 6786},
+																							val:        "Natural",
+																							ignoreCase: false,
+																							want:       "\"Natural\"",
+																						},
+																					},
+																					&actionExpr{
+																						pos: position{line: 247, col: 5, offset: 6824},
+																						run: (*parser).callonExpression203,
+																						expr: &litMatcher{
+																							pos:        position{line: 247, col: 5, offset: 6824},
+																							val:        "Integer",
+																							ignoreCase: false,
+																							want:       "\"Integer\"",
+																						},
+																					},
+																					&actionExpr{
+																						pos: position{line: 248, col: 5, offset: 6862},
+																						run: (*parser).callonExpression205,
+																						expr: &litMatcher{
+																							pos:        position{line: 248, col: 5, offset: 6862},
+																							val:        "Double",
+																							ignoreCase: false,
+																							want:       "\"Double\"",
+																						},
+																					},
+																					&actionExpr{
+																						pos: position{line: 249, col: 5, offset: 6898},
+																						run: (*parser).callonExpression207,
+																						expr: &litMatcher{
+																							pos:        position{line: 249, col: 5, offset: 6898},
+																							val:        "Text",
+																							ignoreCase: false,
+																							want:       "\"Text\"",
+																						},
+																					},
+																					&actionExpr{
+																						pos: position{line: 250, col: 5, offset: 6930},
+																						run: (*parser).callonExpression209,
+																						expr: &litMatcher{
+																							pos:        position{line: 250, col: 5, offset: 6930},
+																							val:        "List",
+																							ignoreCase: false,
+																							want:       "\"List\"",
+																						},
+																					},
+																					&actionExpr{
+																						pos: position{line: 251, col: 5, offset: 6962},
+																						run: (*parser).callonExpression211,
+																						expr: &litMatcher{
+																							pos:        position{line: 251, col: 5, offset: 6962},
+																							val:        "None",
+																							ignoreCase: false,
+																							want:       "\"None\"",
+																						},
+																					},
+																					&actionExpr{
+																						pos: position{line: 252, col: 5, offset: 6994},
+																						run: (*parser).callonExpression213,
+																						expr: &litMatcher{
+																							pos:        position{line: 252, col: 5, offset: 6994},
+																							val:        "Type",
+																							ignoreCase: false,
+																							want:       "\"Type\"",
+																						},
+																					},
+																					&actionExpr{
+																						pos: position{line: 253, col: 5, offset: 7026},
+																						run: (*parser).callonExpression215,
+																						expr: &litMatcher{
+																							pos:        position{line: 253, col: 5, offset: 7026},
+																							val:        "Kind",
+																							ignoreCase: false,
+																							want:       "\"Kind\"",
+																						},
+																					},
+																					&actionExpr{
+																						pos: position{line: 254, col: 5, offset: 7058},
+																						run: (*parser).callonExpression217,
+																						expr: &litMatcher{
+																							pos:        position{line: 254, col: 5, offset: 7058},
+																							val:        "Sort",
+																							ignoreCase: false,
+																							want:       "\"Sort\"",
+																						},
+																					},
+																				},
+																			},
+																		},
+																		&labeledExpr{
+																			pos:   position{line: 125, col: 29, offset: 3014},
+																			label: "label",
+																			expr: &choiceExpr{
+																				pos: position{line: 121, col: 9, offset: 2802},
+																				alternatives: []interface{}{
+																					&actionExpr{
+																						pos: position{line: 121, col: 9, offset: 2802},
+																						run: (*parser).callonExpression221,
+																						expr: &seqExpr{
+																							pos: position{line: 121, col: 9, offset: 2802},
+																							exprs: []interface{}{
+																								&litMatcher{
+																									pos:        position{line: 121, col: 9, offset: 2802},
+																									val:        "`",
+																									ignoreCase: false,
+																									want:       "\"`\"",
+																								},
+																								&labeledExpr{
+																									pos:   position{line: 121, col: 13, offset: 2806},
+																									label: "label",
+																									expr: &actionExpr{
+																										pos: position{line: 119, col: 15, offset: 2743},
+																										run: (*parser).callonExpression225,
+																										expr: &oneOrMoreExpr{
+																											pos: position{line: 119, col: 15, offset: 2743},
+																											expr: &charClassMatcher{
+																												pos:        position{line: 118, col: 19, offset: 2706},
+																												val:        "[ -_a-~]",
+																												ranges:     []rune{' ', '_', 'a', '~'},
+																												ignoreCase: false,
+																												inverted:   false,
+																											},
+																										},
 																									},
 																								},
-																								&charClassMatcher{
-																									pos:        position{line: 110, col: 24, offset: 2434},
-																									val:        "[_A-Za-z]",
-																									chars:      []rune{'_'},
-																									ranges:     []rune{'A', 'Z', 'a', 'z'},
+																								&litMatcher{
+																									pos:        position{line: 121, col: 31, offset: 2824},
+																									val:        "`",
 																									ignoreCase: false,
-																									inverted:   false,
+																									want:       "\"`\"",
 																								},
-																								&zeroOrMoreExpr{
-																									pos: position{line: 113, col: 43, offset: 2601},
-																									expr: &charClassMatcher{
-																										pos:        position{line: 111, col: 23, offset: 2468},
-																										val:        "[_/-A-Za-z0-9]",
-																										chars:      []rune{'_', '/', '-'},
-																										ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
-																										ignoreCase: false,
-																										inverted:   false,
+																							},
+																						},
+																					},
+																					&actionExpr{
+																						pos: position{line: 122, col: 9, offset: 2858},
+																						run: (*parser).callonExpression229,
+																						expr: &labeledExpr{
+																							pos:   position{line: 122, col: 9, offset: 2858},
+																							label: "label",
+																							expr: &choiceExpr{
+																								pos: position{line: 112, col: 15, offset: 2499},
+																								alternatives: []interface{}{
+																									&actionExpr{
+																										pos: position{line: 112, col: 15, offset: 2499},
+																										run: (*parser).callonExpression232,
+																										expr: &seqExpr{
+																											pos: position{line: 112, col: 15, offset: 2499},
+																											exprs: []interface{}{
+																												&choiceExpr{
+																													pos: position{line: 276, col: 5, offset: 7537},
+																													alternatives: []interface{}{
+																														&litMatcher{
+																															pos:        position{line: 256, col: 6, offset: 7094},
+																															val:        "if",
+																															ignoreCase: false,
+																															want:       "\"if\"",
+																														},
+																														&litMatcher{
+																															pos:        position{line: 257, col: 8, offset: 7108},
+																															val:        "then",
+																															ignoreCase: false,
+																															want:       "\"then\"",
+																														},
+																														&litMatcher{
+																															pos:        position{line: 258, col: 8, offset: 7124},
+																															val:        "else",
+																															ignoreCase: false,
+																															want:       "\"else\"",
+																														},
+																														&litMatcher{
+																															pos:        position{line: 259, col: 7, offset: 7139},
+																															val:        "let",
+																															ignoreCase: false,
+																															want:       "\"let\"",
+																														},
+																														&litMatcher{
+																															pos:        position{line: 260, col: 6, offset: 7152},
+																															val:        "in",
+																															ignoreCase: false,
+																															want:       "\"in\"",
+																														},
+																														&litMatcher{
+																															pos:        position{line: 262, col: 9, offset: 7179},
+																															val:        "using",
+																															ignoreCase: false,
+																															want:       "\"using\"",
+																														},
+																														&actionExpr{
+																															pos: position{line: 264, col: 11, offset: 7217},
+																															run: (*parser).callonExpression241,
+																															expr: &litMatcher{
+																																pos:        position{line: 264, col: 11, offset: 7217},
+																																val:        "missing",
+																																ignoreCase: false,
+																																want:       "\"missing\"",
+																															},
+																														},
+																														&litMatcher{
+																															pos:        position{line: 271, col: 10, offset: 7370},
+																															val:        "assert",
+																															ignoreCase: false,
+																															want:       "\"assert\"",
+																														},
+																														&litMatcher{
+																															pos:        position{line: 261, col: 6, offset: 7164},
+																															val:        "as",
+																															ignoreCase: false,
+																															want:       "\"as\"",
+																														},
+																														&litMatcher{
+																															pos:        position{line: 265, col: 8, offset: 7262},
+																															val:        "True",
+																															ignoreCase: false,
+																															want:       "\"True\"",
+																														},
+																														&litMatcher{
+																															pos:        position{line: 266, col: 9, offset: 7279},
+																															val:        "False",
+																															ignoreCase: false,
+																															want:       "\"False\"",
+																														},
+																														&litMatcher{
+																															pos:        position{line: 267, col: 12, offset: 7300},
+																															val:        "Infinity",
+																															ignoreCase: false,
+																															want:       "\"Infinity\"",
+																														},
+																														&litMatcher{
+																															pos:        position{line: 268, col: 7, offset: 7319},
+																															val:        "NaN",
+																															ignoreCase: false,
+																															want:       "\"NaN\"",
+																														},
+																														&litMatcher{
+																															pos:        position{line: 263, col: 9, offset: 7197},
+																															val:        "merge",
+																															ignoreCase: false,
+																															want:       "\"merge\"",
+																														},
+																														&litMatcher{
+																															pos:        position{line: 269, col: 8, offset: 7334},
+																															val:        "Some",
+																															ignoreCase: false,
+																															want:       "\"Some\"",
+																														},
+																														&litMatcher{
+																															pos:        position{line: 270, col: 9, offset: 7351},
+																															val:        "toMap",
+																															ignoreCase: false,
+																															want:       "\"toMap\"",
+																														},
+																													},
+																												},
+																												&oneOrMoreExpr{
+																													pos: position{line: 112, col: 23, offset: 2507},
+																													expr: &charClassMatcher{
+																														pos:        position{line: 111, col: 23, offset: 2468},
+																														val:        "[_/-A-Za-z0-9]",
+																														chars:      []rune{'_', '/', '-'},
+																														ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
+																														ignoreCase: false,
+																														inverted:   false,
+																													},
+																												},
+																											},
+																										},
+																									},
+																									&actionExpr{
+																										pos: position{line: 113, col: 13, offset: 2571},
+																										run: (*parser).callonExpression254,
+																										expr: &seqExpr{
+																											pos: position{line: 113, col: 13, offset: 2571},
+																											exprs: []interface{}{
+																												&notExpr{
+																													pos: position{line: 113, col: 13, offset: 2571},
+																													expr: &choiceExpr{
+																														pos: position{line: 276, col: 5, offset: 7537},
+																														alternatives: []interface{}{
+																															&litMatcher{
+																																pos:        position{line: 256, col: 6, offset: 7094},
+																																val:        "if",
+																																ignoreCase: false,
+																																want:       "\"if\"",
+																															},
+																															&litMatcher{
+																																pos:        position{line: 257, col: 8, offset: 7108},
+																																val:        "then",
+																																ignoreCase: false,
+																																want:       "\"then\"",
+																															},
+																															&litMatcher{
+																																pos:        position{line: 258, col: 8, offset: 7124},
+																																val:        "else",
+																																ignoreCase: false,
+																																want:       "\"else\"",
+																															},
+																															&litMatcher{
+																																pos:        position{line: 259, col: 7, offset: 7139},
+																																val:        "let",
+																																ignoreCase: false,
+																																want:       "\"let\"",
+																															},
+																															&litMatcher{
+																																pos:        position{line: 260, col: 6, offset: 7152},
+																																val:        "in",
+																																ignoreCase: false,
+																																want:       "\"in\"",
+																															},
+																															&litMatcher{
+																																pos:        position{line: 262, col: 9, offset: 7179},
+																																val:        "using",
+																																ignoreCase: false,
+																																want:       "\"using\"",
+																															},
+																															&actionExpr{
+																																pos: position{line: 264, col: 11, offset: 7217},
+																																run: (*parser).callonExpression264,
+																																expr: &litMatcher{
+																																	pos:        position{line: 264, col: 11, offset: 7217},
+																																	val:        "missing",
+																																	ignoreCase: false,
+																																	want:       "\"missing\"",
+																																},
+																															},
+																															&litMatcher{
+																																pos:        position{line: 271, col: 10, offset: 7370},
+																																val:        "assert",
+																																ignoreCase: false,
+																																want:       "\"assert\"",
+																															},
+																															&litMatcher{
+																																pos:        position{line: 261, col: 6, offset: 7164},
+																																val:        "as",
+																																ignoreCase: false,
+																																want:       "\"as\"",
+																															},
+																															&litMatcher{
+																																pos:        position{line: 265, col: 8, offset: 7262},
+																																val:        "True",
+																																ignoreCase: false,
+																																want:       "\"True\"",
+																															},
+																															&litMatcher{
+																																pos:        position{line: 266, col: 9, offset: 7279},
+																																val:        "False",
+																																ignoreCase: false,
+																																want:       "\"False\"",
+																															},
+																															&litMatcher{
+																																pos:        position{line: 267, col: 12, offset: 7300},
+																																val:        "Infinity",
+																																ignoreCase: false,
+																																want:       "\"Infinity\"",
+																															},
+																															&litMatcher{
+																																pos:        position{line: 268, col: 7, offset: 7319},
+																																val:        "NaN",
+																																ignoreCase: false,
+																																want:       "\"NaN\"",
+																															},
+																															&litMatcher{
+																																pos:        position{line: 263, col: 9, offset: 7197},
+																																val:        "merge",
+																																ignoreCase: false,
+																																want:       "\"merge\"",
+																															},
+																															&litMatcher{
+																																pos:        position{line: 269, col: 8, offset: 7334},
+																																val:        "Some",
+																																ignoreCase: false,
+																																want:       "\"Some\"",
+																															},
+																															&litMatcher{
+																																pos:        position{line: 270, col: 9, offset: 7351},
+																																val:        "toMap",
+																																ignoreCase: false,
+																																want:       "\"toMap\"",
+																															},
+																														},
+																													},
+																												},
+																												&charClassMatcher{
+																													pos:        position{line: 110, col: 24, offset: 2434},
+																													val:        "[_A-Za-z]",
+																													chars:      []rune{'_'},
+																													ranges:     []rune{'A', 'Z', 'a', 'z'},
+																													ignoreCase: false,
+																													inverted:   false,
+																												},
+																												&zeroOrMoreExpr{
+																													pos: position{line: 113, col: 43, offset: 2601},
+																													expr: &charClassMatcher{
+																														pos:        position{line: 111, col: 23, offset: 2468},
+																														val:        "[_/-A-Za-z0-9]",
+																														chars:      []rune{'_', '/', '-'},
+																														ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
+																														ignoreCase: false,
+																														inverted:   false,
+																													},
+																												},
+																											},
+																										},
 																									},
 																								},
 																							},
@@ -6926,2052 +8115,1588 @@ var g = &grammar{
 														},
 													},
 												},
+												&ruleRefExpr{
+													pos:  position{line: 523, col: 47, offset: 14852},
+													name: "_",
+												},
+												&litMatcher{
+													pos:        position{line: 523, col: 49, offset: 14854},
+													val:        ":",
+													ignoreCase: false,
+													want:       "\":\"",
+												},
+												&ruleRefExpr{
+													pos:  position{line: 523, col: 53, offset: 14858},
+													name: "_1",
+												},
+												&labeledExpr{
+													pos:   position{line: 523, col: 56, offset: 14861},
+													label: "t",
+													expr: &ruleRefExpr{
+														pos:  position{line: 523, col: 58, offset: 14863},
+														name: "Expression",
+													},
+												},
+												&ruleRefExpr{
+													pos:  position{line: 523, col: 69, offset: 14874},
+													name: "_",
+												},
+												&litMatcher{
+													pos:        position{line: 523, col: 71, offset: 14876},
+													val:        ")",
+													ignoreCase: false,
+													want:       "\")\"",
+												},
+												&ruleRefExpr{
+													pos:  position{line: 523, col: 75, offset: 14880},
+													name: "_",
+												},
+												&choiceExpr{
+													pos: position{line: 294, col: 9, offset: 7918},
+													alternatives: []interface{}{
+														&litMatcher{
+															pos:        position{line: 294, col: 9, offset: 7918},
+															val:        "->",
+															ignoreCase: false,
+															want:       "\"->\"",
+														},
+														&litMatcher{
+															pos:        position{line: 294, col: 16, offset: 7925},
+															val:        "→",
+															ignoreCase: false,
+															want:       "\"→\"",
+														},
+													},
+												},
+												&ruleRefExpr{
+													pos:  position{line: 523, col: 83, offset: 14888},
+													name: "_",
+												},
+												&labeledExpr{
+													pos:   position{line: 523, col: 85, offset: 14890},
+													label: "body",
+													expr: &ruleRefExpr{
+														pos:  position{line: 523, col: 90, offset: 14895},
+														name: "Expression",
+													},
+												},
 											},
-											&actionExpr{
-												pos: position{line: 125, col: 19, offset: 3004},
-												run: (*parser).callonExpression143,
-												expr: &seqExpr{
-													pos: position{line: 125, col: 19, offset: 3004},
-													exprs: []interface{}{
-														&notExpr{
-															pos: position{line: 125, col: 19, offset: 3004},
-															expr: &choiceExpr{
-																pos: position{line: 221, col: 5, offset: 5657},
-																alternatives: []interface{}{
-																	&actionExpr{
-																		pos: position{line: 221, col: 5, offset: 5657},
-																		run: (*parser).callonExpression147,
-																		expr: &litMatcher{
-																			pos:        position{line: 221, col: 5, offset: 5657},
-																			val:        "Natural/build",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 222, col: 5, offset: 5706},
-																		run: (*parser).callonExpression149,
-																		expr: &litMatcher{
-																			pos:        position{line: 222, col: 5, offset: 5706},
-																			val:        "Natural/fold",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 223, col: 5, offset: 5753},
-																		run: (*parser).callonExpression151,
-																		expr: &litMatcher{
-																			pos:        position{line: 223, col: 5, offset: 5753},
-																			val:        "Natural/isZero",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 224, col: 5, offset: 5804},
-																		run: (*parser).callonExpression153,
-																		expr: &litMatcher{
-																			pos:        position{line: 224, col: 5, offset: 5804},
-																			val:        "Natural/even",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 225, col: 5, offset: 5851},
-																		run: (*parser).callonExpression155,
-																		expr: &litMatcher{
-																			pos:        position{line: 225, col: 5, offset: 5851},
-																			val:        "Natural/odd",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 226, col: 5, offset: 5896},
-																		run: (*parser).callonExpression157,
-																		expr: &litMatcher{
-																			pos:        position{line: 226, col: 5, offset: 5896},
-																			val:        "Natural/toInteger",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 227, col: 5, offset: 5953},
-																		run: (*parser).callonExpression159,
-																		expr: &litMatcher{
-																			pos:        position{line: 227, col: 5, offset: 5953},
-																			val:        "Natural/show",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 228, col: 5, offset: 6000},
-																		run: (*parser).callonExpression161,
-																		expr: &litMatcher{
-																			pos:        position{line: 228, col: 5, offset: 6000},
-																			val:        "Natural/subtract",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 229, col: 5, offset: 6055},
-																		run: (*parser).callonExpression163,
-																		expr: &litMatcher{
-																			pos:        position{line: 229, col: 5, offset: 6055},
-																			val:        "Integer/toDouble",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 230, col: 5, offset: 6110},
-																		run: (*parser).callonExpression165,
-																		expr: &litMatcher{
-																			pos:        position{line: 230, col: 5, offset: 6110},
-																			val:        "Integer/show",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 231, col: 5, offset: 6157},
-																		run: (*parser).callonExpression167,
-																		expr: &litMatcher{
-																			pos:        position{line: 231, col: 5, offset: 6157},
-																			val:        "Double/show",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 232, col: 5, offset: 6202},
-																		run: (*parser).callonExpression169,
-																		expr: &litMatcher{
-																			pos:        position{line: 232, col: 5, offset: 6202},
-																			val:        "List/build",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 233, col: 5, offset: 6245},
-																		run: (*parser).callonExpression171,
-																		expr: &litMatcher{
-																			pos:        position{line: 233, col: 5, offset: 6245},
-																			val:        "List/fold",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 234, col: 5, offset: 6286},
-																		run: (*parser).callonExpression173,
-																		expr: &litMatcher{
-																			pos:        position{line: 234, col: 5, offset: 6286},
-																			val:        "List/length",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 235, col: 5, offset: 6331},
-																		run: (*parser).callonExpression175,
-																		expr: &litMatcher{
-																			pos:        position{line: 235, col: 5, offset: 6331},
-																			val:        "List/head",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 236, col: 5, offset: 6372},
-																		run: (*parser).callonExpression177,
-																		expr: &litMatcher{
-																			pos:        position{line: 236, col: 5, offset: 6372},
-																			val:        "List/last",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 237, col: 5, offset: 6413},
-																		run: (*parser).callonExpression179,
-																		expr: &litMatcher{
-																			pos:        position{line: 237, col: 5, offset: 6413},
-																			val:        "List/indexed",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 238, col: 5, offset: 6460},
-																		run: (*parser).callonExpression181,
-																		expr: &litMatcher{
-																			pos:        position{line: 238, col: 5, offset: 6460},
-																			val:        "List/reverse",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 239, col: 5, offset: 6507},
-																		run: (*parser).callonExpression183,
-																		expr: &litMatcher{
-																			pos:        position{line: 239, col: 5, offset: 6507},
-																			val:        "Optional/build",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 240, col: 5, offset: 6558},
-																		run: (*parser).callonExpression185,
-																		expr: &litMatcher{
-																			pos:        position{line: 240, col: 5, offset: 6558},
-																			val:        "Optional/fold",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 241, col: 5, offset: 6607},
-																		run: (*parser).callonExpression187,
-																		expr: &litMatcher{
-																			pos:        position{line: 241, col: 5, offset: 6607},
-																			val:        "Text/show",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 242, col: 5, offset: 6648},
-																		run: (*parser).callonExpression189,
-																		expr: &litMatcher{
-																			pos:        position{line: 242, col: 5, offset: 6648},
-																			val:        "Bool",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 243, col: 5, offset: 6680},
-																		run: (*parser).callonExpression191,
-																		expr: &litMatcher{
-																			pos:        position{line: 243, col: 5, offset: 6680},
-																			val:        "True",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 244, col: 5, offset: 6712},
-																		run: (*parser).callonExpression193,
-																		expr: &litMatcher{
-																			pos:        position{line: 244, col: 5, offset: 6712},
-																			val:        "False",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 245, col: 5, offset: 6746},
-																		run: (*parser).callonExpression195,
-																		expr: &litMatcher{
-																			pos:        position{line: 245, col: 5, offset: 6746},
-																			val:        "Optional",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 246, col: 5, offset: 6786},
-																		run: (*parser).callonExpression197,
-																		expr: &litMatcher{
-																			pos:        position{line: 246, col: 5, offset: 6786},
-																			val:        "Natural",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 247, col: 5, offset: 6824},
-																		run: (*parser).callonExpression199,
-																		expr: &litMatcher{
-																			pos:        position{line: 247, col: 5, offset: 6824},
-																			val:        "Integer",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 248, col: 5, offset: 6862},
-																		run: (*parser).callonExpression201,
-																		expr: &litMatcher{
-																			pos:        position{line: 248, col: 5, offset: 6862},
-																			val:        "Double",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 249, col: 5, offset: 6898},
-																		run: (*parser).callonExpression203,
-																		expr: &litMatcher{
-																			pos:        position{line: 249, col: 5, offset: 6898},
-																			val:        "Text",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 250, col: 5, offset: 6930},
-																		run: (*parser).callonExpression205,
-																		expr: &litMatcher{
-																			pos:        position{line: 250, col: 5, offset: 6930},
-																			val:        "List",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 251, col: 5, offset: 6962},
-																		run: (*parser).callonExpression207,
-																		expr: &litMatcher{
-																			pos:        position{line: 251, col: 5, offset: 6962},
-																			val:        "None",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 252, col: 5, offset: 6994},
-																		run: (*parser).callonExpression209,
-																		expr: &litMatcher{
-																			pos:        position{line: 252, col: 5, offset: 6994},
-																			val:        "Type",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 253, col: 5, offset: 7026},
-																		run: (*parser).callonExpression211,
-																		expr: &litMatcher{
-																			pos:        position{line: 253, col: 5, offset: 7026},
-																			val:        "Kind",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 254, col: 5, offset: 7058},
-																		run: (*parser).callonExpression213,
-																		expr: &litMatcher{
-																			pos:        position{line: 254, col: 5, offset: 7058},
-																			val:        "Sort",
-																			ignoreCase: false,
-																		},
-																	},
-																},
-															},
-														},
-														&labeledExpr{
-															pos:   position{line: 125, col: 29, offset: 3014},
-															label: "label",
-															expr: &choiceExpr{
-																pos: position{line: 121, col: 9, offset: 2802},
-																alternatives: []interface{}{
-																	&actionExpr{
-																		pos: position{line: 121, col: 9, offset: 2802},
-																		run: (*parser).callonExpression217,
-																		expr: &seqExpr{
-																			pos: position{line: 121, col: 9, offset: 2802},
-																			exprs: []interface{}{
-																				&litMatcher{
-																					pos:        position{line: 121, col: 9, offset: 2802},
-																					val:        "`",
-																					ignoreCase: false,
-																				},
-																				&labeledExpr{
-																					pos:   position{line: 121, col: 13, offset: 2806},
-																					label: "label",
-																					expr: &actionExpr{
-																						pos: position{line: 119, col: 15, offset: 2743},
-																						run: (*parser).callonExpression221,
-																						expr: &oneOrMoreExpr{
-																							pos: position{line: 119, col: 15, offset: 2743},
-																							expr: &charClassMatcher{
-																								pos:        position{line: 118, col: 19, offset: 2706},
-																								val:        "[ -_a-~]",
-																								ranges:     []rune{' ', '_', 'a', '~'},
-																								ignoreCase: false,
-																								inverted:   false,
-																							},
-																						},
-																					},
-																				},
-																				&litMatcher{
-																					pos:        position{line: 121, col: 31, offset: 2824},
-																					val:        "`",
-																					ignoreCase: false,
-																				},
-																			},
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 122, col: 9, offset: 2858},
-																		run: (*parser).callonExpression225,
-																		expr: &labeledExpr{
-																			pos:   position{line: 122, col: 9, offset: 2858},
-																			label: "label",
-																			expr: &choiceExpr{
-																				pos: position{line: 112, col: 15, offset: 2499},
-																				alternatives: []interface{}{
-																					&actionExpr{
-																						pos: position{line: 112, col: 15, offset: 2499},
-																						run: (*parser).callonExpression228,
-																						expr: &seqExpr{
-																							pos: position{line: 112, col: 15, offset: 2499},
-																							exprs: []interface{}{
-																								&choiceExpr{
-																									pos: position{line: 274, col: 5, offset: 7396},
-																									alternatives: []interface{}{
-																										&litMatcher{
-																											pos:        position{line: 256, col: 6, offset: 7094},
-																											val:        "if",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 257, col: 8, offset: 7108},
-																											val:        "then",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 258, col: 8, offset: 7124},
-																											val:        "else",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 259, col: 7, offset: 7139},
-																											val:        "let",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 260, col: 6, offset: 7152},
-																											val:        "in",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 262, col: 9, offset: 7179},
-																											val:        "using",
-																											ignoreCase: false,
-																										},
-																										&actionExpr{
-																											pos: position{line: 264, col: 11, offset: 7217},
-																											run: (*parser).callonExpression237,
-																											expr: &litMatcher{
-																												pos:        position{line: 264, col: 11, offset: 7217},
-																												val:        "missing",
-																												ignoreCase: false,
-																											},
-																										},
-																										&litMatcher{
-																											pos:        position{line: 261, col: 6, offset: 7164},
-																											val:        "as",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 265, col: 8, offset: 7262},
-																											val:        "True",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 266, col: 9, offset: 7279},
-																											val:        "False",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 267, col: 12, offset: 7300},
-																											val:        "Infinity",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 268, col: 7, offset: 7319},
-																											val:        "NaN",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 263, col: 9, offset: 7197},
-																											val:        "merge",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 269, col: 8, offset: 7334},
-																											val:        "Some",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 270, col: 9, offset: 7351},
-																											val:        "toMap",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 271, col: 10, offset: 7370},
-																											val:        "assert",
-																											ignoreCase: false,
-																										},
-																									},
-																								},
-																								&oneOrMoreExpr{
-																									pos: position{line: 112, col: 23, offset: 2507},
-																									expr: &charClassMatcher{
-																										pos:        position{line: 111, col: 23, offset: 2468},
-																										val:        "[_/-A-Za-z0-9]",
-																										chars:      []rune{'_', '/', '-'},
-																										ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
-																										ignoreCase: false,
-																										inverted:   false,
-																									},
-																								},
-																							},
-																						},
-																					},
-																					&actionExpr{
-																						pos: position{line: 113, col: 13, offset: 2571},
-																						run: (*parser).callonExpression250,
-																						expr: &seqExpr{
-																							pos: position{line: 113, col: 13, offset: 2571},
-																							exprs: []interface{}{
-																								&notExpr{
-																									pos: position{line: 113, col: 13, offset: 2571},
-																									expr: &choiceExpr{
-																										pos: position{line: 274, col: 5, offset: 7396},
-																										alternatives: []interface{}{
-																											&litMatcher{
-																												pos:        position{line: 256, col: 6, offset: 7094},
-																												val:        "if",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 257, col: 8, offset: 7108},
-																												val:        "then",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 258, col: 8, offset: 7124},
-																												val:        "else",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 259, col: 7, offset: 7139},
-																												val:        "let",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 260, col: 6, offset: 7152},
-																												val:        "in",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 262, col: 9, offset: 7179},
-																												val:        "using",
-																												ignoreCase: false,
-																											},
-																											&actionExpr{
-																												pos: position{line: 264, col: 11, offset: 7217},
-																												run: (*parser).callonExpression260,
-																												expr: &litMatcher{
-																													pos:        position{line: 264, col: 11, offset: 7217},
-																													val:        "missing",
-																													ignoreCase: false,
-																												},
-																											},
-																											&litMatcher{
-																												pos:        position{line: 261, col: 6, offset: 7164},
-																												val:        "as",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 265, col: 8, offset: 7262},
-																												val:        "True",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 266, col: 9, offset: 7279},
-																												val:        "False",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 267, col: 12, offset: 7300},
-																												val:        "Infinity",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 268, col: 7, offset: 7319},
-																												val:        "NaN",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 263, col: 9, offset: 7197},
-																												val:        "merge",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 269, col: 8, offset: 7334},
-																												val:        "Some",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 270, col: 9, offset: 7351},
-																												val:        "toMap",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 271, col: 10, offset: 7370},
-																												val:        "assert",
-																												ignoreCase: false,
-																											},
-																										},
-																									},
-																								},
-																								&charClassMatcher{
-																									pos:        position{line: 110, col: 24, offset: 2434},
-																									val:        "[_A-Za-z]",
-																									chars:      []rune{'_'},
-																									ranges:     []rune{'A', 'Z', 'a', 'z'},
-																									ignoreCase: false,
-																									inverted:   false,
-																								},
-																								&zeroOrMoreExpr{
-																									pos: position{line: 113, col: 43, offset: 2601},
-																									expr: &charClassMatcher{
-																										pos:        position{line: 111, col: 23, offset: 2468},
-																										val:        "[_/-A-Za-z0-9]",
-																										chars:      []rune{'_', '/', '-'},
-																										ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
-																										ignoreCase: false,
-																										inverted:   false,
-																									},
-																								},
-																							},
-																						},
-																					},
-																				},
-																			},
-																		},
-																	},
-																},
-															},
-														},
-													},
-												},
-											},
-										},
-									},
-								},
-								&ruleRefExpr{
-									pos:  position{line: 501, col: 45, offset: 13990},
-									name: "_",
-								},
-								&litMatcher{
-									pos:        position{line: 501, col: 47, offset: 13992},
-									val:        ":",
-									ignoreCase: false,
-								},
-								&ruleRefExpr{
-									pos:  position{line: 501, col: 51, offset: 13996},
-									name: "_1",
-								},
-								&labeledExpr{
-									pos:   position{line: 501, col: 54, offset: 13999},
-									label: "t",
-									expr: &ruleRefExpr{
-										pos:  position{line: 501, col: 56, offset: 14001},
-										name: "Expression",
-									},
-								},
-								&ruleRefExpr{
-									pos:  position{line: 501, col: 67, offset: 14012},
-									name: "_",
-								},
-								&litMatcher{
-									pos:        position{line: 501, col: 69, offset: 14014},
-									val:        ")",
-									ignoreCase: false,
-								},
-								&ruleRefExpr{
-									pos:  position{line: 501, col: 73, offset: 14018},
-									name: "_",
-								},
-								&choiceExpr{
-									pos: position{line: 293, col: 9, offset: 7779},
-									alternatives: []interface{}{
-										&litMatcher{
-											pos:        position{line: 293, col: 9, offset: 7779},
-											val:        "->",
-											ignoreCase: false,
-										},
-										&litMatcher{
-											pos:        position{line: 293, col: 16, offset: 7786},
-											val:        "→",
-											ignoreCase: false,
-										},
-									},
-								},
-								&ruleRefExpr{
-									pos:  position{line: 501, col: 81, offset: 14026},
-									name: "_",
-								},
-								&labeledExpr{
-									pos:   position{line: 501, col: 83, offset: 14028},
-									label: "body",
-									expr: &ruleRefExpr{
-										pos:  position{line: 501, col: 88, offset: 14033},
-										name: "Expression",
-									},
-								},
-							},
-						},
-					},
-					&actionExpr{
-						pos: position{line: 504, col: 7, offset: 14149},
-						run: (*parser).callonExpression288,
-						expr: &seqExpr{
-							pos: position{line: 504, col: 7, offset: 14149},
-							exprs: []interface{}{
-								&litMatcher{
-									pos:        position{line: 256, col: 6, offset: 7094},
-									val:        "if",
-									ignoreCase: false,
-								},
-								&ruleRefExpr{
-									pos:  position{line: 504, col: 10, offset: 14152},
-									name: "_1",
-								},
-								&labeledExpr{
-									pos:   position{line: 504, col: 13, offset: 14155},
-									label: "cond",
-									expr: &ruleRefExpr{
-										pos:  position{line: 504, col: 18, offset: 14160},
-										name: "Expression",
-									},
-								},
-								&ruleRefExpr{
-									pos:  position{line: 504, col: 29, offset: 14171},
-									name: "_",
-								},
-								&litMatcher{
-									pos:        position{line: 257, col: 8, offset: 7108},
-									val:        "then",
-									ignoreCase: false,
-								},
-								&ruleRefExpr{
-									pos:  position{line: 504, col: 36, offset: 14178},
-									name: "_1",
-								},
-								&labeledExpr{
-									pos:   position{line: 504, col: 39, offset: 14181},
-									label: "t",
-									expr: &ruleRefExpr{
-										pos:  position{line: 504, col: 41, offset: 14183},
-										name: "Expression",
-									},
-								},
-								&ruleRefExpr{
-									pos:  position{line: 504, col: 52, offset: 14194},
-									name: "_",
-								},
-								&litMatcher{
-									pos:        position{line: 258, col: 8, offset: 7124},
-									val:        "else",
-									ignoreCase: false,
-								},
-								&ruleRefExpr{
-									pos:  position{line: 504, col: 59, offset: 14201},
-									name: "_1",
-								},
-								&labeledExpr{
-									pos:   position{line: 504, col: 62, offset: 14204},
-									label: "f",
-									expr: &ruleRefExpr{
-										pos:  position{line: 504, col: 64, offset: 14206},
-										name: "Expression",
-									},
-								},
-							},
-						},
-					},
-					&actionExpr{
-						pos: position{line: 507, col: 7, offset: 14292},
-						run: (*parser).callonExpression304,
-						expr: &seqExpr{
-							pos: position{line: 507, col: 7, offset: 14292},
-							exprs: []interface{}{
-								&labeledExpr{
-									pos:   position{line: 507, col: 7, offset: 14292},
-									label: "bindings",
-									expr: &oneOrMoreExpr{
-										pos: position{line: 507, col: 16, offset: 14301},
-										expr: &ruleRefExpr{
-											pos:  position{line: 507, col: 16, offset: 14301},
-											name: "LetBinding",
-										},
-									},
-								},
-								&litMatcher{
-									pos:        position{line: 260, col: 6, offset: 7152},
-									val:        "in",
-									ignoreCase: false,
-								},
-								&ruleRefExpr{
-									pos:  position{line: 507, col: 31, offset: 14316},
-									name: "_1",
-								},
-								&labeledExpr{
-									pos:   position{line: 507, col: 34, offset: 14319},
-									label: "b",
-									expr: &ruleRefExpr{
-										pos:  position{line: 507, col: 36, offset: 14321},
-										name: "Expression",
-									},
-								},
-							},
-						},
-					},
-					&actionExpr{
-						pos: position{line: 514, col: 7, offset: 14560},
-						run: (*parser).callonExpression313,
-						expr: &seqExpr{
-							pos: position{line: 514, col: 7, offset: 14560},
-							exprs: []interface{}{
-								&choiceExpr{
-									pos: position{line: 292, col: 10, offset: 7752},
-									alternatives: []interface{}{
-										&litMatcher{
-											pos:        position{line: 292, col: 10, offset: 7752},
-											val:        "forall",
-											ignoreCase: false,
-										},
-										&litMatcher{
-											pos:        position{line: 292, col: 21, offset: 7763},
-											val:        "∀",
-											ignoreCase: false,
-										},
-									},
-								},
-								&ruleRefExpr{
-									pos:  position{line: 514, col: 14, offset: 14567},
-									name: "_",
-								},
-								&litMatcher{
-									pos:        position{line: 514, col: 16, offset: 14569},
-									val:        "(",
-									ignoreCase: false,
-								},
-								&ruleRefExpr{
-									pos:  position{line: 514, col: 20, offset: 14573},
-									name: "_",
-								},
-								&labeledExpr{
-									pos:   position{line: 514, col: 22, offset: 14575},
-									label: "label",
-									expr: &choiceExpr{
-										pos: position{line: 124, col: 20, offset: 2920},
-										alternatives: []interface{}{
-											&actionExpr{
-												pos: position{line: 124, col: 20, offset: 2920},
-												run: (*parser).callonExpression323,
-												expr: &seqExpr{
-													pos: position{line: 124, col: 20, offset: 2920},
-													exprs: []interface{}{
-														&andExpr{
-															pos: position{line: 124, col: 20, offset: 2920},
-															expr: &seqExpr{
-																pos: position{line: 124, col: 22, offset: 2922},
-																exprs: []interface{}{
-																	&choiceExpr{
-																		pos: position{line: 221, col: 5, offset: 5657},
-																		alternatives: []interface{}{
-																			&actionExpr{
-																				pos: position{line: 221, col: 5, offset: 5657},
-																				run: (*parser).callonExpression328,
-																				expr: &litMatcher{
-																					pos:        position{line: 221, col: 5, offset: 5657},
-																					val:        "Natural/build",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 222, col: 5, offset: 5706},
-																				run: (*parser).callonExpression330,
-																				expr: &litMatcher{
-																					pos:        position{line: 222, col: 5, offset: 5706},
-																					val:        "Natural/fold",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 223, col: 5, offset: 5753},
-																				run: (*parser).callonExpression332,
-																				expr: &litMatcher{
-																					pos:        position{line: 223, col: 5, offset: 5753},
-																					val:        "Natural/isZero",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 224, col: 5, offset: 5804},
-																				run: (*parser).callonExpression334,
-																				expr: &litMatcher{
-																					pos:        position{line: 224, col: 5, offset: 5804},
-																					val:        "Natural/even",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 225, col: 5, offset: 5851},
-																				run: (*parser).callonExpression336,
-																				expr: &litMatcher{
-																					pos:        position{line: 225, col: 5, offset: 5851},
-																					val:        "Natural/odd",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 226, col: 5, offset: 5896},
-																				run: (*parser).callonExpression338,
-																				expr: &litMatcher{
-																					pos:        position{line: 226, col: 5, offset: 5896},
-																					val:        "Natural/toInteger",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 227, col: 5, offset: 5953},
-																				run: (*parser).callonExpression340,
-																				expr: &litMatcher{
-																					pos:        position{line: 227, col: 5, offset: 5953},
-																					val:        "Natural/show",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 228, col: 5, offset: 6000},
-																				run: (*parser).callonExpression342,
-																				expr: &litMatcher{
-																					pos:        position{line: 228, col: 5, offset: 6000},
-																					val:        "Natural/subtract",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 229, col: 5, offset: 6055},
-																				run: (*parser).callonExpression344,
-																				expr: &litMatcher{
-																					pos:        position{line: 229, col: 5, offset: 6055},
-																					val:        "Integer/toDouble",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 230, col: 5, offset: 6110},
-																				run: (*parser).callonExpression346,
-																				expr: &litMatcher{
-																					pos:        position{line: 230, col: 5, offset: 6110},
-																					val:        "Integer/show",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 231, col: 5, offset: 6157},
-																				run: (*parser).callonExpression348,
-																				expr: &litMatcher{
-																					pos:        position{line: 231, col: 5, offset: 6157},
-																					val:        "Double/show",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 232, col: 5, offset: 6202},
-																				run: (*parser).callonExpression350,
-																				expr: &litMatcher{
-																					pos:        position{line: 232, col: 5, offset: 6202},
-																					val:        "List/build",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 233, col: 5, offset: 6245},
-																				run: (*parser).callonExpression352,
-																				expr: &litMatcher{
-																					pos:        position{line: 233, col: 5, offset: 6245},
-																					val:        "List/fold",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 234, col: 5, offset: 6286},
-																				run: (*parser).callonExpression354,
-																				expr: &litMatcher{
-																					pos:        position{line: 234, col: 5, offset: 6286},
-																					val:        "List/length",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 235, col: 5, offset: 6331},
-																				run: (*parser).callonExpression356,
-																				expr: &litMatcher{
-																					pos:        position{line: 235, col: 5, offset: 6331},
-																					val:        "List/head",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 236, col: 5, offset: 6372},
-																				run: (*parser).callonExpression358,
-																				expr: &litMatcher{
-																					pos:        position{line: 236, col: 5, offset: 6372},
-																					val:        "List/last",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 237, col: 5, offset: 6413},
-																				run: (*parser).callonExpression360,
-																				expr: &litMatcher{
-																					pos:        position{line: 237, col: 5, offset: 6413},
-																					val:        "List/indexed",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 238, col: 5, offset: 6460},
-																				run: (*parser).callonExpression362,
-																				expr: &litMatcher{
-																					pos:        position{line: 238, col: 5, offset: 6460},
-																					val:        "List/reverse",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 239, col: 5, offset: 6507},
-																				run: (*parser).callonExpression364,
-																				expr: &litMatcher{
-																					pos:        position{line: 239, col: 5, offset: 6507},
-																					val:        "Optional/build",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 240, col: 5, offset: 6558},
-																				run: (*parser).callonExpression366,
-																				expr: &litMatcher{
-																					pos:        position{line: 240, col: 5, offset: 6558},
-																					val:        "Optional/fold",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 241, col: 5, offset: 6607},
-																				run: (*parser).callonExpression368,
-																				expr: &litMatcher{
-																					pos:        position{line: 241, col: 5, offset: 6607},
-																					val:        "Text/show",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 242, col: 5, offset: 6648},
-																				run: (*parser).callonExpression370,
-																				expr: &litMatcher{
-																					pos:        position{line: 242, col: 5, offset: 6648},
-																					val:        "Bool",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 243, col: 5, offset: 6680},
-																				run: (*parser).callonExpression372,
-																				expr: &litMatcher{
-																					pos:        position{line: 243, col: 5, offset: 6680},
-																					val:        "True",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 244, col: 5, offset: 6712},
-																				run: (*parser).callonExpression374,
-																				expr: &litMatcher{
-																					pos:        position{line: 244, col: 5, offset: 6712},
-																					val:        "False",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 245, col: 5, offset: 6746},
-																				run: (*parser).callonExpression376,
-																				expr: &litMatcher{
-																					pos:        position{line: 245, col: 5, offset: 6746},
-																					val:        "Optional",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 246, col: 5, offset: 6786},
-																				run: (*parser).callonExpression378,
-																				expr: &litMatcher{
-																					pos:        position{line: 246, col: 5, offset: 6786},
-																					val:        "Natural",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 247, col: 5, offset: 6824},
-																				run: (*parser).callonExpression380,
-																				expr: &litMatcher{
-																					pos:        position{line: 247, col: 5, offset: 6824},
-																					val:        "Integer",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 248, col: 5, offset: 6862},
-																				run: (*parser).callonExpression382,
-																				expr: &litMatcher{
-																					pos:        position{line: 248, col: 5, offset: 6862},
-																					val:        "Double",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 249, col: 5, offset: 6898},
-																				run: (*parser).callonExpression384,
-																				expr: &litMatcher{
-																					pos:        position{line: 249, col: 5, offset: 6898},
-																					val:        "Text",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 250, col: 5, offset: 6930},
-																				run: (*parser).callonExpression386,
-																				expr: &litMatcher{
-																					pos:        position{line: 250, col: 5, offset: 6930},
-																					val:        "List",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 251, col: 5, offset: 6962},
-																				run: (*parser).callonExpression388,
-																				expr: &litMatcher{
-																					pos:        position{line: 251, col: 5, offset: 6962},
-																					val:        "None",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 252, col: 5, offset: 6994},
-																				run: (*parser).callonExpression390,
-																				expr: &litMatcher{
-																					pos:        position{line: 252, col: 5, offset: 6994},
-																					val:        "Type",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 253, col: 5, offset: 7026},
-																				run: (*parser).callonExpression392,
-																				expr: &litMatcher{
-																					pos:        position{line: 253, col: 5, offset: 7026},
-																					val:        "Kind",
-																					ignoreCase: false,
-																				},
-																			},
-																			&actionExpr{
-																				pos: position{line: 254, col: 5, offset: 7058},
-																				run: (*parser).callonExpression394,
-																				expr: &litMatcher{
-																					pos:        position{line: 254, col: 5, offset: 7058},
-																					val:        "Sort",
-																					ignoreCase: false,
-																				},
-																			},
-																		},
-																	},
-																	&charClassMatcher{
-																		pos:        position{line: 111, col: 23, offset: 2468},
-																		val:        "[_/-A-Za-z0-9]",
-																		chars:      []rune{'_', '/', '-'},
-																		ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
-																		ignoreCase: false,
-																		inverted:   false,
-																	},
-																},
-															},
-														},
-														&labeledExpr{
-															pos:   position{line: 124, col: 52, offset: 2952},
-															label: "label",
-															expr: &choiceExpr{
-																pos: position{line: 121, col: 9, offset: 2802},
-																alternatives: []interface{}{
-																	&actionExpr{
-																		pos: position{line: 121, col: 9, offset: 2802},
-																		run: (*parser).callonExpression399,
-																		expr: &seqExpr{
-																			pos: position{line: 121, col: 9, offset: 2802},
-																			exprs: []interface{}{
-																				&litMatcher{
-																					pos:        position{line: 121, col: 9, offset: 2802},
-																					val:        "`",
-																					ignoreCase: false,
-																				},
-																				&labeledExpr{
-																					pos:   position{line: 121, col: 13, offset: 2806},
-																					label: "label",
-																					expr: &actionExpr{
-																						pos: position{line: 119, col: 15, offset: 2743},
-																						run: (*parser).callonExpression403,
-																						expr: &oneOrMoreExpr{
-																							pos: position{line: 119, col: 15, offset: 2743},
-																							expr: &charClassMatcher{
-																								pos:        position{line: 118, col: 19, offset: 2706},
-																								val:        "[ -_a-~]",
-																								ranges:     []rune{' ', '_', 'a', '~'},
-																								ignoreCase: false,
-																								inverted:   false,
-																							},
-																						},
-																					},
-																				},
-																				&litMatcher{
-																					pos:        position{line: 121, col: 31, offset: 2824},
-																					val:        "`",
-																					ignoreCase: false,
-																				},
-																			},
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 122, col: 9, offset: 2858},
-																		run: (*parser).callonExpression407,
-																		expr: &labeledExpr{
-																			pos:   position{line: 122, col: 9, offset: 2858},
-																			label: "label",
-																			expr: &choiceExpr{
-																				pos: position{line: 112, col: 15, offset: 2499},
-																				alternatives: []interface{}{
-																					&actionExpr{
-																						pos: position{line: 112, col: 15, offset: 2499},
-																						run: (*parser).callonExpression410,
-																						expr: &seqExpr{
-																							pos: position{line: 112, col: 15, offset: 2499},
-																							exprs: []interface{}{
-																								&choiceExpr{
-																									pos: position{line: 274, col: 5, offset: 7396},
-																									alternatives: []interface{}{
-																										&litMatcher{
-																											pos:        position{line: 256, col: 6, offset: 7094},
-																											val:        "if",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 257, col: 8, offset: 7108},
-																											val:        "then",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 258, col: 8, offset: 7124},
-																											val:        "else",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 259, col: 7, offset: 7139},
-																											val:        "let",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 260, col: 6, offset: 7152},
-																											val:        "in",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 262, col: 9, offset: 7179},
-																											val:        "using",
-																											ignoreCase: false,
-																										},
-																										&actionExpr{
-																											pos: position{line: 264, col: 11, offset: 7217},
-																											run: (*parser).callonExpression419,
-																											expr: &litMatcher{
-																												pos:        position{line: 264, col: 11, offset: 7217},
-																												val:        "missing",
-																												ignoreCase: false,
-																											},
-																										},
-																										&litMatcher{
-																											pos:        position{line: 261, col: 6, offset: 7164},
-																											val:        "as",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 265, col: 8, offset: 7262},
-																											val:        "True",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 266, col: 9, offset: 7279},
-																											val:        "False",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 267, col: 12, offset: 7300},
-																											val:        "Infinity",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 268, col: 7, offset: 7319},
-																											val:        "NaN",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 263, col: 9, offset: 7197},
-																											val:        "merge",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 269, col: 8, offset: 7334},
-																											val:        "Some",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 270, col: 9, offset: 7351},
-																											val:        "toMap",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 271, col: 10, offset: 7370},
-																											val:        "assert",
-																											ignoreCase: false,
-																										},
-																									},
-																								},
-																								&oneOrMoreExpr{
-																									pos: position{line: 112, col: 23, offset: 2507},
-																									expr: &charClassMatcher{
-																										pos:        position{line: 111, col: 23, offset: 2468},
-																										val:        "[_/-A-Za-z0-9]",
-																										chars:      []rune{'_', '/', '-'},
-																										ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
-																										ignoreCase: false,
-																										inverted:   false,
-																									},
-																								},
-																							},
-																						},
-																					},
-																					&actionExpr{
-																						pos: position{line: 113, col: 13, offset: 2571},
-																						run: (*parser).callonExpression432,
-																						expr: &seqExpr{
-																							pos: position{line: 113, col: 13, offset: 2571},
-																							exprs: []interface{}{
-																								&notExpr{
-																									pos: position{line: 113, col: 13, offset: 2571},
-																									expr: &choiceExpr{
-																										pos: position{line: 274, col: 5, offset: 7396},
-																										alternatives: []interface{}{
-																											&litMatcher{
-																												pos:        position{line: 256, col: 6, offset: 7094},
-																												val:        "if",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 257, col: 8, offset: 7108},
-																												val:        "then",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 258, col: 8, offset: 7124},
-																												val:        "else",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 259, col: 7, offset: 7139},
-																												val:        "let",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 260, col: 6, offset: 7152},
-																												val:        "in",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 262, col: 9, offset: 7179},
-																												val:        "using",
-																												ignoreCase: false,
-																											},
-																											&actionExpr{
-																												pos: position{line: 264, col: 11, offset: 7217},
-																												run: (*parser).callonExpression442,
-																												expr: &litMatcher{
-																													pos:        position{line: 264, col: 11, offset: 7217},
-																													val:        "missing",
-																													ignoreCase: false,
-																												},
-																											},
-																											&litMatcher{
-																												pos:        position{line: 261, col: 6, offset: 7164},
-																												val:        "as",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 265, col: 8, offset: 7262},
-																												val:        "True",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 266, col: 9, offset: 7279},
-																												val:        "False",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 267, col: 12, offset: 7300},
-																												val:        "Infinity",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 268, col: 7, offset: 7319},
-																												val:        "NaN",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 263, col: 9, offset: 7197},
-																												val:        "merge",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 269, col: 8, offset: 7334},
-																												val:        "Some",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 270, col: 9, offset: 7351},
-																												val:        "toMap",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 271, col: 10, offset: 7370},
-																												val:        "assert",
-																												ignoreCase: false,
-																											},
-																										},
-																									},
-																								},
-																								&charClassMatcher{
-																									pos:        position{line: 110, col: 24, offset: 2434},
-																									val:        "[_A-Za-z]",
-																									chars:      []rune{'_'},
-																									ranges:     []rune{'A', 'Z', 'a', 'z'},
-																									ignoreCase: false,
-																									inverted:   false,
-																								},
-																								&zeroOrMoreExpr{
-																									pos: position{line: 113, col: 43, offset: 2601},
-																									expr: &charClassMatcher{
-																										pos:        position{line: 111, col: 23, offset: 2468},
-																										val:        "[_/-A-Za-z0-9]",
-																										chars:      []rune{'_', '/', '-'},
-																										ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
-																										ignoreCase: false,
-																										inverted:   false,
-																									},
-																								},
-																							},
-																						},
-																					},
-																				},
-																			},
-																		},
-																	},
-																},
-															},
-														},
-													},
-												},
-											},
-											&actionExpr{
-												pos: position{line: 125, col: 19, offset: 3004},
-												run: (*parser).callonExpression456,
-												expr: &seqExpr{
-													pos: position{line: 125, col: 19, offset: 3004},
-													exprs: []interface{}{
-														&notExpr{
-															pos: position{line: 125, col: 19, offset: 3004},
-															expr: &choiceExpr{
-																pos: position{line: 221, col: 5, offset: 5657},
-																alternatives: []interface{}{
-																	&actionExpr{
-																		pos: position{line: 221, col: 5, offset: 5657},
-																		run: (*parser).callonExpression460,
-																		expr: &litMatcher{
-																			pos:        position{line: 221, col: 5, offset: 5657},
-																			val:        "Natural/build",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 222, col: 5, offset: 5706},
-																		run: (*parser).callonExpression462,
-																		expr: &litMatcher{
-																			pos:        position{line: 222, col: 5, offset: 5706},
-																			val:        "Natural/fold",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 223, col: 5, offset: 5753},
-																		run: (*parser).callonExpression464,
-																		expr: &litMatcher{
-																			pos:        position{line: 223, col: 5, offset: 5753},
-																			val:        "Natural/isZero",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 224, col: 5, offset: 5804},
-																		run: (*parser).callonExpression466,
-																		expr: &litMatcher{
-																			pos:        position{line: 224, col: 5, offset: 5804},
-																			val:        "Natural/even",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 225, col: 5, offset: 5851},
-																		run: (*parser).callonExpression468,
-																		expr: &litMatcher{
-																			pos:        position{line: 225, col: 5, offset: 5851},
-																			val:        "Natural/odd",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 226, col: 5, offset: 5896},
-																		run: (*parser).callonExpression470,
-																		expr: &litMatcher{
-																			pos:        position{line: 226, col: 5, offset: 5896},
-																			val:        "Natural/toInteger",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 227, col: 5, offset: 5953},
-																		run: (*parser).callonExpression472,
-																		expr: &litMatcher{
-																			pos:        position{line: 227, col: 5, offset: 5953},
-																			val:        "Natural/show",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 228, col: 5, offset: 6000},
-																		run: (*parser).callonExpression474,
-																		expr: &litMatcher{
-																			pos:        position{line: 228, col: 5, offset: 6000},
-																			val:        "Natural/subtract",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 229, col: 5, offset: 6055},
-																		run: (*parser).callonExpression476,
-																		expr: &litMatcher{
-																			pos:        position{line: 229, col: 5, offset: 6055},
-																			val:        "Integer/toDouble",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 230, col: 5, offset: 6110},
-																		run: (*parser).callonExpression478,
-																		expr: &litMatcher{
-																			pos:        position{line: 230, col: 5, offset: 6110},
-																			val:        "Integer/show",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 231, col: 5, offset: 6157},
-																		run: (*parser).callonExpression480,
-																		expr: &litMatcher{
-																			pos:        position{line: 231, col: 5, offset: 6157},
-																			val:        "Double/show",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 232, col: 5, offset: 6202},
-																		run: (*parser).callonExpression482,
-																		expr: &litMatcher{
-																			pos:        position{line: 232, col: 5, offset: 6202},
-																			val:        "List/build",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 233, col: 5, offset: 6245},
-																		run: (*parser).callonExpression484,
-																		expr: &litMatcher{
-																			pos:        position{line: 233, col: 5, offset: 6245},
-																			val:        "List/fold",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 234, col: 5, offset: 6286},
-																		run: (*parser).callonExpression486,
-																		expr: &litMatcher{
-																			pos:        position{line: 234, col: 5, offset: 6286},
-																			val:        "List/length",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 235, col: 5, offset: 6331},
-																		run: (*parser).callonExpression488,
-																		expr: &litMatcher{
-																			pos:        position{line: 235, col: 5, offset: 6331},
-																			val:        "List/head",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 236, col: 5, offset: 6372},
-																		run: (*parser).callonExpression490,
-																		expr: &litMatcher{
-																			pos:        position{line: 236, col: 5, offset: 6372},
-																			val:        "List/last",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 237, col: 5, offset: 6413},
-																		run: (*parser).callonExpression492,
-																		expr: &litMatcher{
-																			pos:        position{line: 237, col: 5, offset: 6413},
-																			val:        "List/indexed",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 238, col: 5, offset: 6460},
-																		run: (*parser).callonExpression494,
-																		expr: &litMatcher{
-																			pos:        position{line: 238, col: 5, offset: 6460},
-																			val:        "List/reverse",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 239, col: 5, offset: 6507},
-																		run: (*parser).callonExpression496,
-																		expr: &litMatcher{
-																			pos:        position{line: 239, col: 5, offset: 6507},
-																			val:        "Optional/build",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 240, col: 5, offset: 6558},
-																		run: (*parser).callonExpression498,
-																		expr: &litMatcher{
-																			pos:        position{line: 240, col: 5, offset: 6558},
-																			val:        "Optional/fold",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 241, col: 5, offset: 6607},
-																		run: (*parser).callonExpression500,
-																		expr: &litMatcher{
-																			pos:        position{line: 241, col: 5, offset: 6607},
-																			val:        "Text/show",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 242, col: 5, offset: 6648},
-																		run: (*parser).callonExpression502,
-																		expr: &litMatcher{
-																			pos:        position{line: 242, col: 5, offset: 6648},
-																			val:        "Bool",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 243, col: 5, offset: 6680},
-																		run: (*parser).callonExpression504,
-																		expr: &litMatcher{
-																			pos:        position{line: 243, col: 5, offset: 6680},
-																			val:        "True",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 244, col: 5, offset: 6712},
-																		run: (*parser).callonExpression506,
-																		expr: &litMatcher{
-																			pos:        position{line: 244, col: 5, offset: 6712},
-																			val:        "False",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 245, col: 5, offset: 6746},
-																		run: (*parser).callonExpression508,
-																		expr: &litMatcher{
-																			pos:        position{line: 245, col: 5, offset: 6746},
-																			val:        "Optional",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 246, col: 5, offset: 6786},
-																		run: (*parser).callonExpression510,
-																		expr: &litMatcher{
-																			pos:        position{line: 246, col: 5, offset: 6786},
-																			val:        "Natural",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 247, col: 5, offset: 6824},
-																		run: (*parser).callonExpression512,
-																		expr: &litMatcher{
-																			pos:        position{line: 247, col: 5, offset: 6824},
-																			val:        "Integer",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 248, col: 5, offset: 6862},
-																		run: (*parser).callonExpression514,
-																		expr: &litMatcher{
-																			pos:        position{line: 248, col: 5, offset: 6862},
-																			val:        "Double",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 249, col: 5, offset: 6898},
-																		run: (*parser).callonExpression516,
-																		expr: &litMatcher{
-																			pos:        position{line: 249, col: 5, offset: 6898},
-																			val:        "Text",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 250, col: 5, offset: 6930},
-																		run: (*parser).callonExpression518,
-																		expr: &litMatcher{
-																			pos:        position{line: 250, col: 5, offset: 6930},
-																			val:        "List",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 251, col: 5, offset: 6962},
-																		run: (*parser).callonExpression520,
-																		expr: &litMatcher{
-																			pos:        position{line: 251, col: 5, offset: 6962},
-																			val:        "None",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 252, col: 5, offset: 6994},
-																		run: (*parser).callonExpression522,
-																		expr: &litMatcher{
-																			pos:        position{line: 252, col: 5, offset: 6994},
-																			val:        "Type",
-																			ignoreCase: false,
-																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 253, col: 5, offset: 7026},
-																		run: (*parser).callonExpression524,
-																		expr: &litMatcher{
-																			pos:        position{line: 253, col: 5, offset: 7026},
-																			val:        "Kind",
-																			ignoreCase: false,
+										},
+									},
+									&actionExpr{
+										pos: position{line: 526, col: 9, offset: 15017},
+										run: (*parser).callonExpression292,
+										expr: &seqExpr{
+											pos: position{line: 526, col: 9, offset: 15017},
+											exprs: []interface{}{
+												&litMatcher{
+													pos:        position{line: 256, col: 6, offset: 7094},
+													val:        "if",
+													ignoreCase: false,
+													want:       "\"if\"",
+												},
+												&ruleRefExpr{
+													pos:  position{line: 526, col: 12, offset: 15020},
+													name: "_1",
+												},
+												&labeledExpr{
+													pos:   position{line: 526, col: 15, offset: 15023},
+													label: "cond",
+													expr: &ruleRefExpr{
+														pos:  position{line: 526, col: 20, offset: 15028},
+														name: "Expression",
+													},
+												},
+												&ruleRefExpr{
+													pos:  position{line: 526, col: 31, offset: 15039},
+													name: "_",
+												},
+												&litMatcher{
+													pos:        position{line: 257, col: 8, offset: 7108},
+													val:        "then",
+													ignoreCase: false,
+													want:       "\"then\"",
+												},
+												&ruleRefExpr{
+													pos:  position{line: 526, col: 38, offset: 15046},
+													name: "_1",
+												},
+												&labeledExpr{
+													pos:   position{line: 526, col: 41, offset: 15049},
+													label: "t",
+													expr: &ruleRefExpr{
+														pos:  position{line: 526, col: 43, offset: 15051},
+														name: "Expression",
+													},
+												},
+												&ruleRefExpr{
+													pos:  position{line: 526, col: 54, offset: 15062},
+													name: "_",
+												},
+												&litMatcher{
+													pos:        position{line: 258, col: 8, offset: 7124},
+													val:        "else",
+													ignoreCase: false,
+													want:       "\"else\"",
+												},
+												&ruleRefExpr{
+													pos:  position{line: 526, col: 61, offset: 15069},
+													name: "_1",
+												},
+												&labeledExpr{
+													pos:   position{line: 526, col: 64, offset: 15072},
+													label: "f",
+													expr: &ruleRefExpr{
+														pos:  position{line: 526, col: 66, offset: 15074},
+														name: "Expression",
+													},
+												},
+											},
+										},
+									},
+									&actionExpr{
+										pos: position{line: 529, col: 9, offset: 15166},
+										run: (*parser).callonExpression308,
+										expr: &seqExpr{
+											pos: position{line: 529, col: 9, offset: 15166},
+											exprs: []interface{}{
+												&labeledExpr{
+													pos:   position{line: 529, col: 9, offset: 15166},
+													label: "bindings",
+													expr: &oneOrMoreExpr{
+														pos: position{line: 529, col: 18, offset: 15175},
+														expr: &ruleRefExpr{
+															pos:  position{line: 529, col: 18, offset: 15175},
+															name: "LetBinding",
+														},
+													},
+												},
+												&litMatcher{
+													pos:        position{line: 260, col: 6, offset: 7152},
+													val:        "in",
+													ignoreCase: false,
+													want:       "\"in\"",
+												},
+												&ruleRefExpr{
+													pos:  position{line: 529, col: 33, offset: 15190},
+													name: "_1",
+												},
+												&labeledExpr{
+													pos:   position{line: 529, col: 36, offset: 15193},
+													label: "b",
+													expr: &ruleRefExpr{
+														pos:  position{line: 529, col: 38, offset: 15195},
+														name: "Expression",
+													},
+												},
+											},
+										},
+									},
+									&actionExpr{
+										pos: position{line: 536, col: 9, offset: 15448},
+										run: (*parser).callonExpression317,
+										expr: &seqExpr{
+											pos: position{line: 536, col: 9, offset: 15448},
+											exprs: []interface{}{
+												&choiceExpr{
+													pos: position{line: 293, col: 10, offset: 7891},
+													alternatives: []interface{}{
+														&litMatcher{
+															pos:        position{line: 293, col: 10, offset: 7891},
+															val:        "forall",
+															ignoreCase: false,
+															want:       "\"forall\"",
+														},
+														&litMatcher{
+															pos:        position{line: 293, col: 21, offset: 7902},
+															val:        "∀",
+															ignoreCase: false,
+															want:       "\"∀\"",
+														},
+													},
+												},
+												&ruleRefExpr{
+													pos:  position{line: 536, col: 16, offset: 15455},
+													name: "_",
+												},
+												&litMatcher{
+													pos:        position{line: 536, col: 18, offset: 15457},
+													val:        "(",
+													ignoreCase: false,
+													want:       "\"(\"",
+												},
+												&ruleRefExpr{
+													pos:  position{line: 536, col: 22, offset: 15461},
+													name: "_",
+												},
+												&labeledExpr{
+													pos:   position{line: 536, col: 24, offset: 15463},
+													label: "label",
+													expr: &choiceExpr{
+														pos: position{line: 124, col: 20, offset: 2920},
+														alternatives: []interface{}{
+															&actionExpr{
+																pos: position{line: 124, col: 20, offset: 2920},
+																run: (*parser).callonExpression327,
+																expr: &seqExpr{
+																	pos: position{line: 124, col: 20, offset: 2920},
+																	exprs: []interface{}{
+																		&andExpr{
+																			pos: position{line: 124, col: 20, offset: 2920},
+																			expr: &seqExpr{
+																				pos: position{line: 124, col: 22, offset: 2922},
+																				exprs: []interface{}{
+																					&choiceExpr{
+																						pos: position{line: 221, col: 5, offset: 5657},
+																						alternatives: []interface{}{
+																							&actionExpr{
+																								pos: position{line: 221, col: 5, offset: 5657},
+																								run: (*parser).callonExpression332,
+																								expr: &litMatcher{
+																									pos:        position{line: 221, col: 5, offset: 5657},
+																									val:        "Natural/build",
+																									ignoreCase: false,
+																									want:       "\"Natural/build\"",
+																								},
+																							},
+																							&actionExpr{
+																								pos: position{line: 222, col: 5, offset: 5706},
+																								run: (*parser).callonExpression334,
+																								expr: &litMatcher{
+																									pos:        position{line: 222, col: 5, offset: 5706},
+																									val:        "Natural/fold",
+																									ignoreCase: false,
+																									want:       "\"Natural/fold\"",
+																								},
+																							},
+																							&actionExpr{
+																								pos: position{line: 223, col: 5, offset: 5753},
+																								run: (*parser).callonExpression336,
+																								expr: &litMatcher{
+																									pos:        position{line: 223, col: 5, offset: 5753},
+																									val:        "Natural/isZero",
+																									ignoreCase: false,
+																									want:       "\"Natural/isZero\"",
+																								},
+																							},
+																							&actionExpr{
+																								pos: position{line: 224, col: 5, offset: 5804},
+																								run: (*parser).callonExpression338,
+																								expr: &litMatcher{
+																									pos:        position{line: 224, col: 5, offset: 5804},
+																									val:        "Natural/even",
+																									ignoreCase: false,
+																									want:       "\"Natural/even\"",
+																								},
+																							},
+																							&actionExpr{
+																								pos: position{line: 225, col: 5, offset: 5851},
+																								run: (*parser).callonExpression340,
+																								expr: &litMatcher{
+																									pos:        position{line: 225, col: 5, offset: 5851},
+																									val:        "Natural/odd",
+																									ignoreCase: false,
+																									want:       "\"Natural/odd\"",
+																								},
+																							},
+																							&actionExpr{
+																								pos: position{line: 226, col: 5, offset: 5896},
+																								run: (*parser).callonExpression342,
+																								expr: &litMatcher{
+																									pos:        position{line: 226, col: 5, offset: 5896},
+																									val:        "Natural/toInteger",
+																									ignoreCase: false,
+																									want:       "\"Natural/toInteger\"",
+																								},
+																							},
+																							&actionExpr{
+																								pos: position{line: 227, col: 5, offset: 5953},
+																								run: (*parser).callonExpression344,
+																								expr: &litMatcher{
+																									pos:        position{line: 227, col: 5, offset: 5953},
+																									val:        "Natural/show",
+																									ignoreCase: false,
+																									want:       "\"Natural/show\"",
+																								},
+																							},
+																							&actionExpr{
+																								pos: position{line: 228, col: 5, offset: 6000},
+																								run: (*parser).callonExpression346,
+																								expr: &litMatcher{
+																									pos:        position{line: 228, col: 5, offset: 6000},
+																									val:        "Natural/subtract",
+																									ignoreCase: false,
+																									want:       "\"Natural/subtract\"",
+																								},
+																							},
+																							&actionExpr{
+																								pos: position{line: 229, col: 5, offset: 6055},
+																								run: (*parser).callonExpression348,
+																								expr: &litMatcher{
+																									pos:        position{line: 229, col: 5, offset: 6055},
+																									val:        "Integer/toDouble",
+																									ignoreCase: false,
+																									want:       "\"Integer/toDouble\"",
+																								},
+																							},
+																							&actionExpr{
+																								pos: position{line: 230, col: 5, offset: 6110},
+																								run: (*parser).callonExpression350,
+																								expr: &litMatcher{
+																									pos:        position{line: 230, col: 5, offset: 6110},
+																									val:        "Integer/show",
+																									ignoreCase: false,
+																									want:       "\"Integer/show\"",
+																								},
+																							},
+																							&actionExpr{
+																								pos: position{line: 231, col: 5, offset: 6157},
+																								run: (*parser).callonExpression352,
+																								expr: &litMatcher{
+																									pos:        position{line: 231, col: 5, offset: 6157},
+																									val:        "Double/show",
+																									ignoreCase: false,
+																									want:       "\"Double/show\"",
+																								},
+																							},
+																							&actionExpr{
+																								pos: position{line: 232, col: 5, offset: 6202},
+																								run: (*parser).callonExpression354,
+																								expr: &litMatcher{
+																									pos:        position{line: 232, col: 5, offset: 6202},
+																									val:        "List/build",
+																									ignoreCase: false,
+																									want:       "\"List/build\"",
+																								},
+																							},
+																							&actionExpr{
+																								pos: position{line: 233, col: 5, offset: 6245},
+																								run: (*parser).callonExpression356,
+																								expr: &litMatcher{
+																									pos:        position{line: 233, col: 5, offset: 6245},
+																									val:        "List/fold",
+																									ignoreCase: false,
+																									want:       "\"List/fold\"",
+																								},
+																							},
+																							&actionExpr{
+																								pos: position{line: 234, col: 5, offset: 6286},
+																								run: (*parser).callonExpression358,
+																								expr: &litMatcher{
+																									pos:        position{line: 234, col: 5, offset: 6286},
+																									val:        "List/length",
+																									ignoreCase: false,
+																									want:       "\"List/length\"",
+																								},
+																							},
+																							&actionExpr{
+																								pos: position{line: 235, col: 5, offset: 6331},
+																								run: (*parser).callonExpression360,
+																								expr: &litMatcher{
+																									pos:        position{line: 235, col: 5, offset: 6331},
+																									val:        "List/head",
+																									ignoreCase: false,
+																									want:       "\"List/head\"",
+																								},
+																							},
+																							&actionExpr{
+																								pos: position{line: 236, col: 5, offset: 6372},
+																								run: (*parser).callonExpression362,
+																								expr: &litMatcher{
+																									pos:        position{line: 236, col: 5, offset: 6372},
+																									val:        "List/last",
+																									ignoreCase: false,
+																									want:       "\"List/last\"",
+																								},
+																							},
+																							&actionExpr{
+																								pos: position{line: 237, col: 5, offset: 6413},
+																								run: (*parser).callonExpression364,
+																								expr: &litMatcher{
+																									pos:        position{line: 237, col: 5, offset: 6413},
+																									val:        "List/indexed",
+																									ignoreCase: false,
+																									want:       "\"List/indexed\"",
+																								},
+																							},
+																							&actionExpr{
+																								pos: position{line: 238, col: 5, offset: 6460},
+																								run: (*parser).callonExpression366,
+																								expr: &litMatcher{
+																									pos:        position{line: 238, col: 5, offset: 6460},
+																									val:        "List/reverse",
+																									ignoreCase: false,
+																									want:       "\"List/reverse\"",
+																								},
+																							},
+																							&actionExpr{
+																								pos: position{line: 239, col: 5, offset: 6507},
+																								run: (*parser).callonExpression368,
+																								expr: &litMatcher{
+																									pos:        position{line: 239, col: 5, offset: 6507},
+																									val:        "Optional/build",
+																									ignoreCase: false,
+																									want:       "\"Optional/build\"",
+																								},
+																							},
+																							&actionExpr{
+																								pos: position{line: 240, col: 5, offset: 6558},
+																								run: (*parser).callonExpression370,
+																								expr: &litMatcher{
+																									pos:        position{line: 240, col: 5, offset: 6558},
+																									val:        "Optional/fold",
+																									ignoreCase: false,
+																									want:       "\"Optional/fold\"",
+																								},
+																							},
+																							&actionExpr{
+																								pos: position{line: 241, col: 5, offset: 6607},
+																								run: (*parser).callonExpression372,
+																								expr: &litMatcher{
+																									pos:        position{line: 241, col: 5, offset: 6607},
+																									val:        "Text/show",
+																									ignoreCase: false,
+																									want:       "\"Text/show\"",
+																								},
+																							},
+																							&actionExpr{
+																								pos: position{line: 242, col: 5, offset: 6648},
+																								run: (*parser).callonExpression374,
+																								expr: &litMatcher{
+																									pos:        position{line: 242, col: 5, offset: 6648},
+																									val:        "Bool",
+																									ignoreCase: false,
+																									want:       "\"Bool\"",
+																								},
+																							},
+																							&actionExpr{
+																								pos: position{line: 243, col: 5, offset: 6680},
+																								run: (*parser).callonExpression376,
+																								expr: &litMatcher{
+																									pos:        position{line: 243, col: 5, offset: 6680},
+																									val:        "True",
+																									ignoreCase: false,
+																									want:       "\"True\"",
+																								},
+																							},
+																							&actionExpr{
+																								pos: position{line: 244, col: 5, offset: 6712},
+																								run: (*parser).callonExpression378,
+																								expr: &litMatcher{
+																									pos:        position{line: 244, col: 5, offset: 6712},
+																									val:        "False",
+																									ignoreCase: false,
+																									want:       "\"False\"",
+																								},
+																							},
+																							&actionExpr{
+																								pos: position{line: 245, col: 5, offset: 6746},
+																								run: (*parser).callonExpression380,
+																								expr: &litMatcher{
+																									pos:        position{line: 245, col: 5, offset: 6746},
+																									val:        "Optional",
+																									ignoreCase: false,
+																									want:       "\"Optional\"",
+																								},
+																							},
+																							&actionExpr{
+																								pos: position{line: 246, col: 5, offset: 6786},
+																								run: (*parser).callonExpression382,
+																								expr: &litMatcher{
+																									pos:        position{line: 246, col: 5, offset: 6786},
+																									val:        "Natural",
+																									ignoreCase: false,
+																									want:       "\"Natural\"",
+																								},
+																							},
+																							&actionExpr{
+																								pos: position{line: 247, col: 5, offset: 6824},
+																								run: (*parser).callonExpression384,
+																								expr: &litMatcher{
+																									pos:        position{line: 247, col: 5, offset: 6824},
+																									val:        "Integer",
+																									ignoreCase: false,
+																									want:       "\"Integer\"",
+																								},
+																							},
+																							&actionExpr{
+																								pos: position{line: 248, col: 5, offset: 6862},
+																								run: (*parser).callonExpression386,
+																								expr: &litMatcher{
+																									pos:        position{line: 248, col: 5, offset: 6862},
+																									val:        "Double",
+																									ignoreCase: false,
+																									want:       "\"Double\"",
+																								},
+																							},
+																							&actionExpr{
+																								pos: position{line: 249, col: 5, offset: 6898},
+																								run: (*parser).callonExpression388,
+																								expr: &litMatcher{
+																									pos:        position{line: 249, col: 5, offset: 6898},
+																									val:        "Text",
+																									ignoreCase: false,
+																									want:       "\"Text\"",
+																								},
+																							},
+																							&actionExpr{
+																								pos: position{line: 250, col: 5, offset: 6930},
+																								run: (*parser).callonExpression390,
+																								expr: &litMatcher{
+																									pos:        position{line: 250, col: 5, offset: 6930},
+																									val:        "List",
+																									ignoreCase: false,
+																									want:       "\"List\"",
+																								},
+																							},
+																							&actionExpr{
+																								pos: position{line: 251, col: 5, offset: 6962},
+																								run: (*parser).callonExpression392,
+																								expr: &litMatcher{
+																									pos:        position{line: 251, col: 5, offset: 6962},
+																									val:        "None",
+																									ignoreCase: false,
+																									want:       "\"None\"",
+																								},
+																							},
+																							&actionExpr{
+																								pos: position{line: 252, col: 5, offset: 6994},
+																								run: (*parser).callonExpression394,
+																								expr: &litMatcher{
+																									pos:        position{line: 252, col: 5, offset: 6994},
+																									val:        "Type",
+																									ignoreCase: false,
+																									want:       "\"Type\"",
+																								},
+																							},
+																							&actionExpr{
+																								pos: position{line: 253, col: 5, offset: 7026},
+																								run: (*parser).callonExpression396,
+																								expr: &litMatcher{
+																									pos:        position{line: 253, col: 5, offset: 7026},
+																									val:        "Kind",
+																									ignoreCase: false,
+																									want:       "\"Kind\"",
+																								},
+																							},
+																							&actionExpr{
+																								pos: position{line: 254, col: 5, offset: 7058},
+																								run: (*parser).callonExpression398,
+																								expr: &litMatcher{
+																									pos:        position{line: 254, col: 5, offset: 7058},
+																									val:        "Sort",
+																									ignoreCase: false,
+																									want:       "\"Sort\"",
+																								},
+																							},
+																						},
+																					},
+																					&charClassMatcher{
+																						pos:        position{line: 111, col: 23, offset: 2468},
+																						val:        "[_/-A-Za-z0-9]",
+																						chars:      []rune{'_', '/', '-'},
+																						ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																			},
 																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 254, col: 5, offset: 7058},
-																		run: (*parser).callonExpression526,
-																		expr: &litMatcher{
-																			pos:        position{line: 254, col: 5, offset: 7058},
-																			val:        "Sort",
-																			ignoreCase: false,
+																		&labeledExpr{
+																			pos:   position{line: 124, col: 52, offset: 2952},
+																			label: "label",
+																			expr: &choiceExpr{
+																				pos: position{line: 121, col: 9, offset: 2802},
+																				alternatives: []interface{}{
+																					&actionExpr{
+																						pos: position{line: 121, col: 9, offset: 2802},
+																						run: (*parser).callonExpression403,
+																						expr: &seqExpr{
+																							pos: position{line: 121, col: 9, offset: 2802},
+																							exprs: []interface{}{
+																								&litMatcher{
+																									pos:        position{line: 121, col: 9, offset: 2802},
+																									val:        "`",
+																									ignoreCase: false,
+																									want:       "\"`\"",
+																								},
+																								&labeledExpr{
+																									pos:   position{line: 121, col: 13, offset: 2806},
+																									label: "label",
+																									expr: &actionExpr{
+																										pos: position{line: 119, col: 15, offset: 2743},
+																										run: (*parser).callonExpression407,
+																										expr: &oneOrMoreExpr{
+																											pos: position{line: 119, col: 15, offset: 2743},
+																											expr: &charClassMatcher{
+																												pos:        position{line: 118, col: 19, offset: 2706},
+																												val:        "[ -_a-~]",
+																												ranges:     []rune{' ', '_', 'a', '~'},
+																												ignoreCase: false,
+																												inverted:   false,
+																											},
+																										},
+																									},
+																								},
+																								&litMatcher{
+																									pos:        position{line: 121, col: 31, offset: 2824},
+																									val:        "`",
+																									ignoreCase: false,
+																									want:       "\"`\"",
+																								},
+																							},
+																						},
+																					},
+																					&actionExpr{
+																						pos: position{line: 122, col: 9, offset: 2858},
+																						run: (*parser).callonExpression411,
+																						expr: &labeledExpr{
+																							pos:   position{line: 122, col: 9, offset: 2858},
+																							label: "label",
+																							expr: &choiceExpr{
+																								pos: position{line: 112, col: 15, offset: 2499},
+																								alternatives: []interface{}{
+																									&actionExpr{
+																										pos: position{line: 112, col: 15, offset: 2499},
+																										run: (*parser).callonExpression414,
+																										expr: &seqExpr{
+																											pos: position{line: 112, col: 15, offset: 2499},
+																											exprs: []interface{}{
+																												&choiceExpr{
+																													pos: position{line: 276, col: 5, offset: 7537},
+																													alternatives: []interface{}{
+																														&litMatcher{
+																															pos:        position{line: 256, col: 6, offset: 7094},
+																															val:        "if",
+																															ignoreCase: false,
+																															want:       "\"if\"",
+																														},
+																														&litMatcher{
+																															pos:        position{line: 257, col: 8, offset: 7108},
+																															val:        "then",
+																															ignoreCase: false,
+																															want:       "\"then\"",
+																														},
+																														&litMatcher{
+																															pos:        position{line: 258, col: 8, offset: 7124},
+																															val:        "else",
+																															ignoreCase: false,
+																															want:       "\"else\"",
+																														},
+																														&litMatcher{
+																															pos:        position{line: 259, col: 7, offset: 7139},
+																															val:        "let",
+																															ignoreCase: false,
+																															want:       "\"let\"",
+																														},
+																														&litMatcher{
+																															pos:        position{line: 260, col: 6, offset: 7152},
+																															val:        "in",
+																															ignoreCase: false,
+																															want:       "\"in\"",
+																														},
+																														&litMatcher{
+																															pos:        position{line: 262, col: 9, offset: 7179},
+																															val:        "using",
+																															ignoreCase: false,
+																															want:       "\"using\"",
+																														},
+																														&actionExpr{
+																															pos: position{line: 264, col: 11, offset: 7217},
+																															run: (*parser).callonExpression423,
+																															expr: &litMatcher{
+																																pos:        position{line: 264, col: 11, offset: 7217},
+																																val:        "missing",
+																																ignoreCase: false,
+																																want:       "\"missing\"",
+																															},
+																														},
+																														&litMatcher{
+																															pos:        position{line: 271, col: 10, offset: 7370},
+																															val:        "assert",
+																															ignoreCase: false,
+																															want:       "\"assert\"",
+																														},
+																														&litMatcher{
+																															pos:        position{line: 261, col: 6, offset: 7164},
+																															val:        "as",
+																															ignoreCase: false,
+																															want:       "\"as\"",
+																														},
+																														&litMatcher{
+																															pos:        position{line: 265, col: 8, offset: 7262},
+																															val:        "True",
+																															ignoreCase: false,
+																															want:       "\"True\"",
+																														},
+																														&litMatcher{
+																															pos:        position{line: 266, col: 9, offset: 7279},
+																															val:        "False",
+																															ignoreCase: false,
+																															want:       "\"False\"",
+																														},
+																														&litMatcher{
+																															pos:        position{line: 267, col: 12, offset: 7300},
+																															val:        "Infinity",
+																															ignoreCase: false,
+																															want:       "\"Infinity\"",
+																														},
+																														&litMatcher{
+																															pos:        position{line: 268, col: 7, offset: 7319},
+																															val:        "NaN",
+																															ignoreCase: false,
+																															want:       "\"NaN\"",
+																														},
+																														&litMatcher{
+																															pos:        position{line: 263, col: 9, offset: 7197},
+																															val:        "merge",
+																															ignoreCase: false,
+																															want:       "\"merge\"",
+																														},
+																														&litMatcher{
+																															pos:        position{line: 269, col: 8, offset: 7334},
+																															val:        "Some",
+																															ignoreCase: false,
+																															want:       "\"Some\"",
+																														},
+																														&litMatcher{
+																															pos:        position{line: 270, col: 9, offset: 7351},
+																															val:        "toMap",
+																															ignoreCase: false,
+																															want:       "\"toMap\"",
+																														},
+																													},
+																												},
+																												&oneOrMoreExpr{
+																													pos: position{line: 112, col: 23, offset: 2507},
+																													expr: &charClassMatcher{
+																														pos:        position{line: 111, col: 23, offset: 2468},
+																														val:        "[_/-A-Za-z0-9]",
+																														chars:      []rune{'_', '/', '-'},
+																														ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
+																														ignoreCase: false,
+																														inverted:   false,
+																													},
+																												},
+																											},
+																										},
+																									},
+																									&actionExpr{
+																										pos: position{line: 113, col: 13, offset: 2571},
+																										run: (*parser).callonExpression436,
+																										expr: &seqExpr{
+																											pos: position{line: 113, col: 13, offset: 2571},
+																											exprs: []interface{}{
+																												&notExpr{
+																													pos: position{line: 113, col: 13, offset: 2571},
+																													expr: &choiceExpr{
+																														pos: position{line: 276, col: 5, offset: 7537},
+																														alternatives: []interface{}{
+																															&litMatcher{
+																																pos:        position{line: 256, col: 6, offset: 7094},
+																																val:        "if",
+																																ignoreCase: false,
+																																want:       "\"if\"",
+																															},
+																															&litMatcher{
+																																pos:        position{line: 257, col: 8, offset: 7108},
+																																val:        "then",
+																																ignoreCase: false,
+																																want:       "\"then\"",
+																															},
+																															&litMatcher{
+																																pos:        position{line: 258, col: 8, offset: 7124},
+																																val:        "else",
+																																ignoreCase: false,
+																																want:       "\"else\"",
+																															},
+																															&litMatcher{
+																																pos:        position{line: 259, col: 7, offset: 7139},
+																																val:        "let",
+																																ignoreCase: false,
+																																want:       "\"let\"",
+																															},
+																															&litMatcher{
+																																pos:        position{line: 260, col: 6, offset: 7152},
+																																val:        "in",
+																																ignoreCase: false,
+																																want:       "\"in\"",
+																															},
+																															&litMatcher{
+																																pos:        position{line: 262, col: 9, offset: 7179},
+																																val:        "using",
+																																ignoreCase: false,
+																																want:       "\"using\"",
+																															},
+																															&actionExpr{
+																																pos: position{line: 264, col: 11, offset: 7217},
+																																run: (*parser).callonExpression446,
+																																expr: &litMatcher{
+																																	pos:        position{line: 264, col: 11, offset: 7217},
+																																	val:        "missing",
+																																	ignoreCase: false,
+																																	want:       "\"missing\"",
+																																},
+																															},
+																															&litMatcher{
+																																pos:        position{line: 271, col: 10, offset: 7370},
+																																val:        "assert",
+																																ignoreCase: false,
+																																want:       "\"assert\"",
+																															},
+																															&litMatcher{
+																																pos:        position{line: 261, col: 6, offset: 7164},
+																																val:        "as",
+																																ignoreCase: false,
+																																want:       "\"as\"",
+																															},
+																															&litMatcher{
+																																pos:        position{line: 265, col: 8, offset: 7262},
+																																val:        "True",
+																																ignoreCase: false,
+																																want:       "\"True\"",
+																															},
+																															&litMatcher{
+																																pos:        position{line: 266, col: 9, offset: 7279},
+																																val:        "False",
+																																ignoreCase: false,
+																																want:       "\"False\"",
+																															},
+																															&litMatcher{
+																																pos:        position{line: 267, col: 12, offset: 7300},
+																																val:        "Infinity",
+																																ignoreCase: false,
+																																want:       "\"Infinity\"",
+																															},
+																															&litMatcher{
+																																pos:        position{line: 268, col: 7, offset: 7319},
+																																val:        "NaN",
+																																ignoreCase: false,
+																																want:       "\"NaN\"",
+																															},
+																															&litMatcher{
+																																pos:        position{line: 263, col: 9, offset: 7197},
+																																val:        "merge",
+																																ignoreCase: false,
+																																want:       "\"merge\"",
+																															},
+																															&litMatcher{
+																																pos:        position{line: 269, col: 8, offset: 7334},
+																																val:        "Some",
+																																ignoreCase: false,
+																																want:       "\"Some\"",
+																															},
+																															&litMatcher{
+																																pos:        position{line: 270, col: 9, offset: 7351},
+																																val:        "toMap",
+																																ignoreCase: false,
+																																want:       "\"toMap\"",
+																															},
+																														},
+																													},
+																												},
+																												&charClassMatcher{
+																													pos:        position{line: 110, col: 24, offset: 2434},
+																													val:        "[_A-Za-z]",
+																													chars:      []rune{'_'},
+																													ranges:     []rune{'A', 'Z', 'a', 'z'},
+																													ignoreCase: false,
+																													inverted:   false,
+																												},
+																												&zeroOrMoreExpr{
+																													pos: position{line: 113, col: 43, offset: 2601},
+																													expr: &charClassMatcher{
+																														pos:        position{line: 111, col: 23, offset: 2468},
+																														val:        "[_/-A-Za-z0-9]",
+																														chars:      []rune{'_', '/', '-'},
+																														ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
+																														ignoreCase: false,
+																														inverted:   false,
+																													},
+																												},
+																											},
+																										},
+																									},
+																								},
+																							},
+																						},
+																					},
+																				},
+																			},
 																		},
 																	},
 																},
 															},
-														},
-														&labeledExpr{
-															pos:   position{line: 125, col: 29, offset: 3014},
-															label: "label",
-															expr: &choiceExpr{
-																pos: position{line: 121, col: 9, offset: 2802},
-																alternatives: []interface{}{
-																	&actionExpr{
-																		pos: position{line: 121, col: 9, offset: 2802},
-																		run: (*parser).callonExpression530,
-																		expr: &seqExpr{
-																			pos: position{line: 121, col: 9, offset: 2802},
-																			exprs: []interface{}{
-																				&litMatcher{
-																					pos:        position{line: 121, col: 9, offset: 2802},
-																					val:        "`",
-																					ignoreCase: false,
-																				},
-																				&labeledExpr{
-																					pos:   position{line: 121, col: 13, offset: 2806},
-																					label: "label",
-																					expr: &actionExpr{
-																						pos: position{line: 119, col: 15, offset: 2743},
-																						run: (*parser).callonExpression534,
-																						expr: &oneOrMoreExpr{
-																							pos: position{line: 119, col: 15, offset: 2743},
-																							expr: &charClassMatcher{
-																								pos:        position{line: 118, col: 19, offset: 2706},
-																								val:        "[ -_a-~]",
-																								ranges:     []rune{' ', '_', 'a', '~'},
-																								ignoreCase: false,
-																								inverted:   false,
-																							},
+															&actionExpr{
+																pos: position{line: 125, col: 19, offset: 3004},
+																run: (*parser).callonExpression460,
+																expr: &seqExpr{
+																	pos: position{line: 125, col: 19, offset: 3004},
+																	exprs: []interface{}{
+																		&notExpr{
+																			pos: position{line: 125, col: 19, offset: 3004},
+																			expr: &choiceExpr{
+																				pos: position{line: 221, col: 5, offset: 5657},
+																				alternatives: []interface{}{
+																					&actionExpr{
+																						pos: position{line: 221, col: 5, offset: 5657},
+																						run: (*parser).callonExpression464,
+																						expr: &litMatcher{
+																							pos:        position{line: 221, col: 5, offset: 5657},
+																							val:        "Natural/build",
+																							ignoreCase: false,
+																							want:       "\"Natural/build\"",
+																						},
+																					},
+																					&actionExpr{
+																						pos: position{line: 222, col: 5, offset: 5706},
+																						run: (*parser).callonExpression466,
+																						expr: &litMatcher{
+																							pos:        position{line: 222, col: 5, offset: 5706},
+																							val:        "Natural/fold",
+																							ignoreCase: false,
+																							want:       "\"Natural/fold\"",
+																						},
+																					},
+																					&actionExpr{
+																						pos: position{line: 223, col: 5, offset: 5753},
+																						run: (*parser).callonExpression468,
+																						expr: &litMatcher{
+																							pos:        position{line: 223, col: 5, offset: 5753},
+																							val:        "Natural/isZero",
+																							ignoreCase: false,
+																							want:       "\"Natural/isZero\"",
+																						},
+																					},
+																					&actionExpr{
+																						pos: position{line: 224, col: 5, offset: 5804},
+																						run: (*parser).callonExpression470,
+																						expr: &litMatcher{
+																							pos:        position{line: 224, col: 5, offset: 5804},
+																							val:        "Natural/even",
+																							ignoreCase: false,
+																							want:       "\"Natural/even\"",
+																						},
+																					},
+																					&actionExpr{
+																						pos: position{line: 225, col: 5, offset: 5851},
+																						run: (*parser).callonExpression472,
+																						expr: &litMatcher{
+																							pos:        position{line: 225, col: 5, offset: 5851},
+																							val:        "Natural/odd",
+																							ignoreCase: false,
+																							want:       "\"Natural/odd\"",
+																						},
+																					},
+																					&actionExpr{
+																						pos: position{line: 226, col: 5, offset: 5896},
+																						run: (*parser).callonExpression474,
+																						expr: &litMatcher{
+																							pos:        position{line: 226, col: 5, offset: 5896},
+																							val:        "Natural/toInteger",
+																							ignoreCase: false,
+																							want:       "\"Natural/toInteger\"",
+																						},
+																					},
+																					&actionExpr{
+																						pos: position{line: 227, col: 5, offset: 5953},
+																						run: (*parser).callonExpression476,
+																						expr: &litMatcher{
+																							pos:        position{line: 227, col: 5, offset: 5953},
+																							val:        "Natural/show",
+																							ignoreCase: false,
+																							want:       "\"Natural/show\"",
+																						},
+																					},
+																					&actionExpr{
+																						pos: position{line: 228, col: 5, offset: 6000},
+																						run: (*parser).callonExpression478,
+																						expr: &litMatcher{
+																							pos:        position{line: 228, col: 5, offset: 6000},
+																							val:        "Natural/subtract",
+																							ignoreCase: false,
+																							want:       "\"Natural/subtract\"",
+																						},
+																					},
+																					&actionExpr{
+																						pos: position{line: 229, col: 5, offset: 6055},
+																						run: (*parser).callonExpression480,
+																						expr: &litMatcher{
+																							pos:        position{line: 229, col: 5, offset: 6055},
+																							val:        "Integer/toDouble",
+																							ignoreCase: false,
+																							want:       "\"Integer/toDouble\"",
+																						},
+																					},
+																					&actionExpr{
+																						pos: position{line: 230, col: 5, offset: 6110},
+																						run: (*parser).callonExpression482,
+																						expr: &litMatcher{
+																							pos:        position{line: 230, col: 5, offset: 6110},
+																							val:        "Integer/show",
+																							ignoreCase: false,
+																							want:       "\"Integer/show\"",
+																						},
+																					},
+																					&actionExpr{
+																						pos: position{line: 231, col: 5, offset: 6157},
+																						run: (*parser).callonExpression484,
+																						expr: &litMatcher{
+																							pos:        position{line: 231, col: 5, offset: 6157},
+																							val:        "Double/show",
+																							ignoreCase: false,
+																							want:       "\"Double/show\"",
+																						},
+																					},
+																					&actionExpr{
+																						pos: position{line: 232, col: 5, offset: 6202},
+																						run: (*parser).callonExpression486,
+																						expr: &litMatcher{
+																							pos:        position{line: 232, col: 5, offset: 6202},
+																							val:        "List/build",
+																							ignoreCase: false,
+																							want:       "\"List/build\"",
+																						},
+																					},
+																					&actionExpr{
+																						pos: position{line: 233, col: 5, offset: 6245},
+																						run: (*parser).callonExpression488,
+																						expr: &litMatcher{
+																							pos:        position{line: 233, col: 5, offset: 6245},
+																							val:        "List/fold",
+																							ignoreCase: false,
+																							want:       "\"List/fold\"",
+																						},
+																					},
+																					&actionExpr{
+																						pos: position{line: 234, col: 5, offset: 6286},
+																						run: (*parser).callonExpression490,
+																						expr: &litMatcher{
+																							pos:        position{line: 234, col: 5, offset: 6286},
+																							val:        "List/length",
+																							ignoreCase: false,
+																							want:       "\"List/length\"",
+																						},
+																					},
+																					&actionExpr{
+																						pos: position{line: 235, col: 5, offset: 6331},
+																						run: (*parser).callonExpression492,
+																						expr: &litMatcher{
+																							pos:        position{line: 235, col: 5, offset: 6331},
+																							val:        "List/head",
+																							ignoreCase: false,
+																							want:       "\"List/head\"",
+																						},
+																					},
+																					&actionExpr{
+																						pos: position{line: 236, col: 5, offset: 6372},
+																						run: (*parser).callonExpression494,
+																						expr: &litMatcher{
+																							pos:        position{line: 236, col: 5, offset: 6372},
+																							val:        "List/last",
+																							ignoreCase: false,
+																							want:       "\"List/last\"",
+																						},
+																					},
+																					&actionExpr{
+																						pos: position{line: 237, col: 5, offset: 6413},
+																						run: (*parser).callonExpression496,
+																						expr: &litMatcher{
+																							pos:        position{line: 237, col: 5, offset: 6413},
+																							val:        "List/indexed",
+																							ignoreCase: false,
+																							want:       "\"List/indexed\"",
+																						},
+																					},
+																					&actionExpr{
+																						pos: position{line: 238, col: 5, offset: 6460},
+																						run: (*parser).callonExpression498,
+																						expr: &litMatcher{
+																							pos:        position{line: 238, col: 5, offset: 6460},
+																							val:        "List/reverse",
+																							ignoreCase: false,
+																							want:       "\"List/reverse\"",
+																						},
+																					},
+																					&actionExpr{
+																						pos: position{line: 239, col: 5, offset: 6507},
+																						run: (*parser).callonExpression500,
+																						expr: &litMatcher{
+																							pos:        position{line: 239, col: 5, offset: 6507},
+																							val:        "Optional/build",
+																							ignoreCase: false,
+																							want:       "\"Optional/build\"",
+																						},
+																					},
+																					&actionExpr{
+																						pos: position{line: 240, col: 5, offset: 6558},
+																						run: (*parser).callonExpression502,
+																						expr: &litMatcher{
+																							pos:        position{line: 240, col: 5, offset: 6558},
+																							val:        "Optional/fold",
+																							ignoreCase: false,
+																							want:       "\"Optional/fold\"",
+																						},
+																					},
+																					&actionExpr{
+																						pos: position{line: 241, col: 5, offset: 6607},
+																						run: (*parser).callonExpression504,
+																						expr: &litMatcher{
+																							pos:        position{line: 241, col: 5, offset: 6607},
+																							val:        "Text/show",
+																							ignoreCase: false,
+																							want:       "\"Text/show\"",
+																						},
+																					},
+																					&actionExpr{
+																						pos: position{line: 242, col: 5, offset: 6648},
+																						run: (*parser).callonExpression506,
+																						expr: &litMatcher{
+																							pos:        position{line: 242, col: 5, offset: 6648},
+																							val:        "Bool",
+																							ignoreCase: false,
+																							want:       "\"Bool\"",
+																						},
+																					},
+																					&actionExpr{
+																						pos: position{line: 243, col: 5, offset: 6680},
+																						run: (*parser).callonExpression508,
+																						expr: &litMatcher{
+																							pos:        position{line: 243, col: 5, offset: 6680},
+																							val:        "True",
+																							ignoreCase: false,
+																							want:       "\"True\"",
+																						},
+																					},
+																					&actionExpr{
+																						pos: position{line: 244, col: 5, offset: 6712},
+																						run: (*parser).callonExpression510,
+																						expr: &litMatcher{
+																							pos:        position{line: 244, col: 5, offset: 6712},
+																							val:        "False",
+																							ignoreCase: false,
+																							want:       "\"False\"",
+																						},
+																					},
+																					&actionExpr{
+																						pos: position{line: 245, col: 5, offset: 6746},
+																						run: (*parser).callonExpression512,
+																						expr: &litMatcher{
+																							pos:        position{line: 245, col: 5, offset: 6746},
+																							val:        "Optional",
+																							ignoreCase: false,
+																							want:       "\"Optional\"",
+																						},
+																					},
+																					&actionExpr{
+																						pos: position{line: 246, col: 5, offset: 6786},
+																						run: (*parser).callonExpression514,
+																						expr: &litMatcher{
+																							pos:        position{line: 246, col: 5, offset: 6786},
+																							val:        "Natural",
+																							ignoreCase: false,
+																							want:       "\"Natural\"",
+																						},
+																					},
+																					&actionExpr{
+																						pos: position{line: 247, col: 5, offset: 6824},
+																						run: (*parser).callonExpression516,
+																						expr: &litMatcher{
+																							pos:        position{line: 247, col: 5, offset: 6824},
+																							val:        "Integer",
+																							ignoreCase: false,
+																							want:       "\"Integer\"",
+																						},
+																					},
+																					&actionExpr{
+																						pos: position{line: 248, col: 5, offset: 6862},
+																						run: (*parser).callonExpression518,
+																						expr: &litMatcher{
+																							pos:        position{line: 248, col: 5, offset: 6862},
+																							val:        "Double",
+																							ignoreCase: false,
+																							want:       "\"Double\"",
+																						},
+																					},
+																					&actionExpr{
+																						pos: position{line: 249, col: 5, offset: 6898},
+																						run: (*parser).callonExpression520,
+																						expr: &litMatcher{
+																							pos:        position{line: 249, col: 5, offset: 6898},
+																							val:        "Text",
+																							ignoreCase: false,
+																							want:       "\"Text\"",
+																						},
+																					},
+																					&actionExpr{
+																						pos: position{line: 250, col: 5, offset: 6930},
+																						run: (*parser).callonExpression522,
+																						expr: &litMatcher{
+																							pos:        position{line: 250, col: 5, offset: 6930},
+																							val:        "List",
+																							ignoreCase: false,
+																							want:       "\"List\"",
+																						},
+																					},
+																					&actionExpr{
+																						pos: position{line: 251, col: 5, offset: 6962},
+																						run: (*parser).callonExpression524,
+																						expr: &litMatcher{
+																							pos:        position{line: 251, col: 5, offset: 6962},
+																							val:        "None",
+																							ignoreCase: false,
+																							want:       "\"None\"",
+																						},
+																					},
+																					&actionExpr{
+																						pos: position{line: 252, col: 5, offset: 6994},
+																						run: (*parser).callonExpression526,
+																						expr: &litMatcher{
+																							pos:        position{line: 252, col: 5, offset: 6994},
+																							val:        "Type",
+																							ignoreCase: false,
+																							want:       "\"Type\"",
+																						},
+																					},
+																					&actionExpr{
+																						pos: position{line: 253, col: 5, offset: 7026},
+																						run: (*parser).callonExpression528,
+																						expr: &litMatcher{
+																							pos:        position{line: 253, col: 5, offset: 7026},
+																							val:        "Kind",
+																							ignoreCase: false,
+																							want:       "\"Kind\"",
+																						},
+																					},
+																					&actionExpr{
+																						pos: position{line: 254, col: 5, offset: 7058},
+																						run: (*parser).callonExpression530,
+																						expr: &litMatcher{
+																							pos:        position{line: 254, col: 5, offset: 7058},
+																							val:        "Sort",
+																							ignoreCase: false,
+																							want:       "\"Sort\"",
 																						},
 																					},
-																				},
-																				&litMatcher{
-																					pos:        position{line: 121, col: 31, offset: 2824},
-																					val:        "`",
-																					ignoreCase: false,
 																				},
 																			},
 																		},
-																	},
-																	&actionExpr{
-																		pos: position{line: 122, col: 9, offset: 2858},
-																		run: (*parser).callonExpression538,
-																		expr: &labeledExpr{
-																			pos:   position{line: 122, col: 9, offset: 2858},
+																		&labeledExpr{
+																			pos:   position{line: 125, col: 29, offset: 3014},
 																			label: "label",
 																			expr: &choiceExpr{
-																				pos: position{line: 112, col: 15, offset: 2499},
+																				pos: position{line: 121, col: 9, offset: 2802},
 																				alternatives: []interface{}{
 																					&actionExpr{
-																						pos: position{line: 112, col: 15, offset: 2499},
-																						run: (*parser).callonExpression541,
+																						pos: position{line: 121, col: 9, offset: 2802},
+																						run: (*parser).callonExpression534,
 																						expr: &seqExpr{
-																							pos: position{line: 112, col: 15, offset: 2499},
+																							pos: position{line: 121, col: 9, offset: 2802},
 																							exprs: []interface{}{
-																								&choiceExpr{
-																									pos: position{line: 274, col: 5, offset: 7396},
-																									alternatives: []interface{}{
-																										&litMatcher{
-																											pos:        position{line: 256, col: 6, offset: 7094},
-																											val:        "if",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 257, col: 8, offset: 7108},
-																											val:        "then",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 258, col: 8, offset: 7124},
-																											val:        "else",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 259, col: 7, offset: 7139},
-																											val:        "let",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 260, col: 6, offset: 7152},
-																											val:        "in",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 262, col: 9, offset: 7179},
-																											val:        "using",
-																											ignoreCase: false,
-																										},
-																										&actionExpr{
-																											pos: position{line: 264, col: 11, offset: 7217},
-																											run: (*parser).callonExpression550,
-																											expr: &litMatcher{
-																												pos:        position{line: 264, col: 11, offset: 7217},
-																												val:        "missing",
+																								&litMatcher{
+																									pos:        position{line: 121, col: 9, offset: 2802},
+																									val:        "`",
+																									ignoreCase: false,
+																									want:       "\"`\"",
+																								},
+																								&labeledExpr{
+																									pos:   position{line: 121, col: 13, offset: 2806},
+																									label: "label",
+																									expr: &actionExpr{
+																										pos: position{line: 119, col: 15, offset: 2743},
+																										run: (*parser).callonExpression538,
+																										expr: &oneOrMoreExpr{
+																											pos: position{line: 119, col: 15, offset: 2743},
+																											expr: &charClassMatcher{
+																												pos:        position{line: 118, col: 19, offset: 2706},
+																												val:        "[ -_a-~]",
+																												ranges:     []rune{' ', '_', 'a', '~'},
 																												ignoreCase: false,
+																												inverted:   false,
 																											},
 																										},
-																										&litMatcher{
-																											pos:        position{line: 261, col: 6, offset: 7164},
-																											val:        "as",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 265, col: 8, offset: 7262},
-																											val:        "True",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 266, col: 9, offset: 7279},
-																											val:        "False",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 267, col: 12, offset: 7300},
-																											val:        "Infinity",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 268, col: 7, offset: 7319},
-																											val:        "NaN",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 263, col: 9, offset: 7197},
-																											val:        "merge",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 269, col: 8, offset: 7334},
-																											val:        "Some",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 270, col: 9, offset: 7351},
-																											val:        "toMap",
-																											ignoreCase: false,
-																										},
-																										&litMatcher{
-																											pos:        position{line: 271, col: 10, offset: 7370},
-																											val:        "assert",
-																											ignoreCase: false,
-																										},
 																									},
 																								},
-																								&oneOrMoreExpr{
-																									pos: position{line: 112, col: 23, offset: 2507},
-																									expr: &charClassMatcher{
-																										pos:        position{line: 111, col: 23, offset: 2468},
-																										val:        "[_/-A-Za-z0-9]",
-																										chars:      []rune{'_', '/', '-'},
-																										ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
-																										ignoreCase: false,
-																										inverted:   false,
-																									},
+																								&litMatcher{
+																									pos:        position{line: 121, col: 31, offset: 2824},
+																									val:        "`",
+																									ignoreCase: false,
+																									want:       "\"`\"",
 																								},
 																							},
 																						},
 																					},
 																					&actionExpr{
-																						pos: position{line: 113, col: 13, offset: 2571},
-																						run: (*parser).callonExpression563,
-																						expr: &seqExpr{
-																							pos: position{line: 113, col: 13, offset: 2571},
-																							exprs: []interface{}{
-																								&notExpr{
-																									pos: position{line: 113, col: 13, offset: 2571},
-																									expr: &choiceExpr{
-																										pos: position{line: 274, col: 5, offset: 7396},
-																										alternatives: []interface{}{
-																											&litMatcher{
-																												pos:        position{line: 256, col: 6, offset: 7094},
-																												val:        "if",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 257, col: 8, offset: 7108},
-																												val:        "then",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 258, col: 8, offset: 7124},
-																												val:        "else",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 259, col: 7, offset: 7139},
-																												val:        "let",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 260, col: 6, offset: 7152},
-																												val:        "in",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 262, col: 9, offset: 7179},
-																												val:        "using",
-																												ignoreCase: false,
+																						pos: position{line: 122, col: 9, offset: 2858},
+																						run: (*parser).callonExpression542,
+																						expr: &labeledExpr{
+																							pos:   position{line: 122, col: 9, offset: 2858},
+																							label: "label",
+																							expr: &choiceExpr{
+																								pos: position{line: 112, col: 15, offset: 2499},
+																								alternatives: []interface{}{
+																									&actionExpr{
+																										pos: position{line: 112, col: 15, offset: 2499},
+																										run: (*parser).callonExpression545,
+																										expr: &seqExpr{
+																											pos: position{line: 112, col: 15, offset: 2499},
+																											exprs: []interface{}{
+																												&choiceExpr{
+																													pos: position{line: 276, col: 5, offset: 7537},
+																													alternatives: []interface{}{
+																														&litMatcher{
+																															pos:        position{line: 256, col: 6, offset: 7094},
+																															val:        "if",
+																															ignoreCase: false,
+																															want:       "\"if\"",
+																														},
+																														&litMatcher{
+																															pos:        position{line: 257, col: 8, offset: 7108},
+																															val:        "then",
+																															ignoreCase: false,
+																															want:       "\"then\"",
+																														},
+																														&litMatcher{
+																															pos:        position{line: 258, col: 8, offset: 7124},
+																															val:        "else",
+																															ignoreCase: false,
+																															want:       "\"else\"",
+																														},
+																														&litMatcher{
+																															pos:        position{line: 259, col: 7, offset: 7139},
+																															val:        "let",
+																															ignoreCase: false,
+																															want:       "\"let\"",
+																														},
+																														&litMatcher{
+																															pos:        position{line: 260, col: 6, offset: 7152},
+																															val:        "in",
+																															ignoreCase: false,
+																															want:       "\"in\"",
+																														},
+																														&litMatcher{
+																															pos:        position{line: 262, col: 9, offset: 7179},
+																															val:        "using",
+																															ignoreCase: false,
+																															want:       "\"using\"",
+																														},
+																														&actionExpr{
+																															pos: position{line: 264, col: 11, offset: 7217},
+																															run: (*parser).callonExpression554,
+																															expr: &litMatcher{
+																																pos:        position{line: 264, col: 11, offset: 7217},
+																																val:        "missing",
+																																ignoreCase: false,
+																																want:       "\"missing\"",
+																															},
+																														},
+																														&litMatcher{
+																															pos:        position{line: 271, col: 10, offset: 7370},
+																															val:        "assert",
+																															ignoreCase: false,
+																															want:       "\"assert\"",
+																														},
+																														&litMatcher{
+																															pos:        position{line: 261, col: 6, offset: 7164},
+																															val:        "as",
+																															ignoreCase: false,
+																															want:       "\"as\"",
+																														},
+																														&litMatcher{
+																															pos:        position{line: 265, col: 8, offset: 7262},
+																															val:        "True",
+																															ignoreCase: false,
+																															want:       "\"True\"",
+																														},
+																														&litMatcher{
+																															pos:        position{line: 266, col: 9, offset: 7279},
+																															val:        "False",
+																															ignoreCase: false,
+																															want:       "\"False\"",
+																														},
+																														&litMatcher{
+																															pos:        position{line: 267, col: 12, offset: 7300},
+																															val:        "Infinity",
+																															ignoreCase: false,
+																															want:       "\"Infinity\"",
+																														},
+																														&litMatcher{
+																															pos:        position{line: 268, col: 7, offset: 7319},
+																															val:        "NaN",
+																															ignoreCase: false,
+																															want:       "\"NaN\"",
+																														},
+																														&litMatcher{
+																															pos:        position{line: 263, col: 9, offset: 7197},
+																															val:        "merge",
+																															ignoreCase: false,
+																															want:       "\"merge\"",
+																														},
+																														&litMatcher{
+																															pos:        position{line: 269, col: 8, offset: 7334},
+																															val:        "Some",
+																															ignoreCase: false,
+																															want:       "\"Some\"",
+																														},
+																														&litMatcher{
+																															pos:        position{line: 270, col: 9, offset: 7351},
+																															val:        "toMap",
+																															ignoreCase: false,
+																															want:       "\"toMap\"",
+																														},
+																													},
+																												},
+																												&oneOrMoreExpr{
+																													pos: position{line: 112, col: 23, offset: 2507},
+																													expr: &charClassMatcher{
+																														pos:        position{line: 111, col: 23, offset: 2468},
+																														val:        "[_/-A-Za-z0-9]",
+																														chars:      []rune{'_', '/', '-'},
+																														ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
+																														ignoreCase: false,
+																														inverted:   false,
+																													},
+																												},
 																											},
-																											&actionExpr{
-																												pos: position{line: 264, col: 11, offset: 7217},
-																												run: (*parser).callonExpression573,
-																												expr: &litMatcher{
-																													pos:        position{line: 264, col: 11, offset: 7217},
-																													val:        "missing",
+																										},
+																									},
+																									&actionExpr{
+																										pos: position{line: 113, col: 13, offset: 2571},
+																										run: (*parser).callonExpression567,
+																										expr: &seqExpr{
+																											pos: position{line: 113, col: 13, offset: 2571},
+																											exprs: []interface{}{
+																												&notExpr{
+																													pos: position{line: 113, col: 13, offset: 2571},
+																													expr: &choiceExpr{
+																														pos: position{line: 276, col: 5, offset: 7537},
+																														alternatives: []interface{}{
+																															&litMatcher{
+																																pos:        position{line: 256, col: 6, offset: 7094},
+																																val:        "if",
+																																ignoreCase: false,
+																																want:       "\"if\"",
+																															},
+																															&litMatcher{
+																																pos:        position{line: 257, col: 8, offset: 7108},
+																																val:        "then",
+																																ignoreCase: false,
+																																want:       "\"then\"",
+																															},
+																															&litMatcher{
+																																pos:        position{line: 258, col: 8, offset: 7124},
+																																val:        "else",
+																																ignoreCase: false,
+																																want:       "\"else\"",
+																															},
+																															&litMatcher{
+																																pos:        position{line: 259, col: 7, offset: 7139},
+																																val:        "let",
+																																ignoreCase: false,
+																																want:       "\"let\"",
+																															},
+																															&litMatcher{
+																																pos:        position{line: 260, col: 6, offset: 7152},
+																																val:        "in",
+																																ignoreCase: false,
+																																want:       "\"in\"",
+																															},
+																															&litMatcher{
+																																pos:        position{line: 262, col: 9, offset: 7179},
+																																val:        "using",
+																																ignoreCase: false,
+																																want:       "\"using\"",
+																															},
+																															&actionExpr{
+																																pos: position{line: 264, col: 11, offset: 7217},
+																																run: (*parser).callonExpression577,
+																																expr: &litMatcher{
+																																	pos:        position{line: 264, col: 11, offset: 7217},
+																																	val:        "missing",
+																																	ignoreCase: false,
+																																	want:       "\"missing\"",
+																																},
+																															},
+																															&litMatcher{
+																																pos:        position{line: 271, col: 10, offset: 7370},
+																																val:        "assert",
+																																ignoreCase: false,
+																																want:       "\"assert\"",
+																															},
+																															&litMatcher{
+																																pos:        position{line: 261, col: 6, offset: 7164},
+																																val:        "as",
+																																ignoreCase: false,
+																																want:       "\"as\"",
+																															},
+																															&litMatcher{
+																																pos:        position{line: 265, col: 8, offset: 7262},
+																																val:        "True",
+																																ignoreCase: false,
+																																want:       "\"True\"",
+																															},
+																															&litMatcher{
+																																pos:        position{line: 266, col: 9, offset: 7279},
+																																val:        "False",
+																																ignoreCase: false,
+																																want:       "\"False\"",
+																															},
+																															&litMatcher{
+																																pos:        position{line: 267, col: 12, offset: 7300},
+																																val:        "Infinity",
+																																ignoreCase: false,
+																																want:       "\"Infinity\"",
+																															},
+																															&litMatcher{
+																																pos:        position{line: 268, col: 7, offset: 7319},
+																																val:        "NaN",
+																																ignoreCase: false,
+																																want:       "\"NaN\"",
+																															},
+																															&litMatcher{
+																																pos:        position{line: 263, col: 9, offset: 7197},
+																																val:        "merge",
+																																ignoreCase: false,
+																																want:       "\"merge\"",
+																															},
+																															&litMatcher{
+																																pos:        position{line: 269, col: 8, offset: 7334},
+																																val:        "Some",
+																																ignoreCase: false,
+																																want:       "\"Some\"",
+																															},
+																															&litMatcher{
+																																pos:        position{line: 270, col: 9, offset: 7351},
+																																val:        "toMap",
+																																ignoreCase: false,
+																																want:       "\"toMap\"",
+																															},
+																														},
+																													},
+																												},
+																												&charClassMatcher{
+																													pos:        position{line: 110, col: 24, offset: 2434},
+																													val:        "[_A-Za-z]",
+																													chars:      []rune{'_'},
+																													ranges:     []rune{'A', 'Z', 'a', 'z'},
 																													ignoreCase: false,
+																													inverted:   false,
+																												},
+																												&zeroOrMoreExpr{
+																													pos: position{line: 113, col: 43, offset: 2601},
+																													expr: &charClassMatcher{
+																														pos:        position{line: 111, col: 23, offset: 2468},
+																														val:        "[_/-A-Za-z0-9]",
+																														chars:      []rune{'_', '/', '-'},
+																														ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
+																														ignoreCase: false,
+																														inverted:   false,
+																													},
 																												},
-																											},
-																											&litMatcher{
-																												pos:        position{line: 261, col: 6, offset: 7164},
-																												val:        "as",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 265, col: 8, offset: 7262},
-																												val:        "True",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 266, col: 9, offset: 7279},
-																												val:        "False",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 267, col: 12, offset: 7300},
-																												val:        "Infinity",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 268, col: 7, offset: 7319},
-																												val:        "NaN",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 263, col: 9, offset: 7197},
-																												val:        "merge",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 269, col: 8, offset: 7334},
-																												val:        "Some",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 270, col: 9, offset: 7351},
-																												val:        "toMap",
-																												ignoreCase: false,
-																											},
-																											&litMatcher{
-																												pos:        position{line: 271, col: 10, offset: 7370},
-																												val:        "assert",
-																												ignoreCase: false,
 																											},
 																										},
 																									},
 																								},
-																								&charClassMatcher{
-																									pos:        position{line: 110, col: 24, offset: 2434},
-																									val:        "[_A-Za-z]",
-																									chars:      []rune{'_'},
-																									ranges:     []rune{'A', 'Z', 'a', 'z'},
-																									ignoreCase: false,
-																									inverted:   false,
-																								},
-																								&zeroOrMoreExpr{
-																									pos: position{line: 113, col: 43, offset: 2601},
-																									expr: &charClassMatcher{
-																										pos:        position{line: 111, col: 23, offset: 2468},
-																										val:        "[_/-A-Za-z0-9]",
-																										chars:      []rune{'_', '/', '-'},
-																										ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
-																										ignoreCase: false,
-																										inverted:   false,
-																									},
-																								},
 																							},
 																						},
 																					},
@@ -8984,297 +9709,310 @@ var g = &grammar{
 														},
 													},
 												},
+												&ruleRefExpr{
+													pos:  position{line: 536, col: 47, offset: 15486},
+													name: "_",
+												},
+												&litMatcher{
+													pos:        position{line: 536, col: 49, offset: 15488},
+													val:        ":",
+													ignoreCase: false,
+													want:       "\":\"",
+												},
+												&ruleRefExpr{
+													pos:  position{line: 536, col: 53, offset: 15492},
+													name: "_1",
+												},
+												&labeledExpr{
+													pos:   position{line: 536, col: 56, offset: 15495},
+													label: "t",
+													expr: &ruleRefExpr{
+														pos:  position{line: 536, col: 58, offset: 15497},
+														name: "Expression",
+													},
+												},
+												&ruleRefExpr{
+													pos:  position{line: 536, col: 69, offset: 15508},
+													name: "_",
+												},
+												&litMatcher{
+													pos:        position{line: 536, col: 71, offset: 15510},
+													val:        ")",
+													ignoreCase: false,
+													want:       "\")\"",
+												},
+												&ruleRefExpr{
+													pos:  position{line: 536, col: 75, offset: 15514},
+													name: "_",
+												},
+												&choiceExpr{
+													pos: position{line: 294, col: 9, offset: 7918},
+													alternatives: []interface{}{
+														&litMatcher{
+															pos:        position{line: 294, col: 9, offset: 7918},
+															val:        "->",
+															ignoreCase: false,
+															want:       "\"->\"",
+														},
+														&litMatcher{
+															pos:        position{line: 294, col: 16, offset: 7925},
+															val:        "→",
+															ignoreCase: false,
+															want:       "\"→\"",
+														},
+													},
+												},
+												&ruleRefExpr{
+													pos:  position{line: 536, col: 83, offset: 15522},
+													name: "_",
+												},
+												&labeledExpr{
+													pos:   position{line: 536, col: 85, offset: 15524},
+													label: "body",
+													expr: &ruleRefExpr{
+														pos:  position{line: 536, col: 90, offset: 15529},
+														name: "Expression",
+													},
+												},
+											},
+										},
+									},
+									&actionExpr{
+										pos: position{line: 539, col: 9, offset: 15647},
+										run: (*parser).callonExpression605,
+										expr: &seqExpr{
+											pos: position{line: 539, col: 9, offset: 15647},
+											exprs: []interface{}{
+												&labeledExpr{
+													pos:   position{line: 539, col: 9, offset: 15647},
+													label: "o",
+													expr: &ruleRefExpr{
+														pos:  position{line: 539, col: 11, offset: 15649},
+														name: "OperatorExpression",
+													},
+												},
+												&ruleRefExpr{
+													pos:  position{line: 539, col: 30, offset: 15668},
+													name: "_",
+												},
+												&choiceExpr{
+													pos: position{line: 294, col: 9, offset: 7918},
+													alternatives: []interface{}{
+														&litMatcher{
+															pos:        position{line: 294, col: 9, offset: 7918},
+															val:        "->",
+															ignoreCase: false,
+															want:       "\"->\"",
+														},
+														&litMatcher{
+															pos:        position{line: 294, col: 16, offset: 7925},
+															val:        "→",
+															ignoreCase: false,
+															want:       "\"→\"",
+														},
+													},
+												},
+												&ruleRefExpr{
+													pos:  position{line: 539, col: 38, offset: 15676},
+													name: "_",
+												},
+												&labeledExpr{
+													pos:   position{line: 539, col: 40, offset: 15678},
+													label: "e",
+													expr: &ruleRefExpr{
+														pos:  position{line: 539, col: 42, offset: 15680},
+														name: "Expression",
+													},
+												},
 											},
 										},
 									},
-								},
-								&ruleRefExpr{
-									pos:  position{line: 514, col: 45, offset: 14598},
-									name: "_",
-								},
-								&litMatcher{
-									pos:        position{line: 514, col: 47, offset: 14600},
-									val:        ":",
-									ignoreCase: false,
-								},
-								&ruleRefExpr{
-									pos:  position{line: 514, col: 51, offset: 14604},
-									name: "_1",
-								},
-								&labeledExpr{
-									pos:   position{line: 514, col: 54, offset: 14607},
-									label: "t",
-									expr: &ruleRefExpr{
-										pos:  position{line: 514, col: 56, offset: 14609},
-										name: "Expression",
-									},
-								},
-								&ruleRefExpr{
-									pos:  position{line: 514, col: 67, offset: 14620},
-									name: "_",
-								},
-								&litMatcher{
-									pos:        position{line: 514, col: 69, offset: 14622},
-									val:        ")",
-									ignoreCase: false,
-								},
-								&ruleRefExpr{
-									pos:  position{line: 514, col: 73, offset: 14626},
-									name: "_",
-								},
-								&choiceExpr{
-									pos: position{line: 293, col: 9, offset: 7779},
-									alternatives: []interface{}{
-										&litMatcher{
-											pos:        position{line: 293, col: 9, offset: 7779},
-											val:        "->",
-											ignoreCase: false,
-										},
-										&litMatcher{
-											pos:        position{line: 293, col: 16, offset: 7786},
-											val:        "→",
-											ignoreCase: false,
+									&actionExpr{
+										pos: position{line: 540, col: 9, offset: 15744},
+										run: (*parser).callonExpression616,
+										expr: &seqExpr{
+											pos: position{line: 540, col: 9, offset: 15744},
+											exprs: []interface{}{
+												&litMatcher{
+													pos:        position{line: 263, col: 9, offset: 7197},
+													val:        "merge",
+													ignoreCase: false,
+													want:       "\"merge\"",
+												},
+												&ruleRefExpr{
+													pos:  position{line: 540, col: 15, offset: 15750},
+													name: "_1",
+												},
+												&labeledExpr{
+													pos:   position{line: 540, col: 18, offset: 15753},
+													label: "h",
+													expr: &ruleRefExpr{
+														pos:  position{line: 540, col: 20, offset: 15755},
+														name: "ImportExpression",
+													},
+												},
+												&ruleRefExpr{
+													pos:  position{line: 540, col: 37, offset: 15772},
+													name: "_1",
+												},
+												&labeledExpr{
+													pos:   position{line: 540, col: 40, offset: 15775},
+													label: "u",
+													expr: &ruleRefExpr{
+														pos:  position{line: 540, col: 42, offset: 15777},
+														name: "ImportExpression",
+													},
+												},
+												&ruleRefExpr{
+													pos:  position{line: 540, col: 59, offset: 15794},
+													name: "_",
+												},
+												&litMatcher{
+													pos:        position{line: 540, col: 61, offset: 15796},
+													val:        ":",
+													ignoreCase: false,
+													want:       "\":\"",
+												},
+												&ruleRefExpr{
+													pos:  position{line: 540, col: 65, offset: 15800},
+													name: "_1",
+												},
+												&labeledExpr{
+													pos:   position{line: 540, col: 68, offset: 15803},
+													label: "a",
+													expr: &ruleRefExpr{
+														pos:  position{line: 540, col: 70, offset: 15805},
+														name: "ApplicationExpression",
+													},
+												},
+											},
 										},
 									},
-								},
-								&ruleRefExpr{
-									pos:  position{line: 514, col: 81, offset: 14634},
-									name: "_",
-								},
-								&labeledExpr{
-									pos:   position{line: 514, col: 83, offset: 14636},
-									label: "body",
-									expr: &ruleRefExpr{
-										pos:  position{line: 514, col: 88, offset: 14641},
-										name: "Expression",
-									},
-								},
-							},
-						},
-					},
-					&actionExpr{
-						pos: position{line: 517, col: 7, offset: 14753},
-						run: (*parser).callonExpression601,
-						expr: &seqExpr{
-							pos: position{line: 517, col: 7, offset: 14753},
-							exprs: []interface{}{
-								&labeledExpr{
-									pos:   position{line: 517, col: 7, offset: 14753},
-									label: "o",
-									expr: &ruleRefExpr{
-										pos:  position{line: 517, col: 9, offset: 14755},
-										name: "OperatorExpression",
+									&ruleRefExpr{
+										pos:  position{line: 543, col: 9, offset: 15932},
+										name: "EmptyList",
 									},
-								},
-								&ruleRefExpr{
-									pos:  position{line: 517, col: 28, offset: 14774},
-									name: "_",
-								},
-								&choiceExpr{
-									pos: position{line: 293, col: 9, offset: 7779},
-									alternatives: []interface{}{
-										&litMatcher{
-											pos:        position{line: 293, col: 9, offset: 7779},
-											val:        "->",
-											ignoreCase: false,
-										},
-										&litMatcher{
-											pos:        position{line: 293, col: 16, offset: 7786},
-											val:        "→",
-											ignoreCase: false,
+									&actionExpr{
+										pos: position{line: 544, col: 9, offset: 15950},
+										run: (*parser).callonExpression631,
+										expr: &seqExpr{
+											pos: position{line: 544, col: 9, offset: 15950},
+											exprs: []interface{}{
+												&litMatcher{
+													pos:        position{line: 270, col: 9, offset: 7351},
+													val:        "toMap",
+													ignoreCase: false,
+													want:       "\"toMap\"",
+												},
+												&ruleRefExpr{
+													pos:  position{line: 544, col: 15, offset: 15956},
+													name: "_1",
+												},
+												&labeledExpr{
+													pos:   position{line: 544, col: 18, offset: 15959},
+													label: "e",
+													expr: &ruleRefExpr{
+														pos:  position{line: 544, col: 20, offset: 15961},
+														name: "ImportExpression",
+													},
+												},
+												&ruleRefExpr{
+													pos:  position{line: 544, col: 37, offset: 15978},
+													name: "_",
+												},
+												&litMatcher{
+													pos:        position{line: 544, col: 39, offset: 15980},
+													val:        ":",
+													ignoreCase: false,
+													want:       "\":\"",
+												},
+												&ruleRefExpr{
+													pos:  position{line: 544, col: 43, offset: 15984},
+													name: "_1",
+												},
+												&labeledExpr{
+													pos:   position{line: 544, col: 46, offset: 15987},
+													label: "t",
+													expr: &ruleRefExpr{
+														pos:  position{line: 544, col: 48, offset: 15989},
+														name: "ApplicationExpression",
+													},
+												},
+											},
 										},
 									},
-								},
-								&ruleRefExpr{
-									pos:  position{line: 517, col: 36, offset: 14782},
-									name: "_",
-								},
-								&labeledExpr{
-									pos:   position{line: 517, col: 38, offset: 14784},
-									label: "e",
-									expr: &ruleRefExpr{
-										pos:  position{line: 517, col: 40, offset: 14786},
-										name: "Expression",
-									},
-								},
-							},
-						},
-					},
-					&actionExpr{
-						pos: position{line: 518, col: 7, offset: 14848},
-						run: (*parser).callonExpression612,
-						expr: &seqExpr{
-							pos: position{line: 518, col: 7, offset: 14848},
-							exprs: []interface{}{
-								&litMatcher{
-									pos:        position{line: 263, col: 9, offset: 7197},
-									val:        "merge",
-									ignoreCase: false,
-								},
-								&ruleRefExpr{
-									pos:  position{line: 518, col: 13, offset: 14854},
-									name: "_1",
-								},
-								&labeledExpr{
-									pos:   position{line: 518, col: 16, offset: 14857},
-									label: "h",
-									expr: &ruleRefExpr{
-										pos:  position{line: 518, col: 18, offset: 14859},
-										name: "ImportExpression",
-									},
-								},
-								&ruleRefExpr{
-									pos:  position{line: 518, col: 35, offset: 14876},
-									name: "_1",
-								},
-								&labeledExpr{
-									pos:   position{line: 518, col: 38, offset: 14879},
-									label: "u",
-									expr: &ruleRefExpr{
-										pos:  position{line: 518, col: 40, offset: 14881},
-										name: "ImportExpression",
-									},
-								},
-								&ruleRefExpr{
-									pos:  position{line: 518, col: 57, offset: 14898},
-									name: "_",
-								},
-								&litMatcher{
-									pos:        position{line: 518, col: 59, offset: 14900},
-									val:        ":",
-									ignoreCase: false,
-								},
-								&ruleRefExpr{
-									pos:  position{line: 518, col: 63, offset: 14904},
-									name: "_1",
-								},
-								&labeledExpr{
-									pos:   position{line: 518, col: 66, offset: 14907},
-									label: "a",
-									expr: &ruleRefExpr{
-										pos:  position{line: 518, col: 68, offset: 14909},
-										name: "ApplicationExpression",
-									},
-								},
-							},
-						},
-					},
-					&ruleRefExpr{
-						pos:  position{line: 521, col: 7, offset: 15030},
-						name: "EmptyList",
-					},
-					&actionExpr{
-						pos: position{line: 522, col: 7, offset: 15046},
-						run: (*parser).callonExpression627,
-						expr: &seqExpr{
-							pos: position{line: 522, col: 7, offset: 15046},
-							exprs: []interface{}{
-								&litMatcher{
-									pos:        position{line: 270, col: 9, offset: 7351},
-									val:        "toMap",
-									ignoreCase: false,
-								},
-								&ruleRefExpr{
-									pos:  position{line: 522, col: 13, offset: 15052},
-									name: "_1",
-								},
-								&labeledExpr{
-									pos:   position{line: 522, col: 16, offset: 15055},
-									label: "e",
-									expr: &ruleRefExpr{
-										pos:  position{line: 522, col: 18, offset: 15057},
-										name: "ImportExpression",
-									},
-								},
-								&ruleRefExpr{
-									pos:  position{line: 522, col: 35, offset: 15074},
-									name: "_",
-								},
-								&litMatcher{
-									pos:        position{line: 522, col: 37, offset: 15076},
-									val:        ":",
-									ignoreCase: false,
-								},
-								&ruleRefExpr{
-									pos:  position{line: 522, col: 41, offset: 15080},
-									name: "_1",
-								},
-								&labeledExpr{
-									pos:   position{line: 522, col: 44, offset: 15083},
-									label: "t",
-									expr: &ruleRefExpr{
-										pos:  position{line: 522, col: 46, offset: 15085},
-										name: "ApplicationExpression",
+									&actionExpr{
+										pos: position{line: 545, col: 9, offset: 16061},
+										run: (*parser).callonExpression642,
+										expr: &seqExpr{
+											pos: position{line: 545, col: 9, offset: 16061},
+											exprs: []interface{}{
+												&litMatcher{
+													pos:        position{line: 271, col: 10, offset: 7370},
+													val:        "assert",
+													ignoreCase: false,
+													want:       "\"assert\"",
+												},
+												&ruleRefExpr{
+													pos:  position{line: 545, col: 16, offset: 16068},
+													name: "_",
+												},
+												&litMatcher{
+													pos:        position{line: 545, col: 18, offset: 16070},
+													val:        ":",
+													ignoreCase: false,
+													want:       "\":\"",
+												},
+												&ruleRefExpr{
+													pos:  position{line: 545, col: 22, offset: 16074},
+													name: "_1",
+												},
+												&labeledExpr{
+													pos:   position{line: 545, col: 25, offset: 16077},
+													label: "a",
+													expr: &ruleRefExpr{
+														pos:  position{line: 545, col: 27, offset: 16079},
+														name: "Expression",
+													},
+												},
+											},
+										},
 									},
-								},
-							},
-						},
-					},
-					&actionExpr{
-						pos: position{line: 523, col: 7, offset: 15155},
-						run: (*parser).callonExpression638,
-						expr: &seqExpr{
-							pos: position{line: 523, col: 7, offset: 15155},
-							exprs: []interface{}{
-								&litMatcher{
-									pos:        position{line: 271, col: 10, offset: 7370},
-									val:        "assert",
-									ignoreCase: false,
-								},
-								&ruleRefExpr{
-									pos:  position{line: 523, col: 14, offset: 15162},
-									name: "_",
-								},
-								&litMatcher{
-									pos:        position{line: 523, col: 16, offset: 15164},
-									val:        ":",
-									ignoreCase: false,
-								},
-								&ruleRefExpr{
-									pos:  position{line: 523, col: 20, offset: 15168},
-									name: "_1",
-								},
-								&labeledExpr{
-									pos:   position{line: 523, col: 23, offset: 15171},
-									label: "a",
-									expr: &ruleRefExpr{
-										pos:  position{line: 523, col: 25, offset: 15173},
-										name: "Expression",
+									&ruleRefExpr{
+										pos:  position{line: 546, col: 9, offset: 16143},
+										name: "AnnotatedExpression",
 									},
 								},
 							},
 						},
 					},
-					&ruleRefExpr{
-						pos:  position{line: 524, col: 7, offset: 15235},
-						name: "AnnotatedExpression",
-					},
 				},
 			},
 		},
 		{
 			name: "Annotation",
-			pos:  position{line: 526, col: 1, offset: 15256},
+			pos:  position{line: 549, col: 1, offset: 16192},
 			expr: &actionExpr{
-				pos: position{line: 526, col: 14, offset: 15271},
+				pos: position{line: 549, col: 14, offset: 16207},
 				run: (*parser).callonAnnotation1,
 				expr: &seqExpr{
-					pos: position{line: 526, col: 14, offset: 15271},
+					pos: position{line: 549, col: 14, offset: 16207},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 526, col: 14, offset: 15271},
+							pos:        position{line: 549, col: 14, offset: 16207},
 							val:        ":",
 							ignoreCase: false,
+							want:       "\":\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 526, col: 18, offset: 15275},
+							pos:  position{line: 549, col: 18, offset: 16211},
 							name: "_1",
 						},
 						&labeledExpr{
-							pos:   position{line: 526, col: 21, offset: 15278},
+							pos:   position{line: 549, col: 21, offset: 16214},
 							label: "a",
 							expr: &ruleRefExpr{
-								pos:  position{line: 526, col: 23, offset: 15280},
+								pos:  position{line: 549, col: 23, offset: 16216},
 								name: "Expression",
 							},
 						},
@@ -9284,35 +10022,35 @@ var g = &grammar{
 		},
 		{
 			name: "AnnotatedExpression",
-			pos:  position{line: 528, col: 1, offset: 15310},
+			pos:  position{line: 551, col: 1, offset: 16246},
 			expr: &actionExpr{
-				pos: position{line: 529, col: 1, offset: 15334},
+				pos: position{line: 552, col: 1, offset: 16270},
 				run: (*parser).callonAnnotatedExpression1,
 				expr: &seqExpr{
-					pos: position{line: 529, col: 1, offset: 15334},
+					pos: position{line: 552, col: 1, offset: 16270},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 529, col: 1, offset: 15334},
+							pos:   position{line: 552, col: 1, offset: 16270},
 							label: "e",
 							expr: &ruleRefExpr{
-								pos:  position{line: 529, col: 3, offset: 15336},
+								pos:  position{line: 552, col: 3, offset: 16272},
 								name: "OperatorExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 529, col: 22, offset: 15355},
+							pos:   position{line: 552, col: 22, offset: 16291},
 							label: "a",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 529, col: 24, offset: 15357},
+								pos: position{line: 552, col: 24, offset: 16293},
 								expr: &seqExpr{
-									pos: position{line: 529, col: 25, offset: 15358},
+									pos: position{line: 552, col: 25, offset: 16294},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 529, col: 25, offset: 15358},
+											pos:  position{line: 552, col: 25, offset: 16294},
 											name: "_",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 529, col: 27, offset: 15360},
+											pos:  position{line: 552, col: 27, offset: 16296},
 											name: "Annotation",
 										},
 									},
@@ -9325,62 +10063,66 @@ var g = &grammar{
 		},
 		{
 			name: "EmptyList",
-			pos:  position{line: 534, col: 1, offset: 15485},
+			pos:  position{line: 557, col: 1, offset: 16421},
 			expr: &actionExpr{
-				pos: position{line: 534, col: 13, offset: 15499},
+				pos: position{line: 557, col: 13, offset: 16435},
 				run: (*parser).callonEmptyList1,
 				expr: &seqExpr{
-					pos: position{line: 534, col: 13, offset: 15499},
+					pos: position{line: 557, col: 13, offset: 16435},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 534, col: 13, offset: 15499},
+							pos:        position{line: 557, col: 13, offset: 16435},
 							val:        "[",
 							ignoreCase: false,
+							want:       "\"[\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 534, col: 17, offset: 15503},
+							pos:  position{line: 557, col: 17, offset: 16439},
 							name: "_",
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 534, col: 19, offset: 15505},
+							pos: position{line: 557, col: 19, offset: 16441},
 							expr: &seqExpr{
-								pos: position{line: 534, col: 20, offset: 15506},
+								pos: position{line: 557, col: 20, offset: 16442},
 								exprs: []interface{}{
 									&litMatcher{
-										pos:        position{line: 534, col: 20, offset: 15506},
+										pos:        position{line: 557, col: 20, offset: 16442},
 										val:        ",",
 										ignoreCase: false,
+										want:       "\",\"",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 534, col: 24, offset: 15510},
+										pos:  position{line: 557, col: 24, offset: 16446},
 										name: "_",
 									},
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 534, col: 28, offset: 15514},
+							pos:        position{line: 557, col: 28, offset: 16450},
 							val:        "]",
 							ignoreCase: false,
+							want:       "\"]\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 534, col: 32, offset: 15518},
+							pos:  position{line: 557, col: 32, offset: 16454},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 534, col: 34, offset: 15520},
+							pos:        position{line: 557, col: 34, offset: 16456},
 							val:        ":",
 							ignoreCase: false,
+							want:       "\":\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 534, col: 38, offset: 15524},
+							pos:  position{line: 557, col: 38, offset: 16460},
 							name: "_1",
 						},
 						&labeledExpr{
-							pos:   position{line: 534, col: 41, offset: 15527},
+							pos:   position{line: 557, col: 41, offset: 16463},
 							label: "a",
 							expr: &ruleRefExpr{
-								pos:  position{line: 534, col: 43, offset: 15529},
+								pos:  position{line: 557, col: 43, offset: 16465},
 								name: "ApplicationExpression",
 							},
 						},
@@ -9390,52 +10132,53 @@ var g = &grammar{
 		},
 		{
 			name: "OperatorExpression",
-			pos:  position{line: 538, col: 1, offset: 15597},
+			pos:  position{line: 561, col: 1, offset: 16533},
 			expr: &ruleRefExpr{
-				pos:  position{line: 538, col: 22, offset: 15620},
+				pos:  position{line: 561, col: 22, offset: 16556},
 				name: "ImportAltExpression",
 			},
 		},
 		{
 			name: "ImportAltExpression",
-			pos:  position{line: 540, col: 1, offset: 15641},
+			pos:  position{line: 563, col: 1, offset: 16577},
 			expr: &actionExpr{
-				pos: position{line: 540, col: 26, offset: 15668},
+				pos: position{line: 563, col: 26, offset: 16604},
 				run: (*parser).callonImportAltExpression1,
 				expr: &seqExpr{
-					pos: position{line: 540, col: 26, offset: 15668},
+					pos: position{line: 563, col: 26, offset: 16604},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 540, col: 26, offset: 15668},
+							pos:   position{line: 563, col: 26, offset: 16604},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 540, col: 32, offset: 15674},
+								pos:  position{line: 563, col: 32, offset: 16610},
 								name: "OrExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 540, col: 55, offset: 15697},
+							pos:   position{line: 563, col: 55, offset: 16633},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 540, col: 60, offset: 15702},
+								pos: position{line: 563, col: 60, offset: 16638},
 								expr: &seqExpr{
-									pos: position{line: 540, col: 61, offset: 15703},
+									pos: position{line: 563, col: 61, offset: 16639},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 540, col: 61, offset: 15703},
+											pos:  position{line: 563, col: 61, offset: 16639},
 											name: "_",
 										},
 										&litMatcher{
-											pos:        position{line: 540, col: 63, offset: 15705},
+											pos:        position{line: 563, col: 63, offset: 16641},
 											val:        "?",
 											ignoreCase: false,
+											want:       "\"?\"",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 540, col: 67, offset: 15709},
+											pos:  position{line: 563, col: 67, offset: 16645},
 											name: "_1",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 540, col: 70, offset: 15712},
+											pos:  position{line: 563, col: 70, offset: 16648},
 											name: "OrExpression",
 										},
 									},
@@ -9448,44 +10191,45 @@ var g = &grammar{
 		},
 		{
 			name: "OrExpression",
-			pos:  position{line: 542, col: 1, offset: 15783},
+			pos:  position{line: 565, col: 1, offset: 16719},
 			expr: &actionExpr{
-				pos: position{line: 542, col: 26, offset: 15810},
+				pos: position{line: 565, col: 26, offset: 16746},
 				run: (*parser).callonOrExpression1,
 				expr: &seqExpr{
-					pos: position{line: 542, col: 26, offset: 15810},
+					pos: position{line: 565, col: 26, offset: 16746},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 542, col: 26, offset: 15810},
+							pos:   position{line: 565, col: 26, offset: 16746},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 542, col: 32, offset: 15816},
+								pos:  position{line: 565, col: 32, offset: 16752},
 								name: "PlusExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 542, col: 55, offset: 15839},
+							pos:   position{line: 565, col: 55, offset: 16775},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 542, col: 60, offset: 15844},
+								pos: position{line: 565, col: 60, offset: 16780},
 								expr: &seqExpr{
-									pos: position{line: 542, col: 61, offset: 15845},
+									pos: position{line: 565, col: 61, offset: 16781},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 542, col: 61, offset: 15845},
+											pos:  position{line: 565, col: 61, offset: 16781},
 											name: "_",
 										},
 										&litMatcher{
-											pos:        position{line: 542, col: 63, offset: 15847},
+											pos:        position{line: 565, col: 63, offset: 16783},
 											val:        "||",
 											ignoreCase: false,
+											want:       "\"||\"",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 542, col: 68, offset: 15852},
+											pos:  position{line: 565, col: 68, offset: 16788},
 											name: "_",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 542, col: 70, offset: 15854},
+											pos:  position{line: 565, col: 70, offset: 16790},
 											name: "PlusExpression",
 										},
 									},
@@ -9498,47 +10242,48 @@ var g = &grammar{
 		},
 		{
 			name: "PlusExpression",
-			pos:  position{line: 544, col: 1, offset: 15920},
+			pos:  position{line: 567, col: 1, offset: 16856},
 			expr: &actionExpr{
-				pos: position{line: 544, col: 26, offset: 15947},
+				pos: position{line: 567, col: 26, offset: 16883},
 				run: (*parser).callonPlusExpression1,
 				expr: &seqExpr{
-					pos: position{line: 544, col: 26, offset: 15947},
+					pos: position{line: 567, col: 26, offset: 16883},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 544, col: 26, offset: 15947},
+							pos:   position{line: 567, col: 26, offset: 16883},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 544, col: 32, offset: 15953},
+								pos:  position{line: 567, col: 32, offset: 16889},
 								name: "TextAppendExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 544, col: 55, offset: 15976},
+							pos:   position{line: 567, col: 55, offset: 16912},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 544, col: 60, offset: 15981},
+								pos: position{line: 567, col: 60, offset: 16917},
 								expr: &seqExpr{
-									pos: position{line: 544, col: 61, offset: 15982},
+									pos: position{line: 567, col: 61, offset: 16918},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 544, col: 61, offset: 15982},
+											pos:  position{line: 567, col: 61, offset: 16918},
 											name: "_",
 										},
 										&litMatcher{
-											pos:        position{line: 544, col: 63, offset: 15984},
+											pos:        position{line: 567, col: 63, offset: 16920},
 											val:        "+",
 											ignoreCase: false,
+											want:       "\"+\"",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 544, col: 67, offset: 15988},
+											pos:  position{line: 567, col: 67, offset: 16924},
 											name: "_1",
 										},
 										&labeledExpr{
-											pos:   position{line: 544, col: 70, offset: 15991},
+											pos:   position{line: 567, col: 70, offset: 16927},
 											label: "e",
 											expr: &ruleRefExpr{
-												pos:  position{line: 544, col: 72, offset: 15993},
+												pos:  position{line: 567, col: 72, offset: 16929},
 												name: "TextAppendExpression",
 											},
 										},
@@ -9552,47 +10297,48 @@ var g = &grammar{
 		},
 		{
 			name: "TextAppendExpression",
-			pos:  position{line: 546, col: 1, offset: 16067},
+			pos:  position{line: 569, col: 1, offset: 17003},
 			expr: &actionExpr{
-				pos: position{line: 546, col: 26, offset: 16094},
+				pos: position{line: 569, col: 26, offset: 17030},
 				run: (*parser).callonTextAppendExpression1,
 				expr: &seqExpr{
-					pos: position{line: 546, col: 26, offset: 16094},
+					pos: position{line: 569, col: 26, offset: 17030},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 546, col: 26, offset: 16094},
+							pos:   position{line: 569, col: 26, offset: 17030},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 546, col: 32, offset: 16100},
+								pos:  position{line: 569, col: 32, offset: 17036},
 								name: "ListAppendExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 546, col: 55, offset: 16123},
+							pos:   position{line: 569, col: 55, offset: 17059},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 546, col: 60, offset: 16128},
+								pos: position{line: 569, col: 60, offset: 17064},
 								expr: &seqExpr{
-									pos: position{line: 546, col: 61, offset: 16129},
+									pos: position{line: 569, col: 61, offset: 17065},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 546, col: 61, offset: 16129},
+											pos:  position{line: 569, col: 61, offset: 17065},
 											name: "_",
 										},
 										&litMatcher{
-											pos:        position{line: 546, col: 63, offset: 16131},
+											pos:        position{line: 569, col: 63, offset: 17067},
 											val:        "++",
 											ignoreCase: false,
+											want:       "\"++\"",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 546, col: 68, offset: 16136},
+											pos:  position{line: 569, col: 68, offset: 17072},
 											name: "_",
 										},
 										&labeledExpr{
-											pos:   position{line: 546, col: 70, offset: 16138},
+											pos:   position{line: 569, col: 70, offset: 17074},
 											label: "e",
 											expr: &ruleRefExpr{
-												pos:  position{line: 546, col: 72, offset: 16140},
+												pos:  position{line: 569, col: 72, offset: 17076},
 												name: "ListAppendExpression",
 											},
 										},
@@ -9606,47 +10352,48 @@ var g = &grammar{
 		},
 		{
 			name: "ListAppendExpression",
-			pos:  position{line: 548, col: 1, offset: 16220},
+			pos:  position{line: 571, col: 1, offset: 17156},
 			expr: &actionExpr{
-				pos: position{line: 548, col: 26, offset: 16247},
+				pos: position{line: 571, col: 26, offset: 17183},
 				run: (*parser).callonListAppendExpression1,
 				expr: &seqExpr{
-					pos: position{line: 548, col: 26, offset: 16247},
+					pos: position{line: 571, col: 26, offset: 17183},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 548, col: 26, offset: 16247},
+							pos:   position{line: 571, col: 26, offset: 17183},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 548, col: 32, offset: 16253},
+								pos:  position{line: 571, col: 32, offset: 17189},
 								name: "AndExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 548, col: 55, offset: 16276},
+							pos:   position{line: 571, col: 55, offset: 17212},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 548, col: 60, offset: 16281},
+								pos: position{line: 571, col: 60, offset: 17217},
 								expr: &seqExpr{
-									pos: position{line: 548, col: 61, offset: 16282},
+									pos: position{line: 571, col: 61, offset: 17218},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 548, col: 61, offset: 16282},
+											pos:  position{line: 571, col: 61, offset: 17218},
 											name: "_",
 										},
 										&litMatcher{
-											pos:        position{line: 548, col: 63, offset: 16284},
+											pos:        position{line: 571, col: 63, offset: 17220},
 											val:        "#",
 											ignoreCase: false,
+											want:       "\"#\"",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 548, col: 67, offset: 16288},
+											pos:  position{line: 571, col: 67, offset: 17224},
 											name: "_",
 										},
 										&labeledExpr{
-											pos:   position{line: 548, col: 69, offset: 16290},
+											pos:   position{line: 571, col: 69, offset: 17226},
 											label: "e",
 											expr: &ruleRefExpr{
-												pos:  position{line: 548, col: 71, offset: 16292},
+												pos:  position{line: 571, col: 71, offset: 17228},
 												name: "AndExpression",
 											},
 										},
@@ -9660,47 +10407,48 @@ var g = &grammar{
 		},
 		{
 			name: "AndExpression",
-			pos:  position{line: 550, col: 1, offset: 16365},
+			pos:  position{line: 573, col: 1, offset: 17301},
 			expr: &actionExpr{
-				pos: position{line: 550, col: 26, offset: 16392},
+				pos: position{line: 573, col: 26, offset: 17328},
 				run: (*parser).callonAndExpression1,
 				expr: &seqExpr{
-					pos: position{line: 550, col: 26, offset: 16392},
+					pos: position{line: 573, col: 26, offset: 17328},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 550, col: 26, offset: 16392},
+							pos:   position{line: 573, col: 26, offset: 17328},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 550, col: 32, offset: 16398},
+								pos:  position{line: 573, col: 32, offset: 17334},
 								name: "CombineExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 550, col: 55, offset: 16421},
+							pos:   position{line: 573, col: 55, offset: 17357},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 550, col: 60, offset: 16426},
+								pos: position{line: 573, col: 60, offset: 17362},
 								expr: &seqExpr{
-									pos: position{line: 550, col: 61, offset: 16427},
+									pos: position{line: 573, col: 61, offset: 17363},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 550, col: 61, offset: 16427},
+											pos:  position{line: 573, col: 61, offset: 17363},
 											name: "_",
 										},
 										&litMatcher{
-											pos:        position{line: 550, col: 63, offset: 16429},
+											pos:        position{line: 573, col: 63, offset: 17365},
 											val:        "&&",
 											ignoreCase: false,
+											want:       "\"&&\"",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 550, col: 68, offset: 16434},
+											pos:  position{line: 573, col: 68, offset: 17370},
 											name: "_",
 										},
 										&labeledExpr{
-											pos:   position{line: 550, col: 70, offset: 16436},
+											pos:   position{line: 573, col: 70, offset: 17372},
 											label: "e",
 											expr: &ruleRefExpr{
-												pos:  position{line: 550, col: 72, offset: 16438},
+												pos:  position{line: 573, col: 72, offset: 17374},
 												name: "CombineExpression",
 											},
 										},
@@ -9714,57 +10462,59 @@ var g = &grammar{
 		},
 		{
 			name: "CombineExpression",
-			pos:  position{line: 552, col: 1, offset: 16508},
+			pos:  position{line: 575, col: 1, offset: 17444},
 			expr: &actionExpr{
-				pos: position{line: 552, col: 26, offset: 16535},
+				pos: position{line: 575, col: 26, offset: 17471},
 				run: (*parser).callonCombineExpression1,
 				expr: &seqExpr{
-					pos: position{line: 552, col: 26, offset: 16535},
+					pos: position{line: 575, col: 26, offset: 17471},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 552, col: 26, offset: 16535},
+							pos:   position{line: 575, col: 26, offset: 17471},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 552, col: 32, offset: 16541},
+								pos:  position{line: 575, col: 32, offset: 17477},
 								name: "PreferExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 552, col: 55, offset: 16564},
+							pos:   position{line: 575, col: 55, offset: 17500},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 552, col: 60, offset: 16569},
+								pos: position{line: 575, col: 60, offset: 17505},
 								expr: &seqExpr{
-									pos: position{line: 552, col: 61, offset: 16570},
+									pos: position{line: 575, col: 61, offset: 17506},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 552, col: 61, offset: 16570},
+											pos:  position{line: 575, col: 61, offset: 17506},
 											name: "_",
 										},
 										&choiceExpr{
-											pos: position{line: 287, col: 11, offset: 7617},
+											pos: position{line: 288, col: 11, offset: 7756},
 											alternatives: []interface{}{
 												&litMatcher{
-													pos:        position{line: 287, col: 11, offset: 7617},
+													pos:        position{line: 288, col: 11, offset: 7756},
 													val:        "/\\",
 													ignoreCase: false,
+													want:       "\"/\\\\\"",
 												},
 												&litMatcher{
-													pos:        position{line: 287, col: 19, offset: 7625},
+													pos:        position{line: 288, col: 19, offset: 7764},
 													val:        "∧",
 													ignoreCase: false,
+													want:       "\"∧\"",
 												},
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 552, col: 71, offset: 16580},
+											pos:  position{line: 575, col: 71, offset: 17516},
 											name: "_",
 										},
 										&labeledExpr{
-											pos:   position{line: 552, col: 73, offset: 16582},
+											pos:   position{line: 575, col: 73, offset: 17518},
 											label: "e",
 											expr: &ruleRefExpr{
-												pos:  position{line: 552, col: 75, offset: 16584},
+												pos:  position{line: 575, col: 75, offset: 17520},
 												name: "PreferExpression",
 											},
 										},
@@ -9778,57 +10528,59 @@ var g = &grammar{
 		},
 		{
 			name: "PreferExpression",
-			pos:  position{line: 554, col: 1, offset: 16661},
+			pos:  position{line: 577, col: 1, offset: 17597},
 			expr: &actionExpr{
-				pos: position{line: 554, col: 26, offset: 16688},
+				pos: position{line: 577, col: 26, offset: 17624},
 				run: (*parser).callonPreferExpression1,
 				expr: &seqExpr{
-					pos: position{line: 554, col: 26, offset: 16688},
+					pos: position{line: 577, col: 26, offset: 17624},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 554, col: 26, offset: 16688},
+							pos:   position{line: 577, col: 26, offset: 17624},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 554, col: 32, offset: 16694},
+								pos:  position{line: 577, col: 32, offset: 17630},
 								name: "CombineTypesExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 554, col: 55, offset: 16717},
+							pos:   position{line: 577, col: 55, offset: 17653},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 554, col: 60, offset: 16722},
+								pos: position{line: 577, col: 60, offset: 17658},
 								expr: &seqExpr{
-									pos: position{line: 554, col: 61, offset: 16723},
+									pos: position{line: 577, col: 61, offset: 17659},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 554, col: 61, offset: 16723},
+											pos:  position{line: 577, col: 61, offset: 17659},
 											name: "_",
 										},
 										&choiceExpr{
-											pos: position{line: 290, col: 10, offset: 7705},
+											pos: position{line: 291, col: 10, offset: 7844},
 											alternatives: []interface{}{
 												&litMatcher{
-													pos:        position{line: 290, col: 10, offset: 7705},
+													pos:        position{line: 291, col: 10, offset: 7844},
 													val:        "//",
 													ignoreCase: false,
+													want:       "\"//\"",
 												},
 												&litMatcher{
-													pos:        position{line: 290, col: 17, offset: 7712},
+													pos:        position{line: 291, col: 17, offset: 7851},
 													val:        "⫽",
 													ignoreCase: false,
+													want:       "\"⫽\"",
 												},
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 554, col: 70, offset: 16732},
+											pos:  position{line: 577, col: 70, offset: 17668},
 											name: "_",
 										},
 										&labeledExpr{
-											pos:   position{line: 554, col: 72, offset: 16734},
+											pos:   position{line: 577, col: 72, offset: 17670},
 											label: "e",
 											expr: &ruleRefExpr{
-												pos:  position{line: 554, col: 74, offset: 16736},
+												pos:  position{line: 577, col: 74, offset: 17672},
 												name: "CombineTypesExpression",
 											},
 										},
@@ -9842,57 +10594,59 @@ var g = &grammar{
 		},
 		{
 			name: "CombineTypesExpression",
-			pos:  position{line: 556, col: 1, offset: 16830},
+			pos:  position{line: 579, col: 1, offset: 17766},
 			expr: &actionExpr{
-				pos: position{line: 556, col: 26, offset: 16857},
+				pos: position{line: 579, col: 26, offset: 17793},
 				run: (*parser).callonCombineTypesExpression1,
 				expr: &seqExpr{
-					pos: position{line: 556, col: 26, offset: 16857},
+					pos: position{line: 579, col: 26, offset: 17793},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 556, col: 26, offset: 16857},
+							pos:   position{line: 579, col: 26, offset: 17793},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 556, col: 32, offset: 16863},
+								pos:  position{line: 579, col: 32, offset: 17799},
 								name: "TimesExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 556, col: 55, offset: 16886},
+							pos:   position{line: 579, col: 55, offset: 17822},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 556, col: 60, offset: 16891},
+								pos: position{line: 579, col: 60, offset: 17827},
 								expr: &seqExpr{
-									pos: position{line: 556, col: 61, offset: 16892},
+									pos: position{line: 579, col: 61, offset: 17828},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 556, col: 61, offset: 16892},
+											pos:  position{line: 579, col: 61, offset: 17828},
 											name: "_",
 										},
 										&choiceExpr{
-											pos: position{line: 288, col: 16, offset: 7648},
+											pos: position{line: 289, col: 16, offset: 7787},
 											alternatives: []interface{}{
 												&litMatcher{
-													pos:        position{line: 288, col: 16, offset: 7648},
+													pos:        position{line: 289, col: 16, offset: 7787},
 													val:        "//\\\\",
 													ignoreCase: false,
+													want:       "\"//\\\\\\\\\"",
 												},
 												&litMatcher{
-													pos:        position{line: 288, col: 27, offset: 7659},
+													pos:        position{line: 289, col: 27, offset: 7798},
 													val:        "⩓",
 													ignoreCase: false,
+													want:       "\"⩓\"",
 												},
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 556, col: 76, offset: 16907},
+											pos:  position{line: 579, col: 76, offset: 17843},
 											name: "_",
 										},
 										&labeledExpr{
-											pos:   position{line: 556, col: 78, offset: 16909},
+											pos:   position{line: 579, col: 78, offset: 17845},
 											label: "e",
 											expr: &ruleRefExpr{
-												pos:  position{line: 556, col: 80, offset: 16911},
+												pos:  position{line: 579, col: 80, offset: 17847},
 												name: "TimesExpression",
 											},
 										},
@@ -9906,47 +10660,48 @@ var g = &grammar{
 		},
 		{
 			name: "TimesExpression",
-			pos:  position{line: 558, col: 1, offset: 16991},
+			pos:  position{line: 581, col: 1, offset: 17927},
 			expr: &actionExpr{
-				pos: position{line: 558, col: 26, offset: 17018},
+				pos: position{line: 581, col: 26, offset: 17954},
 				run: (*parser).callonTimesExpression1,
 				expr: &seqExpr{
-					pos: position{line: 558, col: 26, offset: 17018},
+					pos: position{line: 581, col: 26, offset: 17954},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 558, col: 26, offset: 17018},
+							pos:   position{line: 581, col: 26, offset: 17954},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 558, col: 32, offset: 17024},
+								pos:  position{line: 581, col: 32, offset: 17960},
 								name: "EqualExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 558, col: 55, offset: 17047},
+							pos:   position{line: 581, col: 55, offset: 17983},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 558, col: 60, offset: 17052},
+								pos: position{line: 581, col: 60, offset: 17988},
 								expr: &seqExpr{
-									pos: position{line: 558, col: 61, offset: 17053},
+									pos: position{line: 581, col: 61, offset: 17989},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 558, col: 61, offset: 17053},
+											pos:  position{line: 581, col: 61, offset: 17989},
 											name: "_",
 										},
 										&litMatcher{
-											pos:        position{line: 558, col: 63, offset: 17055},
+											pos:        position{line: 581, col: 63, offset: 17991},
 											val:        "*",
 											ignoreCase: false,
+											want:       "\"*\"",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 558, col: 67, offset: 17059},
+											pos:  position{line: 581, col: 67, offset: 17995},
 											name: "_",
 										},
 										&labeledExpr{
-											pos:   position{line: 558, col: 69, offset: 17061},
+											pos:   position{line: 581, col: 69, offset: 17997},
 											label: "e",
 											expr: &ruleRefExpr{
-												pos:  position{line: 558, col: 71, offset: 17063},
+												pos:  position{line: 581, col: 71, offset: 17999},
 												name: "EqualExpression",
 											},
 										},
@@ -9960,47 +10715,48 @@ var g = &grammar{
 		},
 		{
 			name: "EqualExpression",
-			pos:  position{line: 560, col: 1, offset: 17133},
+			pos:  position{line: 583, col: 1, offset: 18069},
 			expr: &actionExpr{
-				pos: position{line: 560, col: 26, offset: 17160},
+				pos: position{line: 583, col: 26, offset: 18096},
 				run: (*parser).callonEqualExpression1,
 				expr: &seqExpr{
-					pos: position{line: 560, col: 26, offset: 17160},
+					pos: position{line: 583, col: 26, offset: 18096},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 560, col: 26, offset: 17160},
+							pos:   position{line: 583, col: 26, offset: 18096},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 560, col: 32, offset: 17166},
+								pos:  position{line: 583, col: 32, offset: 18102},
 								name: "NotEqualExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 560, col: 55, offset: 17189},
+							pos:   position{line: 583, col: 55, offset: 18125},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 560, col: 60, offset: 17194},
+								pos: position{line: 583, col: 60, offset: 18130},
 								expr: &seqExpr{
-									pos: position{line: 560, col: 61, offset: 17195},
+									pos: position{line: 583, col: 61, offset: 18131},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 560, col: 61, offset: 17195},
+											pos:  position{line: 583, col: 61, offset: 18131},
 											name: "_",
 										},
 										&litMatcher{
-											pos:        position{line: 560, col: 63, offset: 17197},
+											pos:        position{line: 583, col: 63, offset: 18133},
 											val:        "==",
 											ignoreCase: false,
+											want:       "\"==\"",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 560, col: 68, offset: 17202},
+											pos:  position{line: 583, col: 68, offset: 18138},
 											name: "_",
 										},
 										&labeledExpr{
-											pos:   position{line: 560, col: 70, offset: 17204},
+											pos:   position{line: 583, col: 70, offset: 18140},
 											label: "e",
 											expr: &ruleRefExpr{
-												pos:  position{line: 560, col: 72, offset: 17206},
+												pos:  position{line: 583, col: 72, offset: 18142},
 												name: "NotEqualExpression",
 											},
 										},
@@ -10014,47 +10770,48 @@ var g = &grammar{
 		},
 		{
 			name: "NotEqualExpression",
-			pos:  position{line: 562, col: 1, offset: 17276},
+			pos:  position{line: 585, col: 1, offset: 18212},
 			expr: &actionExpr{
-				pos: position{line: 562, col: 26, offset: 17303},
+				pos: position{line: 585, col: 26, offset: 18239},
 				run: (*parser).callonNotEqualExpression1,
 				expr: &seqExpr{
-					pos: position{line: 562, col: 26, offset: 17303},
+					pos: position{line: 585, col: 26, offset: 18239},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 562, col: 26, offset: 17303},
+							pos:   position{line: 585, col: 26, offset: 18239},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 562, col: 32, offset: 17309},
+								pos:  position{line: 585, col: 32, offset: 18245},
 								name: "EquivalentExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 562, col: 54, offset: 17331},
+							pos:   position{line: 585, col: 54, offset: 18267},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 562, col: 59, offset: 17336},
+								pos: position{line: 585, col: 59, offset: 18272},
 								expr: &seqExpr{
-									pos: position{line: 562, col: 60, offset: 17337},
+									pos: position{line: 585, col: 60, offset: 18273},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 562, col: 60, offset: 17337},
+											pos:  position{line: 585, col: 60, offset: 18273},
 											name: "_",
 										},
 										&litMatcher{
-											pos:        position{line: 562, col: 62, offset: 17339},
+											pos:        position{line: 585, col: 62, offset: 18275},
 											val:        "!=",
 											ignoreCase: false,
+											want:       "\"!=\"",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 562, col: 67, offset: 17344},
+											pos:  position{line: 585, col: 67, offset: 18280},
 											name: "_",
 										},
 										&labeledExpr{
-											pos:   position{line: 562, col: 69, offset: 17346},
+											pos:   position{line: 585, col: 69, offset: 18282},
 											label: "e",
 											expr: &ruleRefExpr{
-												pos:  position{line: 562, col: 71, offset: 17348},
+												pos:  position{line: 585, col: 71, offset: 18284},
 												name: "EquivalentExpression",
 											},
 										},
@@ -10068,57 +10825,59 @@ var g = &grammar{
 		},
 		{
 			name: "EquivalentExpression",
-			pos:  position{line: 564, col: 1, offset: 17420},
+			pos:  position{line: 587, col: 1, offset: 18356},
 			expr: &actionExpr{
-				pos: position{line: 564, col: 28, offset: 17449},
+				pos: position{line: 587, col: 28, offset: 18385},
 				run: (*parser).callonEquivalentExpression1,
 				expr: &seqExpr{
-					pos: position{line: 564, col: 28, offset: 17449},
+					pos: position{line: 587, col: 28, offset: 18385},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 564, col: 28, offset: 17449},
+							pos:   position{line: 587, col: 28, offset: 18385},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 564, col: 34, offset: 17455},
+								pos:  position{line: 587, col: 34, offset: 18391},
 								name: "ApplicationExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 564, col: 57, offset: 17478},
+							pos:   position{line: 587, col: 57, offset: 18414},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 564, col: 62, offset: 17483},
+								pos: position{line: 587, col: 62, offset: 18419},
 								expr: &seqExpr{
-									pos: position{line: 564, col: 63, offset: 17484},
+									pos: position{line: 587, col: 63, offset: 18420},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 564, col: 63, offset: 17484},
+											pos:  position{line: 587, col: 63, offset: 18420},
 											name: "_",
 										},
 										&choiceExpr{
-											pos: position{line: 289, col: 14, offset: 7680},
+											pos: position{line: 290, col: 14, offset: 7819},
 											alternatives: []interface{}{
 												&litMatcher{
-													pos:        position{line: 289, col: 14, offset: 7680},
+													pos:        position{line: 290, col: 14, offset: 7819},
 													val:        "===",
 													ignoreCase: false,
+													want:       "\"===\"",
 												},
 												&litMatcher{
-													pos:        position{line: 289, col: 22, offset: 7688},
+													pos:        position{line: 290, col: 22, offset: 7827},
 													val:        "≡",
 													ignoreCase: false,
+													want:       "\"≡\"",
 												},
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 564, col: 76, offset: 17497},
+											pos:  position{line: 587, col: 76, offset: 18433},
 											name: "_",
 										},
 										&labeledExpr{
-											pos:   position{line: 564, col: 78, offset: 17499},
+											pos:   position{line: 587, col: 78, offset: 18435},
 											label: "e",
 											expr: &ruleRefExpr{
-												pos:  position{line: 564, col: 80, offset: 17501},
+												pos:  position{line: 587, col: 80, offset: 18437},
 												name: "ApplicationExpression",
 											},
 										},
@@ -10132,35 +10891,35 @@ var g = &grammar{
 		},
 		{
 			name: "ApplicationExpression",
-			pos:  position{line: 567, col: 1, offset: 17578},
+			pos:  position{line: 590, col: 1, offset: 18514},
 			expr: &actionExpr{
-				pos: position{line: 567, col: 25, offset: 17604},
+				pos: position{line: 590, col: 25, offset: 18540},
 				run: (*parser).callonApplicationExpression1,
 				expr: &seqExpr{
-					pos: position{line: 567, col: 25, offset: 17604},
+					pos: position{line: 590, col: 25, offset: 18540},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 567, col: 25, offset: 17604},
+							pos:   position{line: 590, col: 25, offset: 18540},
 							label: "f",
 							expr: &ruleRefExpr{
-								pos:  position{line: 567, col: 27, offset: 17606},
+								pos:  position{line: 590, col: 27, offset: 18542},
 								name: "FirstApplicationExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 567, col: 54, offset: 17633},
+							pos:   position{line: 590, col: 54, offset: 18569},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 567, col: 59, offset: 17638},
+								pos: position{line: 590, col: 59, offset: 18574},
 								expr: &seqExpr{
-									pos: position{line: 567, col: 60, offset: 17639},
+									pos: position{line: 590, col: 60, offset: 18575},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 567, col: 60, offset: 17639},
+											pos:  position{line: 590, col: 60, offset: 18575},
 											name: "_1",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 567, col: 63, offset: 17642},
+											pos:  position{line: 590, col: 63, offset: 18578},
 											name: "ImportExpression",
 										},
 									},
@@ -10173,42 +10932,43 @@ var g = &grammar{
 		},
 		{
 			name: "FirstApplicationExpression",
-			pos:  position{line: 576, col: 1, offset: 17885},
+			pos:  position{line: 599, col: 1, offset: 18821},
 			expr: &choiceExpr{
-				pos: position{line: 577, col: 8, offset: 17923},
+				pos: position{line: 600, col: 8, offset: 18859},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 577, col: 8, offset: 17923},
+						pos: position{line: 600, col: 8, offset: 18859},
 						run: (*parser).callonFirstApplicationExpression2,
 						expr: &seqExpr{
-							pos: position{line: 577, col: 8, offset: 17923},
+							pos: position{line: 600, col: 8, offset: 18859},
 							exprs: []interface{}{
 								&litMatcher{
 									pos:        position{line: 263, col: 9, offset: 7197},
 									val:        "merge",
 									ignoreCase: false,
+									want:       "\"merge\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 577, col: 14, offset: 17929},
+									pos:  position{line: 600, col: 14, offset: 18865},
 									name: "_1",
 								},
 								&labeledExpr{
-									pos:   position{line: 577, col: 17, offset: 17932},
+									pos:   position{line: 600, col: 17, offset: 18868},
 									label: "h",
 									expr: &ruleRefExpr{
-										pos:  position{line: 577, col: 19, offset: 17934},
+										pos:  position{line: 600, col: 19, offset: 18870},
 										name: "ImportExpression",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 577, col: 36, offset: 17951},
+									pos:  position{line: 600, col: 36, offset: 18887},
 									name: "_1",
 								},
 								&labeledExpr{
-									pos:   position{line: 577, col: 39, offset: 17954},
+									pos:   position{line: 600, col: 39, offset: 18890},
 									label: "u",
 									expr: &ruleRefExpr{
-										pos:  position{line: 577, col: 41, offset: 17956},
+										pos:  position{line: 600, col: 41, offset: 18892},
 										name: "ImportExpression",
 									},
 								},
@@ -10216,25 +10976,26 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 580, col: 8, offset: 18059},
+						pos: position{line: 603, col: 8, offset: 18995},
 						run: (*parser).callonFirstApplicationExpression11,
 						expr: &seqExpr{
-							pos: position{line: 580, col: 8, offset: 18059},
+							pos: position{line: 603, col: 8, offset: 18995},
 							exprs: []interface{}{
 								&litMatcher{
 									pos:        position{line: 269, col: 8, offset: 7334},
 									val:        "Some",
 									ignoreCase: false,
+									want:       "\"Some\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 580, col: 13, offset: 18064},
+									pos:  position{line: 603, col: 13, offset: 19000},
 									name: "_1",
 								},
 								&labeledExpr{
-									pos:   position{line: 580, col: 16, offset: 18067},
+									pos:   position{line: 603, col: 16, offset: 19003},
 									label: "e",
 									expr: &ruleRefExpr{
-										pos:  position{line: 580, col: 18, offset: 18069},
+										pos:  position{line: 603, col: 18, offset: 19005},
 										name: "ImportExpression",
 									},
 								},
@@ -10242,25 +11003,26 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 581, col: 8, offset: 18124},
+						pos: position{line: 604, col: 8, offset: 19060},
 						run: (*parser).callonFirstApplicationExpression17,
 						expr: &seqExpr{
-							pos: position{line: 581, col: 8, offset: 18124},
+							pos: position{line: 604, col: 8, offset: 19060},
 							exprs: []interface{}{
 								&litMatcher{
 									pos:        position{line: 270, col: 9, offset: 7351},
 									val:        "toMap",
 									ignoreCase: false,
+									want:       "\"toMap\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 581, col: 14, offset: 18130},
+									pos:  position{line: 604, col: 14, offset: 19066},
 									name: "_1",
 								},
 								&labeledExpr{
-									pos:   position{line: 581, col: 17, offset: 18133},
+									pos:   position{line: 604, col: 17, offset: 19069},
 									label: "e",
 									expr: &ruleRefExpr{
-										pos:  position{line: 581, col: 19, offset: 18135},
+										pos:  position{line: 604, col: 19, offset: 19071},
 										name: "ImportExpression",
 									},
 								},
@@ -10268,7 +11030,7 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 582, col: 8, offset: 18199},
+						pos:  position{line: 605, col: 8, offset: 19135},
 						name: "ImportExpression",
 					},
 				},
@@ -10276,16 +11038,16 @@ var g = &grammar{
 		},
 		{
 			name: "ImportExpression",
-			pos:  position{line: 584, col: 1, offset: 18217},
+			pos:  position{line: 607, col: 1, offset: 19153},
 			expr: &choiceExpr{
-				pos: position{line: 584, col: 20, offset: 18238},
+				pos: position{line: 607, col: 20, offset: 19174},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 584, col: 20, offset: 18238},
+						pos:  position{line: 607, col: 20, offset: 19174},
 						name: "Import",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 584, col: 29, offset: 18247},
+						pos:  position{line: 607, col: 29, offset: 19183},
 						name: "CompletionExpression",
 					},
 				},
@@ -10293,36 +11055,37 @@ var g = &grammar{
 		},
 		{
 			name: "CompletionExpression",
-			pos:  position{line: 586, col: 1, offset: 18269},
+			pos:  position{line: 609, col: 1, offset: 19205},
 			expr: &actionExpr{
-				pos: position{line: 586, col: 24, offset: 18294},
+				pos: position{line: 609, col: 24, offset: 19230},
 				run: (*parser).callonCompletionExpression1,
 				expr: &seqExpr{
-					pos: position{line: 586, col: 24, offset: 18294},
+					pos: position{line: 609, col: 24, offset: 19230},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 586, col: 24, offset: 18294},
+							pos:   position{line: 609, col: 24, offset: 19230},
 							label: "a",
 							expr: &ruleRefExpr{
-								pos:  position{line: 586, col: 26, offset: 18296},
+								pos:  position{line: 609, col: 26, offset: 19232},
 								name: "SelectorExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 586, col: 45, offset: 18315},
+							pos:   position{line: 609, col: 45, offset: 19251},
 							label: "b",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 586, col: 47, offset: 18317},
+								pos: position{line: 609, col: 47, offset: 19253},
 								expr: &seqExpr{
-									pos: position{line: 586, col: 48, offset: 18318},
+									pos: position{line: 609, col: 48, offset: 19254},
 									exprs: []interface{}{
 										&litMatcher{
-											pos:        position{line: 294, col: 12, offset: 7805},
+											pos:        position{line: 295, col: 12, offset: 7944},
 											val:        "::",
 											ignoreCase: false,
+											want:       "\"::\"",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 586, col: 57, offset: 18327},
+											pos:  position{line: 609, col: 57, offset: 19263},
 											name: "SelectorExpression",
 										},
 									},
@@ -10335,44 +11098,45 @@ var g = &grammar{
 		},
 		{
 			name: "SelectorExpression",
-			pos:  position{line: 593, col: 1, offset: 18482},
+			pos:  position{line: 616, col: 1, offset: 19418},
 			expr: &actionExpr{
-				pos: position{line: 593, col: 22, offset: 18505},
+				pos: position{line: 616, col: 22, offset: 19441},
 				run: (*parser).callonSelectorExpression1,
 				expr: &seqExpr{
-					pos: position{line: 593, col: 22, offset: 18505},
+					pos: position{line: 616, col: 22, offset: 19441},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 593, col: 22, offset: 18505},
+							pos:   position{line: 616, col: 22, offset: 19441},
 							label: "e",
 							expr: &ruleRefExpr{
-								pos:  position{line: 593, col: 24, offset: 18507},
+								pos:  position{line: 616, col: 24, offset: 19443},
 								name: "PrimitiveExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 593, col: 44, offset: 18527},
+							pos:   position{line: 616, col: 44, offset: 19463},
 							label: "ls",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 593, col: 47, offset: 18530},
+								pos: position{line: 616, col: 47, offset: 19466},
 								expr: &seqExpr{
-									pos: position{line: 593, col: 48, offset: 18531},
+									pos: position{line: 616, col: 48, offset: 19467},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 593, col: 48, offset: 18531},
+											pos:  position{line: 616, col: 48, offset: 19467},
 											name: "_",
 										},
 										&litMatcher{
-											pos:        position{line: 593, col: 50, offset: 18533},
+											pos:        position{line: 616, col: 50, offset: 19469},
 											val:        ".",
 											ignoreCase: false,
+											want:       "\".\"",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 593, col: 54, offset: 18537},
+											pos:  position{line: 616, col: 54, offset: 19473},
 											name: "_",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 593, col: 56, offset: 18539},
+											pos:  position{line: 616, col: 56, offset: 19475},
 											name: "Selector",
 										},
 									},
@@ -10385,9 +11149,9 @@ var g = &grammar{
 		},
 		{
 			name: "Selector",
-			pos:  position{line: 612, col: 1, offset: 19092},
+			pos:  position{line: 635, col: 1, offset: 20028},
 			expr: &choiceExpr{
-				pos: position{line: 612, col: 12, offset: 19105},
+				pos: position{line: 635, col: 12, offset: 20041},
 				alternatives: []interface{}{
 					&actionExpr{
 						pos: position{line: 121, col: 9, offset: 2802},
@@ -10399,6 +11163,7 @@ var g = &grammar{
 									pos:        position{line: 121, col: 9, offset: 2802},
 									val:        "`",
 									ignoreCase: false,
+									want:       "\"`\"",
 								},
 								&labeledExpr{
 									pos:   position{line: 121, col: 13, offset: 2806},
@@ -10422,6 +11187,7 @@ var g = &grammar{
 									pos:        position{line: 121, col: 31, offset: 2824},
 									val:        "`",
 									ignoreCase: false,
+									want:       "\"`\"",
 								},
 							},
 						},
@@ -10442,37 +11208,43 @@ var g = &grammar{
 											pos: position{line: 112, col: 15, offset: 2499},
 											exprs: []interface{}{
 												&choiceExpr{
-													pos: position{line: 274, col: 5, offset: 7396},
+													pos: position{line: 276, col: 5, offset: 7537},
 													alternatives: []interface{}{
 														&litMatcher{
 															pos:        position{line: 256, col: 6, offset: 7094},
 															val:        "if",
 															ignoreCase: false,
+															want:       "\"if\"",
 														},
 														&litMatcher{
 															pos:        position{line: 257, col: 8, offset: 7108},
 															val:        "then",
 															ignoreCase: false,
+															want:       "\"then\"",
 														},
 														&litMatcher{
 															pos:        position{line: 258, col: 8, offset: 7124},
 															val:        "else",
 															ignoreCase: false,
+															want:       "\"else\"",
 														},
 														&litMatcher{
 															pos:        position{line: 259, col: 7, offset: 7139},
 															val:        "let",
 															ignoreCase: false,
+															want:       "\"let\"",
 														},
 														&litMatcher{
 															pos:        position{line: 260, col: 6, offset: 7152},
 															val:        "in",
 															ignoreCase: false,
+															want:       "\"in\"",
 														},
 														&litMatcher{
 															pos:        position{line: 262, col: 9, offset: 7179},
 															val:        "using",
 															ignoreCase: false,
+															want:       "\"using\"",
 														},
 														&actionExpr{
 															pos: position{line: 264, col: 11, offset: 7217},
@@ -10481,52 +11253,62 @@ var g = &grammar{
 																pos:        position{line: 264, col: 11, offset: 7217},
 																val:        "missing",
 																ignoreCase: false,
+																want:       "\"missing\"",
 															},
 														},
+														&litMatcher{
+															pos:        position{line: 271, col: 10, offset: 7370},
+															val:        "assert",
+															ignoreCase: false,
+															want:       "\"assert\"",
+														},
 														&litMatcher{
 															pos:        position{line: 261, col: 6, offset: 7164},
 															val:        "as",
 															ignoreCase: false,
+															want:       "\"as\"",
 														},
 														&litMatcher{
 															pos:        position{line: 265, col: 8, offset: 7262},
 															val:        "True",
 															ignoreCase: false,
+															want:       "\"True\"",
 														},
 														&litMatcher{
 															pos:        position{line: 266, col: 9, offset: 7279},
 															val:        "False",
 															ignoreCase: false,
+															want:       "\"False\"",
 														},
 														&litMatcher{
 															pos:        position{line: 267, col: 12, offset: 7300},
 															val:        "Infinity",
 															ignoreCase: false,
+															want:       "\"Infinity\"",
 														},
 														&litMatcher{
 															pos:        position{line: 268, col: 7, offset: 7319},
 															val:        "NaN",
 															ignoreCase: false,
+															want:       "\"NaN\"",
 														},
 														&litMatcher{
 															pos:        position{line: 263, col: 9, offset: 7197},
 															val:        "merge",
 															ignoreCase: false,
+															want:       "\"merge\"",
 														},
 														&litMatcher{
 															pos:        position{line: 269, col: 8, offset: 7334},
 															val:        "Some",
 															ignoreCase: false,
+															want:       "\"Some\"",
 														},
 														&litMatcher{
 															pos:        position{line: 270, col: 9, offset: 7351},
 															val:        "toMap",
 															ignoreCase: false,
-														},
-														&litMatcher{
-															pos:        position{line: 271, col: 10, offset: 7370},
-															val:        "assert",
-															ignoreCase: false,
+															want:       "\"toMap\"",
 														},
 													},
 												},
@@ -10553,37 +11335,43 @@ var g = &grammar{
 												&notExpr{
 													pos: position{line: 113, col: 13, offset: 2571},
 													expr: &choiceExpr{
-														pos: position{line: 274, col: 5, offset: 7396},
+														pos: position{line: 276, col: 5, offset: 7537},
 														alternatives: []interface{}{
 															&litMatcher{
 																pos:        position{line: 256, col: 6, offset: 7094},
 																val:        "if",
 																ignoreCase: false,
+																want:       "\"if\"",
 															},
 															&litMatcher{
 																pos:        position{line: 257, col: 8, offset: 7108},
 																val:        "then",
 																ignoreCase: false,
+																want:       "\"then\"",
 															},
 															&litMatcher{
 																pos:        position{line: 258, col: 8, offset: 7124},
 																val:        "else",
 																ignoreCase: false,
+																want:       "\"else\"",
 															},
 															&litMatcher{
 																pos:        position{line: 259, col: 7, offset: 7139},
 																val:        "let",
 																ignoreCase: false,
+																want:       "\"let\"",
 															},
 															&litMatcher{
 																pos:        position{line: 260, col: 6, offset: 7152},
 																val:        "in",
 																ignoreCase: false,
+																want:       "\"in\"",
 															},
 															&litMatcher{
 																pos:        position{line: 262, col: 9, offset: 7179},
 																val:        "using",
 																ignoreCase: false,
+																want:       "\"using\"",
 															},
 															&actionExpr{
 																pos: position{line: 264, col: 11, offset: 7217},
@@ -10592,52 +11380,62 @@ var g = &grammar{
 																	pos:        position{line: 264, col: 11, offset: 7217},
 																	val:        "missing",
 																	ignoreCase: false,
+																	want:       "\"missing\"",
 																},
 															},
+															&litMatcher{
+																pos:        position{line: 271, col: 10, offset: 7370},
+																val:        "assert",
+																ignoreCase: false,
+																want:       "\"assert\"",
+															},
 															&litMatcher{
 																pos:        position{line: 261, col: 6, offset: 7164},
 																val:        "as",
 																ignoreCase: false,
+																want:       "\"as\"",
 															},
 															&litMatcher{
 																pos:        position{line: 265, col: 8, offset: 7262},
 																val:        "True",
 																ignoreCase: false,
+																want:       "\"True\"",
 															},
 															&litMatcher{
 																pos:        position{line: 266, col: 9, offset: 7279},
 																val:        "False",
 																ignoreCase: false,
+																want:       "\"False\"",
 															},
 															&litMatcher{
 																pos:        position{line: 267, col: 12, offset: 7300},
 																val:        "Infinity",
 																ignoreCase: false,
+																want:       "\"Infinity\"",
 															},
 															&litMatcher{
 																pos:        position{line: 268, col: 7, offset: 7319},
 																val:        "NaN",
 																ignoreCase: false,
+																want:       "\"NaN\"",
 															},
 															&litMatcher{
 																pos:        position{line: 263, col: 9, offset: 7197},
 																val:        "merge",
 																ignoreCase: false,
+																want:       "\"merge\"",
 															},
 															&litMatcher{
 																pos:        position{line: 269, col: 8, offset: 7334},
 																val:        "Some",
 																ignoreCase: false,
+																want:       "\"Some\"",
 															},
 															&litMatcher{
 																pos:        position{line: 270, col: 9, offset: 7351},
-																val:        "toMap",
-																ignoreCase: false,
-															},
-															&litMatcher{
-																pos:        position{line: 271, col: 10, offset: 7370},
-																val:        "assert",
+																val:        "toMap",
 																ignoreCase: false,
+																want:       "\"toMap\"",
 															},
 														},
 													},
@@ -10669,11 +11467,11 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 612, col: 23, offset: 19116},
+						pos:  position{line: 635, col: 23, offset: 20052},
 						name: "Labels",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 612, col: 32, offset: 19125},
+						pos:  position{line: 635, col: 32, offset: 20061},
 						name: "TypeSelector",
 					},
 				},
@@ -10681,29 +11479,30 @@ var g = &grammar{
 		},
 		{
 			name: "Labels",
-			pos:  position{line: 614, col: 1, offset: 19139},
+			pos:  position{line: 637, col: 1, offset: 20075},
 			expr: &actionExpr{
-				pos: position{line: 614, col: 10, offset: 19150},
+				pos: position{line: 637, col: 10, offset: 20086},
 				run: (*parser).callonLabels1,
 				expr: &seqExpr{
-					pos: position{line: 614, col: 10, offset: 19150},
+					pos: position{line: 637, col: 10, offset: 20086},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 614, col: 10, offset: 19150},
+							pos:        position{line: 637, col: 10, offset: 20086},
 							val:        "{",
 							ignoreCase: false,
+							want:       "\"{\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 614, col: 14, offset: 19154},
+							pos:  position{line: 637, col: 14, offset: 20090},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 614, col: 16, offset: 19156},
+							pos:   position{line: 637, col: 16, offset: 20092},
 							label: "optclauses",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 614, col: 27, offset: 19167},
+								pos: position{line: 637, col: 27, offset: 20103},
 								expr: &seqExpr{
-									pos: position{line: 614, col: 29, offset: 19169},
+									pos: position{line: 637, col: 29, offset: 20105},
 									exprs: []interface{}{
 										&choiceExpr{
 											pos: position{line: 121, col: 9, offset: 2802},
@@ -10718,6 +11517,7 @@ var g = &grammar{
 																pos:        position{line: 121, col: 9, offset: 2802},
 																val:        "`",
 																ignoreCase: false,
+																want:       "\"`\"",
 															},
 															&labeledExpr{
 																pos:   position{line: 121, col: 13, offset: 2806},
@@ -10741,6 +11541,7 @@ var g = &grammar{
 																pos:        position{line: 121, col: 31, offset: 2824},
 																val:        "`",
 																ignoreCase: false,
+																want:       "\"`\"",
 															},
 														},
 													},
@@ -10761,37 +11562,43 @@ var g = &grammar{
 																		pos: position{line: 112, col: 15, offset: 2499},
 																		exprs: []interface{}{
 																			&choiceExpr{
-																				pos: position{line: 274, col: 5, offset: 7396},
+																				pos: position{line: 276, col: 5, offset: 7537},
 																				alternatives: []interface{}{
 																					&litMatcher{
 																						pos:        position{line: 256, col: 6, offset: 7094},
 																						val:        "if",
 																						ignoreCase: false,
+																						want:       "\"if\"",
 																					},
 																					&litMatcher{
 																						pos:        position{line: 257, col: 8, offset: 7108},
 																						val:        "then",
 																						ignoreCase: false,
+																						want:       "\"then\"",
 																					},
 																					&litMatcher{
 																						pos:        position{line: 258, col: 8, offset: 7124},
 																						val:        "else",
 																						ignoreCase: false,
+																						want:       "\"else\"",
 																					},
 																					&litMatcher{
 																						pos:        position{line: 259, col: 7, offset: 7139},
 																						val:        "let",
 																						ignoreCase: false,
+																						want:       "\"let\"",
 																					},
 																					&litMatcher{
 																						pos:        position{line: 260, col: 6, offset: 7152},
 																						val:        "in",
 																						ignoreCase: false,
+																						want:       "\"in\"",
 																					},
 																					&litMatcher{
 																						pos:        position{line: 262, col: 9, offset: 7179},
 																						val:        "using",
 																						ignoreCase: false,
+																						want:       "\"using\"",
 																					},
 																					&actionExpr{
 																						pos: position{line: 264, col: 11, offset: 7217},
@@ -10800,52 +11607,62 @@ var g = &grammar{
 																							pos:        position{line: 264, col: 11, offset: 7217},
 																							val:        "missing",
 																							ignoreCase: false,
+																							want:       "\"missing\"",
 																						},
 																					},
+																					&litMatcher{
+																						pos:        position{line: 271, col: 10, offset: 7370},
+																						val:        "assert",
+																						ignoreCase: false,
+																						want:       "\"assert\"",
+																					},
 																					&litMatcher{
 																						pos:        position{line: 261, col: 6, offset: 7164},
 																						val:        "as",
 																						ignoreCase: false,
+																						want:       "\"as\"",
 																					},
 																					&litMatcher{
 																						pos:        position{line: 265, col: 8, offset: 7262},
 																						val:        "True",
 																						ignoreCase: false,
+																						want:       "\"True\"",
 																					},
 																					&litMatcher{
 																						pos:        position{line: 266, col: 9, offset: 7279},
 																						val:        "False",
 																						ignoreCase: false,
+																						want:       "\"False\"",
 																					},
 																					&litMatcher{
 																						pos:        position{line: 267, col: 12, offset: 7300},
 																						val:        "Infinity",
 																						ignoreCase: false,
+																						want:       "\"Infinity\"",
 																					},
 																					&litMatcher{
 																						pos:        position{line: 268, col: 7, offset: 7319},
 																						val:        "NaN",
 																						ignoreCase: false,
+																						want:       "\"NaN\"",
 																					},
 																					&litMatcher{
 																						pos:        position{line: 263, col: 9, offset: 7197},
 																						val:        "merge",
 																						ignoreCase: false,
+																						want:       "\"merge\"",
 																					},
 																					&litMatcher{
 																						pos:        position{line: 269, col: 8, offset: 7334},
 																						val:        "Some",
 																						ignoreCase: false,
+																						want:       "\"Some\"",
 																					},
 																					&litMatcher{
 																						pos:        position{line: 270, col: 9, offset: 7351},
 																						val:        "toMap",
 																						ignoreCase: false,
-																					},
-																					&litMatcher{
-																						pos:        position{line: 271, col: 10, offset: 7370},
-																						val:        "assert",
-																						ignoreCase: false,
+																						want:       "\"toMap\"",
 																					},
 																				},
 																			},
@@ -10872,37 +11689,43 @@ var g = &grammar{
 																			&notExpr{
 																				pos: position{line: 113, col: 13, offset: 2571},
 																				expr: &choiceExpr{
-																					pos: position{line: 274, col: 5, offset: 7396},
+																					pos: position{line: 276, col: 5, offset: 7537},
 																					alternatives: []interface{}{
 																						&litMatcher{
 																							pos:        position{line: 256, col: 6, offset: 7094},
 																							val:        "if",
 																							ignoreCase: false,
+																							want:       "\"if\"",
 																						},
 																						&litMatcher{
 																							pos:        position{line: 257, col: 8, offset: 7108},
 																							val:        "then",
 																							ignoreCase: false,
+																							want:       "\"then\"",
 																						},
 																						&litMatcher{
 																							pos:        position{line: 258, col: 8, offset: 7124},
 																							val:        "else",
 																							ignoreCase: false,
+																							want:       "\"else\"",
 																						},
 																						&litMatcher{
 																							pos:        position{line: 259, col: 7, offset: 7139},
 																							val:        "let",
 																							ignoreCase: false,
+																							want:       "\"let\"",
 																						},
 																						&litMatcher{
 																							pos:        position{line: 260, col: 6, offset: 7152},
 																							val:        "in",
 																							ignoreCase: false,
+																							want:       "\"in\"",
 																						},
 																						&litMatcher{
 																							pos:        position{line: 262, col: 9, offset: 7179},
 																							val:        "using",
 																							ignoreCase: false,
+																							want:       "\"using\"",
 																						},
 																						&actionExpr{
 																							pos: position{line: 264, col: 11, offset: 7217},
@@ -10911,52 +11734,62 @@ var g = &grammar{
 																								pos:        position{line: 264, col: 11, offset: 7217},
 																								val:        "missing",
 																								ignoreCase: false,
+																								want:       "\"missing\"",
 																							},
 																						},
+																						&litMatcher{
+																							pos:        position{line: 271, col: 10, offset: 7370},
+																							val:        "assert",
+																							ignoreCase: false,
+																							want:       "\"assert\"",
+																						},
 																						&litMatcher{
 																							pos:        position{line: 261, col: 6, offset: 7164},
 																							val:        "as",
 																							ignoreCase: false,
+																							want:       "\"as\"",
 																						},
 																						&litMatcher{
 																							pos:        position{line: 265, col: 8, offset: 7262},
 																							val:        "True",
 																							ignoreCase: false,
+																							want:       "\"True\"",
 																						},
 																						&litMatcher{
 																							pos:        position{line: 266, col: 9, offset: 7279},
 																							val:        "False",
 																							ignoreCase: false,
+																							want:       "\"False\"",
 																						},
 																						&litMatcher{
 																							pos:        position{line: 267, col: 12, offset: 7300},
 																							val:        "Infinity",
 																							ignoreCase: false,
+																							want:       "\"Infinity\"",
 																						},
 																						&litMatcher{
 																							pos:        position{line: 268, col: 7, offset: 7319},
 																							val:        "NaN",
 																							ignoreCase: false,
+																							want:       "\"NaN\"",
 																						},
 																						&litMatcher{
 																							pos:        position{line: 263, col: 9, offset: 7197},
 																							val:        "merge",
 																							ignoreCase: false,
+																							want:       "\"merge\"",
 																						},
 																						&litMatcher{
 																							pos:        position{line: 269, col: 8, offset: 7334},
 																							val:        "Some",
 																							ignoreCase: false,
+																							want:       "\"Some\"",
 																						},
 																						&litMatcher{
 																							pos:        position{line: 270, col: 9, offset: 7351},
 																							val:        "toMap",
 																							ignoreCase: false,
-																						},
-																						&litMatcher{
-																							pos:        position{line: 271, col: 10, offset: 7370},
-																							val:        "assert",
-																							ignoreCase: false,
+																							want:       "\"toMap\"",
 																						},
 																					},
 																				},
@@ -10990,21 +11823,22 @@ var g = &grammar{
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 614, col: 38, offset: 19178},
+											pos:  position{line: 637, col: 38, offset: 20114},
 											name: "_",
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 614, col: 40, offset: 19180},
+											pos: position{line: 637, col: 40, offset: 20116},
 											expr: &seqExpr{
-												pos: position{line: 614, col: 41, offset: 19181},
+												pos: position{line: 637, col: 41, offset: 20117},
 												exprs: []interface{}{
 													&litMatcher{
-														pos:        position{line: 614, col: 41, offset: 19181},
+														pos:        position{line: 637, col: 41, offset: 20117},
 														val:        ",",
 														ignoreCase: false,
+														want:       "\",\"",
 													},
 													&ruleRefExpr{
-														pos:  position{line: 614, col: 45, offset: 19185},
+														pos:  position{line: 637, col: 45, offset: 20121},
 														name: "_",
 													},
 													&choiceExpr{
@@ -11020,6 +11854,7 @@ var g = &grammar{
 																			pos:        position{line: 121, col: 9, offset: 2802},
 																			val:        "`",
 																			ignoreCase: false,
+																			want:       "\"`\"",
 																		},
 																		&labeledExpr{
 																			pos:   position{line: 121, col: 13, offset: 2806},
@@ -11043,6 +11878,7 @@ var g = &grammar{
 																			pos:        position{line: 121, col: 31, offset: 2824},
 																			val:        "`",
 																			ignoreCase: false,
+																			want:       "\"`\"",
 																		},
 																	},
 																},
@@ -11063,37 +11899,43 @@ var g = &grammar{
 																					pos: position{line: 112, col: 15, offset: 2499},
 																					exprs: []interface{}{
 																						&choiceExpr{
-																							pos: position{line: 274, col: 5, offset: 7396},
+																							pos: position{line: 276, col: 5, offset: 7537},
 																							alternatives: []interface{}{
 																								&litMatcher{
 																									pos:        position{line: 256, col: 6, offset: 7094},
 																									val:        "if",
 																									ignoreCase: false,
+																									want:       "\"if\"",
 																								},
 																								&litMatcher{
 																									pos:        position{line: 257, col: 8, offset: 7108},
 																									val:        "then",
 																									ignoreCase: false,
+																									want:       "\"then\"",
 																								},
 																								&litMatcher{
 																									pos:        position{line: 258, col: 8, offset: 7124},
 																									val:        "else",
 																									ignoreCase: false,
+																									want:       "\"else\"",
 																								},
 																								&litMatcher{
 																									pos:        position{line: 259, col: 7, offset: 7139},
 																									val:        "let",
 																									ignoreCase: false,
+																									want:       "\"let\"",
 																								},
 																								&litMatcher{
 																									pos:        position{line: 260, col: 6, offset: 7152},
 																									val:        "in",
 																									ignoreCase: false,
+																									want:       "\"in\"",
 																								},
 																								&litMatcher{
 																									pos:        position{line: 262, col: 9, offset: 7179},
 																									val:        "using",
 																									ignoreCase: false,
+																									want:       "\"using\"",
 																								},
 																								&actionExpr{
 																									pos: position{line: 264, col: 11, offset: 7217},
@@ -11102,52 +11944,62 @@ var g = &grammar{
 																										pos:        position{line: 264, col: 11, offset: 7217},
 																										val:        "missing",
 																										ignoreCase: false,
+																										want:       "\"missing\"",
 																									},
 																								},
+																								&litMatcher{
+																									pos:        position{line: 271, col: 10, offset: 7370},
+																									val:        "assert",
+																									ignoreCase: false,
+																									want:       "\"assert\"",
+																								},
 																								&litMatcher{
 																									pos:        position{line: 261, col: 6, offset: 7164},
 																									val:        "as",
 																									ignoreCase: false,
+																									want:       "\"as\"",
 																								},
 																								&litMatcher{
 																									pos:        position{line: 265, col: 8, offset: 7262},
 																									val:        "True",
 																									ignoreCase: false,
+																									want:       "\"True\"",
 																								},
 																								&litMatcher{
 																									pos:        position{line: 266, col: 9, offset: 7279},
 																									val:        "False",
 																									ignoreCase: false,
+																									want:       "\"False\"",
 																								},
 																								&litMatcher{
 																									pos:        position{line: 267, col: 12, offset: 7300},
 																									val:        "Infinity",
 																									ignoreCase: false,
+																									want:       "\"Infinity\"",
 																								},
 																								&litMatcher{
 																									pos:        position{line: 268, col: 7, offset: 7319},
 																									val:        "NaN",
 																									ignoreCase: false,
+																									want:       "\"NaN\"",
 																								},
 																								&litMatcher{
 																									pos:        position{line: 263, col: 9, offset: 7197},
 																									val:        "merge",
 																									ignoreCase: false,
+																									want:       "\"merge\"",
 																								},
 																								&litMatcher{
 																									pos:        position{line: 269, col: 8, offset: 7334},
 																									val:        "Some",
 																									ignoreCase: false,
+																									want:       "\"Some\"",
 																								},
 																								&litMatcher{
 																									pos:        position{line: 270, col: 9, offset: 7351},
 																									val:        "toMap",
 																									ignoreCase: false,
-																								},
-																								&litMatcher{
-																									pos:        position{line: 271, col: 10, offset: 7370},
-																									val:        "assert",
-																									ignoreCase: false,
+																									want:       "\"toMap\"",
 																								},
 																							},
 																						},
@@ -11174,37 +12026,43 @@ var g = &grammar{
 																						&notExpr{
 																							pos: position{line: 113, col: 13, offset: 2571},
 																							expr: &choiceExpr{
-																								pos: position{line: 274, col: 5, offset: 7396},
+																								pos: position{line: 276, col: 5, offset: 7537},
 																								alternatives: []interface{}{
 																									&litMatcher{
 																										pos:        position{line: 256, col: 6, offset: 7094},
 																										val:        "if",
 																										ignoreCase: false,
+																										want:       "\"if\"",
 																									},
 																									&litMatcher{
 																										pos:        position{line: 257, col: 8, offset: 7108},
 																										val:        "then",
 																										ignoreCase: false,
+																										want:       "\"then\"",
 																									},
 																									&litMatcher{
 																										pos:        position{line: 258, col: 8, offset: 7124},
 																										val:        "else",
 																										ignoreCase: false,
+																										want:       "\"else\"",
 																									},
 																									&litMatcher{
 																										pos:        position{line: 259, col: 7, offset: 7139},
 																										val:        "let",
 																										ignoreCase: false,
+																										want:       "\"let\"",
 																									},
 																									&litMatcher{
 																										pos:        position{line: 260, col: 6, offset: 7152},
 																										val:        "in",
 																										ignoreCase: false,
+																										want:       "\"in\"",
 																									},
 																									&litMatcher{
 																										pos:        position{line: 262, col: 9, offset: 7179},
 																										val:        "using",
 																										ignoreCase: false,
+																										want:       "\"using\"",
 																									},
 																									&actionExpr{
 																										pos: position{line: 264, col: 11, offset: 7217},
@@ -11213,52 +12071,62 @@ var g = &grammar{
 																											pos:        position{line: 264, col: 11, offset: 7217},
 																											val:        "missing",
 																											ignoreCase: false,
+																											want:       "\"missing\"",
 																										},
 																									},
 																									&litMatcher{
+																										pos:        position{line: 271, col: 10, offset: 7370},
+																										val:        "assert",
+																										ignoreCase: false,
+																										want:       "\"assert\"",
+																									},
+																									&litMatcher{
 																										pos:        position{line: 261, col: 6, offset: 7164},
 																										val:        "as",
 																										ignoreCase: false,
+																										want:       "\"as\"",
 																									},
 																									&litMatcher{
 																										pos:        position{line: 265, col: 8, offset: 7262},
 																										val:        "True",
 																										ignoreCase: false,
+																										want:       "\"True\"",
 																									},
 																									&litMatcher{
 																										pos:        position{line: 266, col: 9, offset: 7279},
 																										val:        "False",
 																										ignoreCase: false,
+																										want:       "\"False\"",
 																									},
 																									&litMatcher{
 																										pos:        position{line: 267, col: 12, offset: 7300},
 																										val:        "Infinity",
 																										ignoreCase: false,
+																										want:       "\"Infinity\"",
 																									},
 																									&litMatcher{
 																										pos:        position{line: 268, col: 7, offset: 7319},
 																										val:        "NaN",
 																										ignoreCase: false,
+																										want:       "\"NaN\"",
 																									},
 																									&litMatcher{
 																										pos:        position{line: 263, col: 9, offset: 7197},
 																										val:        "merge",
 																										ignoreCase: false,
+																										want:       "\"merge\"",
 																									},
 																									&litMatcher{
 																										pos:        position{line: 269, col: 8, offset: 7334},
 																										val:        "Some",
 																										ignoreCase: false,
+																										want:       "\"Some\"",
 																									},
 																									&litMatcher{
 																										pos:        position{line: 270, col: 9, offset: 7351},
 																										val:        "toMap",
 																										ignoreCase: false,
-																									},
-																									&litMatcher{
-																										pos:        position{line: 271, col: 10, offset: 7370},
-																										val:        "assert",
-																										ignoreCase: false,
+																										want:       "\"toMap\"",
 																									},
 																								},
 																							},
@@ -11292,7 +12160,7 @@ var g = &grammar{
 														},
 													},
 													&ruleRefExpr{
-														pos:  position{line: 614, col: 56, offset: 19196},
+														pos:  position{line: 637, col: 56, offset: 20132},
 														name: "_",
 													},
 												},
@@ -11303,9 +12171,10 @@ var g = &grammar{
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 614, col: 64, offset: 19204},
+							pos:        position{line: 637, col: 64, offset: 20140},
 							val:        "}",
 							ignoreCase: false,
+							want:       "\"}\"",
 						},
 					},
 				},
@@ -11313,38 +12182,40 @@ var g = &grammar{
 		},
 		{
 			name: "TypeSelector",
-			pos:  position{line: 624, col: 1, offset: 19500},
+			pos:  position{line: 647, col: 1, offset: 20436},
 			expr: &actionExpr{
-				pos: position{line: 624, col: 16, offset: 19517},
+				pos: position{line: 647, col: 16, offset: 20453},
 				run: (*parser).callonTypeSelector1,
 				expr: &seqExpr{
-					pos: position{line: 624, col: 16, offset: 19517},
+					pos: position{line: 647, col: 16, offset: 20453},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 624, col: 16, offset: 19517},
+							pos:        position{line: 647, col: 16, offset: 20453},
 							val:        "(",
 							ignoreCase: false,
+							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 624, col: 20, offset: 19521},
+							pos:  position{line: 647, col: 20, offset: 20457},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 624, col: 22, offset: 19523},
+							pos:   position{line: 647, col: 22, offset: 20459},
 							label: "e",
 							expr: &ruleRefExpr{
-								pos:  position{line: 624, col: 24, offset: 19525},
+								pos:  position{line: 647, col: 24, offset: 20461},
 								name: "Expression",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 624, col: 35, offset: 19536},
+							pos:  position{line: 647, col: 35, offset: 20472},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 624, col: 37, offset: 19538},
+							pos:        position{line: 647, col: 37, offset: 20474},
 							val:        ")",
 							ignoreCase: false,
+							want:       "\")\"",
 						},
 					},
 				},
@@ -11352,23 +12223,23 @@ var g = &grammar{
 		},
 		{
 			name: "PrimitiveExpression",
-			pos:  position{line: 626, col: 1, offset: 19561},
+			pos:  position{line: 649, col: 1, offset: 20497},
 			expr: &choiceExpr{
-				pos: position{line: 627, col: 7, offset: 19591},
+				pos: position{line: 650, col: 7, offset: 20527},
 				alternatives: []interface{}{
 					&labeledExpr{
-						pos:   position{line: 306, col: 17, offset: 8080},
+						pos:   position{line: 307, col: 17, offset: 8219},
 						label: "d",
 						expr: &actionExpr{
-							pos: position{line: 298, col: 24, offset: 7868},
+							pos: position{line: 299, col: 24, offset: 8007},
 							run: (*parser).callonPrimitiveExpression3,
 							expr: &seqExpr{
-								pos: position{line: 298, col: 24, offset: 7868},
+								pos: position{line: 299, col: 24, offset: 8007},
 								exprs: []interface{}{
 									&zeroOrOneExpr{
-										pos: position{line: 298, col: 24, offset: 7868},
+										pos: position{line: 299, col: 24, offset: 8007},
 										expr: &charClassMatcher{
-											pos:        position{line: 298, col: 24, offset: 7868},
+											pos:        position{line: 299, col: 24, offset: 8007},
 											val:        "[+-]",
 											chars:      []rune{'+', '-'},
 											ignoreCase: false,
@@ -11376,7 +12247,7 @@ var g = &grammar{
 										},
 									},
 									&oneOrMoreExpr{
-										pos: position{line: 298, col: 30, offset: 7874},
+										pos: position{line: 299, col: 30, offset: 8013},
 										expr: &charClassMatcher{
 											pos:        position{line: 106, col: 9, offset: 2375},
 											val:        "[0-9]",
@@ -11386,18 +12257,19 @@ var g = &grammar{
 										},
 									},
 									&choiceExpr{
-										pos: position{line: 298, col: 39, offset: 7883},
+										pos: position{line: 299, col: 39, offset: 8022},
 										alternatives: []interface{}{
 											&seqExpr{
-												pos: position{line: 298, col: 39, offset: 7883},
+												pos: position{line: 299, col: 39, offset: 8022},
 												exprs: []interface{}{
 													&litMatcher{
-														pos:        position{line: 298, col: 39, offset: 7883},
+														pos:        position{line: 299, col: 39, offset: 8022},
 														val:        ".",
 														ignoreCase: false,
+														want:       "\".\"",
 													},
 													&oneOrMoreExpr{
-														pos: position{line: 298, col: 43, offset: 7887},
+														pos: position{line: 299, col: 43, offset: 8026},
 														expr: &charClassMatcher{
 															pos:        position{line: 106, col: 9, offset: 2375},
 															val:        "[0-9]",
@@ -11407,19 +12279,20 @@ var g = &grammar{
 														},
 													},
 													&zeroOrOneExpr{
-														pos: position{line: 298, col: 50, offset: 7894},
+														pos: position{line: 299, col: 50, offset: 8033},
 														expr: &seqExpr{
-															pos: position{line: 296, col: 12, offset: 7824},
+															pos: position{line: 297, col: 12, offset: 7963},
 															exprs: []interface{}{
 																&litMatcher{
-																	pos:        position{line: 296, col: 12, offset: 7824},
+																	pos:        position{line: 297, col: 12, offset: 7963},
 																	val:        "e",
 																	ignoreCase: true,
+																	want:       "\"e\"i",
 																},
 																&zeroOrOneExpr{
-																	pos: position{line: 296, col: 17, offset: 7829},
+																	pos: position{line: 297, col: 17, offset: 7968},
 																	expr: &charClassMatcher{
-																		pos:        position{line: 296, col: 17, offset: 7829},
+																		pos:        position{line: 297, col: 17, offset: 7968},
 																		val:        "[+-]",
 																		chars:      []rune{'+', '-'},
 																		ignoreCase: false,
@@ -11427,7 +12300,7 @@ var g = &grammar{
 																	},
 																},
 																&oneOrMoreExpr{
-																	pos: position{line: 296, col: 23, offset: 7835},
+																	pos: position{line: 297, col: 23, offset: 7974},
 																	expr: &charClassMatcher{
 																		pos:        position{line: 106, col: 9, offset: 2375},
 																		val:        "[0-9]",
@@ -11442,17 +12315,18 @@ var g = &grammar{
 												},
 											},
 											&seqExpr{
-												pos: position{line: 296, col: 12, offset: 7824},
+												pos: position{line: 297, col: 12, offset: 7963},
 												exprs: []interface{}{
 													&litMatcher{
-														pos:        position{line: 296, col: 12, offset: 7824},
+														pos:        position{line: 297, col: 12, offset: 7963},
 														val:        "e",
 														ignoreCase: true,
+														want:       "\"e\"i",
 													},
 													&zeroOrOneExpr{
-														pos: position{line: 296, col: 17, offset: 7829},
+														pos: position{line: 297, col: 17, offset: 7968},
 														expr: &charClassMatcher{
-															pos:        position{line: 296, col: 17, offset: 7829},
+															pos:        position{line: 297, col: 17, offset: 7968},
 															val:        "[+-]",
 															chars:      []rune{'+', '-'},
 															ignoreCase: false,
@@ -11460,7 +12334,7 @@ var g = &grammar{
 														},
 													},
 													&oneOrMoreExpr{
-														pos: position{line: 296, col: 23, offset: 7835},
+														pos: position{line: 297, col: 23, offset: 7974},
 														expr: &charClassMatcher{
 															pos:        position{line: 106, col: 9, offset: 2375},
 															val:        "[0-9]",
@@ -11478,37 +12352,40 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 307, col: 5, offset: 8107},
+						pos: position{line: 308, col: 5, offset: 8246},
 						run: (*parser).callonPrimitiveExpression27,
 						expr: &litMatcher{
 							pos:        position{line: 267, col: 12, offset: 7300},
 							val:        "Infinity",
 							ignoreCase: false,
+							want:       "\"Infinity\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 308, col: 5, offset: 8159},
+						pos: position{line: 309, col: 5, offset: 8298},
 						run: (*parser).callonPrimitiveExpression29,
 						expr: &litMatcher{
-							pos:        position{line: 308, col: 5, offset: 8159},
+							pos:        position{line: 309, col: 5, offset: 8298},
 							val:        "-Infinity",
 							ignoreCase: false,
+							want:       "\"-Infinity\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 309, col: 5, offset: 8216},
+						pos: position{line: 310, col: 5, offset: 8355},
 						run: (*parser).callonPrimitiveExpression31,
 						expr: &litMatcher{
 							pos:        position{line: 268, col: 7, offset: 7319},
 							val:        "NaN",
 							ignoreCase: false,
+							want:       "\"NaN\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 311, col: 18, offset: 8278},
+						pos: position{line: 312, col: 18, offset: 8417},
 						run: (*parser).callonPrimitiveExpression33,
 						expr: &oneOrMoreExpr{
-							pos: position{line: 311, col: 18, offset: 8278},
+							pos: position{line: 312, col: 18, offset: 8417},
 							expr: &charClassMatcher{
 								pos:        position{line: 106, col: 9, offset: 2375},
 								val:        "[0-9]",
@@ -11519,23 +12396,23 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 316, col: 18, offset: 8386},
+						pos: position{line: 317, col: 18, offset: 8525},
 						run: (*parser).callonPrimitiveExpression36,
 						expr: &seqExpr{
-							pos: position{line: 316, col: 18, offset: 8386},
+							pos: position{line: 317, col: 18, offset: 8525},
 							exprs: []interface{}{
 								&charClassMatcher{
-									pos:        position{line: 316, col: 18, offset: 8386},
+									pos:        position{line: 317, col: 18, offset: 8525},
 									val:        "[+-]",
 									chars:      []rune{'+', '-'},
 									ignoreCase: false,
 									inverted:   false,
 								},
 								&actionExpr{
-									pos: position{line: 311, col: 18, offset: 8278},
+									pos: position{line: 312, col: 18, offset: 8417},
 									run: (*parser).callonPrimitiveExpression39,
 									expr: &oneOrMoreExpr{
-										pos: position{line: 311, col: 18, offset: 8278},
+										pos: position{line: 312, col: 18, offset: 8417},
 										expr: &charClassMatcher{
 											pos:        position{line: 106, col: 9, offset: 2375},
 											val:        "[0-9]",
@@ -11549,169 +12426,178 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 630, col: 7, offset: 19653},
+						pos:  position{line: 653, col: 7, offset: 20589},
 						name: "TextLiteral",
 					},
 					&actionExpr{
-						pos: position{line: 631, col: 7, offset: 19671},
+						pos: position{line: 654, col: 7, offset: 20607},
 						run: (*parser).callonPrimitiveExpression43,
 						expr: &seqExpr{
-							pos: position{line: 631, col: 7, offset: 19671},
+							pos: position{line: 654, col: 7, offset: 20607},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 631, col: 7, offset: 19671},
+									pos:        position{line: 654, col: 7, offset: 20607},
 									val:        "{",
 									ignoreCase: false,
+									want:       "\"{\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 631, col: 11, offset: 19675},
+									pos:  position{line: 654, col: 11, offset: 20611},
 									name: "_",
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 631, col: 13, offset: 19677},
+									pos: position{line: 654, col: 13, offset: 20613},
 									expr: &seqExpr{
-										pos: position{line: 631, col: 14, offset: 19678},
+										pos: position{line: 654, col: 14, offset: 20614},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 631, col: 14, offset: 19678},
+												pos:        position{line: 654, col: 14, offset: 20614},
 												val:        ",",
 												ignoreCase: false,
+												want:       "\",\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 631, col: 18, offset: 19682},
+												pos:  position{line: 654, col: 18, offset: 20618},
 												name: "_",
 											},
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 631, col: 22, offset: 19686},
+									pos:   position{line: 654, col: 22, offset: 20622},
 									label: "r",
 									expr: &ruleRefExpr{
-										pos:  position{line: 631, col: 24, offset: 19688},
+										pos:  position{line: 654, col: 24, offset: 20624},
 										name: "RecordTypeOrLiteral",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 631, col: 44, offset: 19708},
+									pos:  position{line: 654, col: 44, offset: 20644},
 									name: "_",
 								},
 								&litMatcher{
-									pos:        position{line: 631, col: 46, offset: 19710},
+									pos:        position{line: 654, col: 46, offset: 20646},
 									val:        "}",
 									ignoreCase: false,
+									want:       "\"}\"",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 632, col: 7, offset: 19738},
+						pos: position{line: 655, col: 7, offset: 20674},
 						run: (*parser).callonPrimitiveExpression55,
 						expr: &seqExpr{
-							pos: position{line: 632, col: 7, offset: 19738},
+							pos: position{line: 655, col: 7, offset: 20674},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 632, col: 7, offset: 19738},
+									pos:        position{line: 655, col: 7, offset: 20674},
 									val:        "<",
 									ignoreCase: false,
+									want:       "\"<\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 632, col: 11, offset: 19742},
+									pos:  position{line: 655, col: 11, offset: 20678},
 									name: "_",
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 632, col: 13, offset: 19744},
+									pos: position{line: 655, col: 13, offset: 20680},
 									expr: &seqExpr{
-										pos: position{line: 632, col: 14, offset: 19745},
+										pos: position{line: 655, col: 14, offset: 20681},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 632, col: 14, offset: 19745},
+												pos:        position{line: 655, col: 14, offset: 20681},
 												val:        "|",
 												ignoreCase: false,
+												want:       "\"|\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 632, col: 18, offset: 19749},
+												pos:  position{line: 655, col: 18, offset: 20685},
 												name: "_",
 											},
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 632, col: 22, offset: 19753},
+									pos:   position{line: 655, col: 22, offset: 20689},
 									label: "u",
 									expr: &ruleRefExpr{
-										pos:  position{line: 632, col: 24, offset: 19755},
+										pos:  position{line: 655, col: 24, offset: 20691},
 										name: "UnionType",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 632, col: 34, offset: 19765},
+									pos:  position{line: 655, col: 34, offset: 20701},
 									name: "_",
 								},
 								&litMatcher{
-									pos:        position{line: 632, col: 36, offset: 19767},
+									pos:        position{line: 655, col: 36, offset: 20703},
 									val:        ">",
 									ignoreCase: false,
+									want:       "\">\"",
 								},
 							},
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 633, col: 7, offset: 19795},
+						pos:  position{line: 656, col: 7, offset: 20731},
 						name: "NonEmptyListLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 634, col: 7, offset: 19821},
+						pos:  position{line: 657, col: 7, offset: 20757},
 						name: "Identifier",
 					},
 					&actionExpr{
-						pos: position{line: 635, col: 7, offset: 19838},
+						pos: position{line: 658, col: 7, offset: 20774},
 						run: (*parser).callonPrimitiveExpression69,
 						expr: &seqExpr{
-							pos: position{line: 635, col: 7, offset: 19838},
+							pos: position{line: 658, col: 7, offset: 20774},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 635, col: 7, offset: 19838},
+									pos:        position{line: 658, col: 7, offset: 20774},
 									val:        "(",
 									ignoreCase: false,
+									want:       "\"(\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 635, col: 11, offset: 19842},
+									pos:  position{line: 658, col: 11, offset: 20778},
 									name: "_",
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 635, col: 13, offset: 19844},
+									pos: position{line: 658, col: 13, offset: 20780},
 									expr: &seqExpr{
-										pos: position{line: 635, col: 14, offset: 19845},
+										pos: position{line: 658, col: 14, offset: 20781},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 635, col: 14, offset: 19845},
+												pos:        position{line: 658, col: 14, offset: 20781},
 												val:        "|",
 												ignoreCase: false,
+												want:       "\"|\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 635, col: 18, offset: 19849},
+												pos:  position{line: 658, col: 18, offset: 20785},
 												name: "_",
 											},
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 635, col: 22, offset: 19853},
+									pos:   position{line: 658, col: 22, offset: 20789},
 									label: "e",
 									expr: &ruleRefExpr{
-										pos:  position{line: 635, col: 24, offset: 19855},
+										pos:  position{line: 658, col: 24, offset: 20791},
 										name: "Expression",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 635, col: 35, offset: 19866},
+									pos:  position{line: 658, col: 35, offset: 20802},
 									name: "_",
 								},
 								&litMatcher{
-									pos:        position{line: 635, col: 37, offset: 19868},
+									pos:        position{line: 658, col: 37, offset: 20804},
 									val:        ")",
 									ignoreCase: false,
+									want:       "\")\"",
 								},
 							},
 						},
@@ -11721,34 +12607,36 @@ var g = &grammar{
 		},
 		{
 			name: "RecordTypeOrLiteral",
-			pos:  position{line: 637, col: 1, offset: 19891},
+			pos:  position{line: 660, col: 1, offset: 20827},
 			expr: &choiceExpr{
-				pos: position{line: 638, col: 7, offset: 19921},
+				pos: position{line: 661, col: 7, offset: 20857},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 638, col: 7, offset: 19921},
+						pos: position{line: 661, col: 7, offset: 20857},
 						run: (*parser).callonRecordTypeOrLiteral2,
 						expr: &litMatcher{
-							pos:        position{line: 638, col: 7, offset: 19921},
+							pos:        position{line: 661, col: 7, offset: 20857},
 							val:        "=",
 							ignoreCase: false,
+							want:       "\"=\"",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 639, col: 7, offset: 19959},
+						pos:  position{line: 662, col: 7, offset: 20895},
 						name: "NonEmptyRecordType",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 640, col: 7, offset: 19984},
+						pos:  position{line: 663, col: 7, offset: 20920},
 						name: "NonEmptyRecordLiteral",
 					},
 					&actionExpr{
-						pos: position{line: 641, col: 7, offset: 20012},
+						pos: position{line: 664, col: 7, offset: 20948},
 						run: (*parser).callonRecordTypeOrLiteral6,
 						expr: &litMatcher{
-							pos:        position{line: 641, col: 7, offset: 20012},
+							pos:        position{line: 664, col: 7, offset: 20948},
 							val:        "",
 							ignoreCase: false,
+							want:       "\"\"",
 						},
 					},
 				},
@@ -11756,15 +12644,15 @@ var g = &grammar{
 		},
 		{
 			name: "RecordTypeField",
-			pos:  position{line: 643, col: 1, offset: 20045},
+			pos:  position{line: 666, col: 1, offset: 20981},
 			expr: &actionExpr{
-				pos: position{line: 643, col: 19, offset: 20065},
+				pos: position{line: 666, col: 19, offset: 21001},
 				run: (*parser).callonRecordTypeField1,
 				expr: &seqExpr{
-					pos: position{line: 643, col: 19, offset: 20065},
+					pos: position{line: 666, col: 19, offset: 21001},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 643, col: 19, offset: 20065},
+							pos:   position{line: 666, col: 19, offset: 21001},
 							label: "name",
 							expr: &choiceExpr{
 								pos: position{line: 121, col: 9, offset: 2802},
@@ -11779,6 +12667,7 @@ var g = &grammar{
 													pos:        position{line: 121, col: 9, offset: 2802},
 													val:        "`",
 													ignoreCase: false,
+													want:       "\"`\"",
 												},
 												&labeledExpr{
 													pos:   position{line: 121, col: 13, offset: 2806},
@@ -11802,6 +12691,7 @@ var g = &grammar{
 													pos:        position{line: 121, col: 31, offset: 2824},
 													val:        "`",
 													ignoreCase: false,
+													want:       "\"`\"",
 												},
 											},
 										},
@@ -11822,37 +12712,43 @@ var g = &grammar{
 															pos: position{line: 112, col: 15, offset: 2499},
 															exprs: []interface{}{
 																&choiceExpr{
-																	pos: position{line: 274, col: 5, offset: 7396},
+																	pos: position{line: 276, col: 5, offset: 7537},
 																	alternatives: []interface{}{
 																		&litMatcher{
 																			pos:        position{line: 256, col: 6, offset: 7094},
 																			val:        "if",
 																			ignoreCase: false,
+																			want:       "\"if\"",
 																		},
 																		&litMatcher{
 																			pos:        position{line: 257, col: 8, offset: 7108},
 																			val:        "then",
 																			ignoreCase: false,
+																			want:       "\"then\"",
 																		},
 																		&litMatcher{
 																			pos:        position{line: 258, col: 8, offset: 7124},
 																			val:        "else",
 																			ignoreCase: false,
+																			want:       "\"else\"",
 																		},
 																		&litMatcher{
 																			pos:        position{line: 259, col: 7, offset: 7139},
 																			val:        "let",
 																			ignoreCase: false,
+																			want:       "\"let\"",
 																		},
 																		&litMatcher{
 																			pos:        position{line: 260, col: 6, offset: 7152},
 																			val:        "in",
 																			ignoreCase: false,
+																			want:       "\"in\"",
 																		},
 																		&litMatcher{
 																			pos:        position{line: 262, col: 9, offset: 7179},
 																			val:        "using",
 																			ignoreCase: false,
+																			want:       "\"using\"",
 																		},
 																		&actionExpr{
 																			pos: position{line: 264, col: 11, offset: 7217},
@@ -11861,52 +12757,62 @@ var g = &grammar{
 																				pos:        position{line: 264, col: 11, offset: 7217},
 																				val:        "missing",
 																				ignoreCase: false,
+																				want:       "\"missing\"",
 																			},
 																		},
+																		&litMatcher{
+																			pos:        position{line: 271, col: 10, offset: 7370},
+																			val:        "assert",
+																			ignoreCase: false,
+																			want:       "\"assert\"",
+																		},
 																		&litMatcher{
 																			pos:        position{line: 261, col: 6, offset: 7164},
 																			val:        "as",
 																			ignoreCase: false,
+																			want:       "\"as\"",
 																		},
 																		&litMatcher{
 																			pos:        position{line: 265, col: 8, offset: 7262},
 																			val:        "True",
 																			ignoreCase: false,
+																			want:       "\"True\"",
 																		},
 																		&litMatcher{
 																			pos:        position{line: 266, col: 9, offset: 7279},
 																			val:        "False",
 																			ignoreCase: false,
+																			want:       "\"False\"",
 																		},
 																		&litMatcher{
 																			pos:        position{line: 267, col: 12, offset: 7300},
 																			val:        "Infinity",
 																			ignoreCase: false,
+																			want:       "\"Infinity\"",
 																		},
 																		&litMatcher{
 																			pos:        position{line: 268, col: 7, offset: 7319},
 																			val:        "NaN",
 																			ignoreCase: false,
+																			want:       "\"NaN\"",
 																		},
 																		&litMatcher{
 																			pos:        position{line: 263, col: 9, offset: 7197},
 																			val:        "merge",
 																			ignoreCase: false,
+																			want:       "\"merge\"",
 																		},
 																		&litMatcher{
 																			pos:        position{line: 269, col: 8, offset: 7334},
 																			val:        "Some",
 																			ignoreCase: false,
+																			want:       "\"Some\"",
 																		},
 																		&litMatcher{
 																			pos:        position{line: 270, col: 9, offset: 7351},
 																			val:        "toMap",
 																			ignoreCase: false,
-																		},
-																		&litMatcher{
-																			pos:        position{line: 271, col: 10, offset: 7370},
-																			val:        "assert",
-																			ignoreCase: false,
+																			want:       "\"toMap\"",
 																		},
 																	},
 																},
@@ -11933,37 +12839,43 @@ var g = &grammar{
 																&notExpr{
 																	pos: position{line: 113, col: 13, offset: 2571},
 																	expr: &choiceExpr{
-																		pos: position{line: 274, col: 5, offset: 7396},
+																		pos: position{line: 276, col: 5, offset: 7537},
 																		alternatives: []interface{}{
 																			&litMatcher{
 																				pos:        position{line: 256, col: 6, offset: 7094},
 																				val:        "if",
 																				ignoreCase: false,
+																				want:       "\"if\"",
 																			},
 																			&litMatcher{
 																				pos:        position{line: 257, col: 8, offset: 7108},
 																				val:        "then",
 																				ignoreCase: false,
+																				want:       "\"then\"",
 																			},
 																			&litMatcher{
 																				pos:        position{line: 258, col: 8, offset: 7124},
 																				val:        "else",
 																				ignoreCase: false,
+																				want:       "\"else\"",
 																			},
 																			&litMatcher{
 																				pos:        position{line: 259, col: 7, offset: 7139},
 																				val:        "let",
 																				ignoreCase: false,
+																				want:       "\"let\"",
 																			},
 																			&litMatcher{
 																				pos:        position{line: 260, col: 6, offset: 7152},
 																				val:        "in",
 																				ignoreCase: false,
+																				want:       "\"in\"",
 																			},
 																			&litMatcher{
 																				pos:        position{line: 262, col: 9, offset: 7179},
 																				val:        "using",
 																				ignoreCase: false,
+																				want:       "\"using\"",
 																			},
 																			&actionExpr{
 																				pos: position{line: 264, col: 11, offset: 7217},
@@ -11972,52 +12884,62 @@ var g = &grammar{
 																					pos:        position{line: 264, col: 11, offset: 7217},
 																					val:        "missing",
 																					ignoreCase: false,
+																					want:       "\"missing\"",
 																				},
 																			},
+																			&litMatcher{
+																				pos:        position{line: 271, col: 10, offset: 7370},
+																				val:        "assert",
+																				ignoreCase: false,
+																				want:       "\"assert\"",
+																			},
 																			&litMatcher{
 																				pos:        position{line: 261, col: 6, offset: 7164},
 																				val:        "as",
 																				ignoreCase: false,
+																				want:       "\"as\"",
 																			},
 																			&litMatcher{
 																				pos:        position{line: 265, col: 8, offset: 7262},
 																				val:        "True",
 																				ignoreCase: false,
+																				want:       "\"True\"",
 																			},
 																			&litMatcher{
 																				pos:        position{line: 266, col: 9, offset: 7279},
 																				val:        "False",
 																				ignoreCase: false,
+																				want:       "\"False\"",
 																			},
 																			&litMatcher{
 																				pos:        position{line: 267, col: 12, offset: 7300},
 																				val:        "Infinity",
 																				ignoreCase: false,
+																				want:       "\"Infinity\"",
 																			},
 																			&litMatcher{
 																				pos:        position{line: 268, col: 7, offset: 7319},
 																				val:        "NaN",
 																				ignoreCase: false,
+																				want:       "\"NaN\"",
 																			},
 																			&litMatcher{
 																				pos:        position{line: 263, col: 9, offset: 7197},
 																				val:        "merge",
 																				ignoreCase: false,
+																				want:       "\"merge\"",
 																			},
 																			&litMatcher{
 																				pos:        position{line: 269, col: 8, offset: 7334},
 																				val:        "Some",
 																				ignoreCase: false,
+																				want:       "\"Some\"",
 																			},
 																			&litMatcher{
 																				pos:        position{line: 270, col: 9, offset: 7351},
 																				val:        "toMap",
 																				ignoreCase: false,
-																			},
-																			&litMatcher{
-																				pos:        position{line: 271, col: 10, offset: 7370},
-																				val:        "assert",
-																				ignoreCase: false,
+																				want:       "\"toMap\"",
 																			},
 																		},
 																	},
@@ -12052,23 +12974,24 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 643, col: 33, offset: 20079},
+							pos:  position{line: 666, col: 33, offset: 21015},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 643, col: 35, offset: 20081},
+							pos:        position{line: 666, col: 35, offset: 21017},
 							val:        ":",
 							ignoreCase: false,
+							want:       "\":\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 643, col: 39, offset: 20085},
+							pos:  position{line: 666, col: 39, offset: 21021},
 							name: "_1",
 						},
 						&labeledExpr{
-							pos:   position{line: 643, col: 42, offset: 20088},
+							pos:   position{line: 666, col: 42, offset: 21024},
 							label: "expr",
 							expr: &ruleRefExpr{
-								pos:  position{line: 643, col: 47, offset: 20093},
+								pos:  position{line: 666, col: 47, offset: 21029},
 								name: "Expression",
 							},
 						},
@@ -12078,31 +13001,32 @@ var g = &grammar{
 		},
 		{
 			name: "MoreRecordType",
-			pos:  position{line: 646, col: 1, offset: 20150},
+			pos:  position{line: 669, col: 1, offset: 21086},
 			expr: &actionExpr{
-				pos: position{line: 646, col: 18, offset: 20169},
+				pos: position{line: 669, col: 18, offset: 21105},
 				run: (*parser).callonMoreRecordType1,
 				expr: &seqExpr{
-					pos: position{line: 646, col: 18, offset: 20169},
+					pos: position{line: 669, col: 18, offset: 21105},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 646, col: 18, offset: 20169},
+							pos:  position{line: 669, col: 18, offset: 21105},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 646, col: 20, offset: 20171},
+							pos:        position{line: 669, col: 20, offset: 21107},
 							val:        ",",
 							ignoreCase: false,
+							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 646, col: 24, offset: 20175},
+							pos:  position{line: 669, col: 24, offset: 21111},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 646, col: 26, offset: 20177},
+							pos:   position{line: 669, col: 26, offset: 21113},
 							label: "f",
 							expr: &ruleRefExpr{
-								pos:  position{line: 646, col: 28, offset: 20179},
+								pos:  position{line: 669, col: 28, offset: 21115},
 								name: "RecordTypeField",
 							},
 						},
@@ -12112,28 +13036,28 @@ var g = &grammar{
 		},
 		{
 			name: "NonEmptyRecordType",
-			pos:  position{line: 647, col: 1, offset: 20211},
+			pos:  position{line: 670, col: 1, offset: 21147},
 			expr: &actionExpr{
-				pos: position{line: 648, col: 7, offset: 20240},
+				pos: position{line: 671, col: 7, offset: 21176},
 				run: (*parser).callonNonEmptyRecordType1,
 				expr: &seqExpr{
-					pos: position{line: 648, col: 7, offset: 20240},
+					pos: position{line: 671, col: 7, offset: 21176},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 648, col: 7, offset: 20240},
+							pos:   position{line: 671, col: 7, offset: 21176},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 648, col: 13, offset: 20246},
+								pos:  position{line: 671, col: 13, offset: 21182},
 								name: "RecordTypeField",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 648, col: 29, offset: 20262},
+							pos:   position{line: 671, col: 29, offset: 21198},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 648, col: 34, offset: 20267},
+								pos: position{line: 671, col: 34, offset: 21203},
 								expr: &ruleRefExpr{
-									pos:  position{line: 648, col: 34, offset: 20267},
+									pos:  position{line: 671, col: 34, offset: 21203},
 									name: "MoreRecordType",
 								},
 							},
@@ -12144,15 +13068,15 @@ var g = &grammar{
 		},
 		{
 			name: "RecordLiteralField",
-			pos:  position{line: 662, col: 1, offset: 20838},
+			pos:  position{line: 685, col: 1, offset: 21774},
 			expr: &actionExpr{
-				pos: position{line: 662, col: 22, offset: 20861},
+				pos: position{line: 685, col: 22, offset: 21797},
 				run: (*parser).callonRecordLiteralField1,
 				expr: &seqExpr{
-					pos: position{line: 662, col: 22, offset: 20861},
+					pos: position{line: 685, col: 22, offset: 21797},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 662, col: 22, offset: 20861},
+							pos:   position{line: 685, col: 22, offset: 21797},
 							label: "name",
 							expr: &choiceExpr{
 								pos: position{line: 121, col: 9, offset: 2802},
@@ -12167,6 +13091,7 @@ var g = &grammar{
 													pos:        position{line: 121, col: 9, offset: 2802},
 													val:        "`",
 													ignoreCase: false,
+													want:       "\"`\"",
 												},
 												&labeledExpr{
 													pos:   position{line: 121, col: 13, offset: 2806},
@@ -12190,6 +13115,7 @@ var g = &grammar{
 													pos:        position{line: 121, col: 31, offset: 2824},
 													val:        "`",
 													ignoreCase: false,
+													want:       "\"`\"",
 												},
 											},
 										},
@@ -12210,37 +13136,43 @@ var g = &grammar{
 															pos: position{line: 112, col: 15, offset: 2499},
 															exprs: []interface{}{
 																&choiceExpr{
-																	pos: position{line: 274, col: 5, offset: 7396},
+																	pos: position{line: 276, col: 5, offset: 7537},
 																	alternatives: []interface{}{
 																		&litMatcher{
 																			pos:        position{line: 256, col: 6, offset: 7094},
 																			val:        "if",
 																			ignoreCase: false,
+																			want:       "\"if\"",
 																		},
 																		&litMatcher{
 																			pos:        position{line: 257, col: 8, offset: 7108},
 																			val:        "then",
 																			ignoreCase: false,
+																			want:       "\"then\"",
 																		},
 																		&litMatcher{
 																			pos:        position{line: 258, col: 8, offset: 7124},
 																			val:        "else",
 																			ignoreCase: false,
+																			want:       "\"else\"",
 																		},
 																		&litMatcher{
 																			pos:        position{line: 259, col: 7, offset: 7139},
 																			val:        "let",
 																			ignoreCase: false,
+																			want:       "\"let\"",
 																		},
 																		&litMatcher{
 																			pos:        position{line: 260, col: 6, offset: 7152},
 																			val:        "in",
 																			ignoreCase: false,
+																			want:       "\"in\"",
 																		},
 																		&litMatcher{
 																			pos:        position{line: 262, col: 9, offset: 7179},
 																			val:        "using",
 																			ignoreCase: false,
+																			want:       "\"using\"",
 																		},
 																		&actionExpr{
 																			pos: position{line: 264, col: 11, offset: 7217},
@@ -12249,52 +13181,62 @@ var g = &grammar{
 																				pos:        position{line: 264, col: 11, offset: 7217},
 																				val:        "missing",
 																				ignoreCase: false,
+																				want:       "\"missing\"",
 																			},
 																		},
+																		&litMatcher{
+																			pos:        position{line: 271, col: 10, offset: 7370},
+																			val:        "assert",
+																			ignoreCase: false,
+																			want:       "\"assert\"",
+																		},
 																		&litMatcher{
 																			pos:        position{line: 261, col: 6, offset: 7164},
 																			val:        "as",
 																			ignoreCase: false,
+																			want:       "\"as\"",
 																		},
 																		&litMatcher{
 																			pos:        position{line: 265, col: 8, offset: 7262},
 																			val:        "True",
 																			ignoreCase: false,
+																			want:       "\"True\"",
 																		},
 																		&litMatcher{
 																			pos:        position{line: 266, col: 9, offset: 7279},
 																			val:        "False",
 																			ignoreCase: false,
+																			want:       "\"False\"",
 																		},
 																		&litMatcher{
 																			pos:        position{line: 267, col: 12, offset: 7300},
 																			val:        "Infinity",
 																			ignoreCase: false,
+																			want:       "\"Infinity\"",
 																		},
 																		&litMatcher{
 																			pos:        position{line: 268, col: 7, offset: 7319},
 																			val:        "NaN",
 																			ignoreCase: false,
+																			want:       "\"NaN\"",
 																		},
 																		&litMatcher{
 																			pos:        position{line: 263, col: 9, offset: 7197},
 																			val:        "merge",
 																			ignoreCase: false,
+																			want:       "\"merge\"",
 																		},
 																		&litMatcher{
 																			pos:        position{line: 269, col: 8, offset: 7334},
 																			val:        "Some",
 																			ignoreCase: false,
+																			want:       "\"Some\"",
 																		},
 																		&litMatcher{
 																			pos:        position{line: 270, col: 9, offset: 7351},
 																			val:        "toMap",
 																			ignoreCase: false,
-																		},
-																		&litMatcher{
-																			pos:        position{line: 271, col: 10, offset: 7370},
-																			val:        "assert",
-																			ignoreCase: false,
+																			want:       "\"toMap\"",
 																		},
 																	},
 																},
@@ -12321,37 +13263,43 @@ var g = &grammar{
 																&notExpr{
 																	pos: position{line: 113, col: 13, offset: 2571},
 																	expr: &choiceExpr{
-																		pos: position{line: 274, col: 5, offset: 7396},
+																		pos: position{line: 276, col: 5, offset: 7537},
 																		alternatives: []interface{}{
 																			&litMatcher{
 																				pos:        position{line: 256, col: 6, offset: 7094},
 																				val:        "if",
 																				ignoreCase: false,
+																				want:       "\"if\"",
 																			},
 																			&litMatcher{
 																				pos:        position{line: 257, col: 8, offset: 7108},
 																				val:        "then",
 																				ignoreCase: false,
+																				want:       "\"then\"",
 																			},
 																			&litMatcher{
 																				pos:        position{line: 258, col: 8, offset: 7124},
 																				val:        "else",
 																				ignoreCase: false,
+																				want:       "\"else\"",
 																			},
 																			&litMatcher{
 																				pos:        position{line: 259, col: 7, offset: 7139},
 																				val:        "let",
 																				ignoreCase: false,
+																				want:       "\"let\"",
 																			},
 																			&litMatcher{
 																				pos:        position{line: 260, col: 6, offset: 7152},
 																				val:        "in",
 																				ignoreCase: false,
+																				want:       "\"in\"",
 																			},
 																			&litMatcher{
 																				pos:        position{line: 262, col: 9, offset: 7179},
 																				val:        "using",
 																				ignoreCase: false,
+																				want:       "\"using\"",
 																			},
 																			&actionExpr{
 																				pos: position{line: 264, col: 11, offset: 7217},
@@ -12360,52 +13308,62 @@ var g = &grammar{
 																					pos:        position{line: 264, col: 11, offset: 7217},
 																					val:        "missing",
 																					ignoreCase: false,
+																					want:       "\"missing\"",
 																				},
 																			},
+																			&litMatcher{
+																				pos:        position{line: 271, col: 10, offset: 7370},
+																				val:        "assert",
+																				ignoreCase: false,
+																				want:       "\"assert\"",
+																			},
 																			&litMatcher{
 																				pos:        position{line: 261, col: 6, offset: 7164},
 																				val:        "as",
 																				ignoreCase: false,
+																				want:       "\"as\"",
 																			},
 																			&litMatcher{
 																				pos:        position{line: 265, col: 8, offset: 7262},
 																				val:        "True",
 																				ignoreCase: false,
+																				want:       "\"True\"",
 																			},
 																			&litMatcher{
 																				pos:        position{line: 266, col: 9, offset: 7279},
 																				val:        "False",
 																				ignoreCase: false,
+																				want:       "\"False\"",
 																			},
 																			&litMatcher{
 																				pos:        position{line: 267, col: 12, offset: 7300},
 																				val:        "Infinity",
 																				ignoreCase: false,
+																				want:       "\"Infinity\"",
 																			},
 																			&litMatcher{
 																				pos:        position{line: 268, col: 7, offset: 7319},
 																				val:        "NaN",
 																				ignoreCase: false,
+																				want:       "\"NaN\"",
 																			},
 																			&litMatcher{
 																				pos:        position{line: 263, col: 9, offset: 7197},
 																				val:        "merge",
 																				ignoreCase: false,
+																				want:       "\"merge\"",
 																			},
 																			&litMatcher{
 																				pos:        position{line: 269, col: 8, offset: 7334},
 																				val:        "Some",
 																				ignoreCase: false,
+																				want:       "\"Some\"",
 																			},
 																			&litMatcher{
 																				pos:        position{line: 270, col: 9, offset: 7351},
 																				val:        "toMap",
 																				ignoreCase: false,
-																			},
-																			&litMatcher{
-																				pos:        position{line: 271, col: 10, offset: 7370},
-																				val:        "assert",
-																				ignoreCase: false,
+																				want:       "\"toMap\"",
 																			},
 																		},
 																	},
@@ -12440,23 +13398,24 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 662, col: 36, offset: 20875},
+							pos:  position{line: 685, col: 36, offset: 21811},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 662, col: 38, offset: 20877},
+							pos:        position{line: 685, col: 38, offset: 21813},
 							val:        "=",
 							ignoreCase: false,
+							want:       "\"=\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 662, col: 42, offset: 20881},
+							pos:  position{line: 685, col: 42, offset: 21817},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 662, col: 44, offset: 20883},
+							pos:   position{line: 685, col: 44, offset: 21819},
 							label: "expr",
 							expr: &ruleRefExpr{
-								pos:  position{line: 662, col: 49, offset: 20888},
+								pos:  position{line: 685, col: 49, offset: 21824},
 								name: "Expression",
 							},
 						},
@@ -12466,31 +13425,32 @@ var g = &grammar{
 		},
 		{
 			name: "MoreRecordLiteral",
-			pos:  position{line: 665, col: 1, offset: 20945},
+			pos:  position{line: 688, col: 1, offset: 21881},
 			expr: &actionExpr{
-				pos: position{line: 665, col: 21, offset: 20967},
+				pos: position{line: 688, col: 21, offset: 21903},
 				run: (*parser).callonMoreRecordLiteral1,
 				expr: &seqExpr{
-					pos: position{line: 665, col: 21, offset: 20967},
+					pos: position{line: 688, col: 21, offset: 21903},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 665, col: 21, offset: 20967},
+							pos:  position{line: 688, col: 21, offset: 21903},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 665, col: 23, offset: 20969},
+							pos:        position{line: 688, col: 23, offset: 21905},
 							val:        ",",
 							ignoreCase: false,
+							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 665, col: 27, offset: 20973},
+							pos:  position{line: 688, col: 27, offset: 21909},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 665, col: 29, offset: 20975},
+							pos:   position{line: 688, col: 29, offset: 21911},
 							label: "f",
 							expr: &ruleRefExpr{
-								pos:  position{line: 665, col: 31, offset: 20977},
+								pos:  position{line: 688, col: 31, offset: 21913},
 								name: "RecordLiteralField",
 							},
 						},
@@ -12500,28 +13460,28 @@ var g = &grammar{
 		},
 		{
 			name: "NonEmptyRecordLiteral",
-			pos:  position{line: 666, col: 1, offset: 21012},
+			pos:  position{line: 689, col: 1, offset: 21948},
 			expr: &actionExpr{
-				pos: position{line: 667, col: 7, offset: 21044},
+				pos: position{line: 690, col: 7, offset: 21980},
 				run: (*parser).callonNonEmptyRecordLiteral1,
 				expr: &seqExpr{
-					pos: position{line: 667, col: 7, offset: 21044},
+					pos: position{line: 690, col: 7, offset: 21980},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 667, col: 7, offset: 21044},
+							pos:   position{line: 690, col: 7, offset: 21980},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 667, col: 13, offset: 21050},
+								pos:  position{line: 690, col: 13, offset: 21986},
 								name: "RecordLiteralField",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 667, col: 32, offset: 21069},
+							pos:   position{line: 690, col: 32, offset: 22005},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 667, col: 37, offset: 21074},
+								pos: position{line: 690, col: 37, offset: 22010},
 								expr: &ruleRefExpr{
-									pos:  position{line: 667, col: 37, offset: 21074},
+									pos:  position{line: 690, col: 37, offset: 22010},
 									name: "MoreRecordLiteral",
 								},
 							},
@@ -12532,21 +13492,22 @@ var g = &grammar{
 		},
 		{
 			name: "UnionType",
-			pos:  position{line: 681, col: 1, offset: 21647},
+			pos:  position{line: 704, col: 1, offset: 22583},
 			expr: &choiceExpr{
-				pos: position{line: 681, col: 13, offset: 21661},
+				pos: position{line: 704, col: 13, offset: 22597},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 681, col: 13, offset: 21661},
+						pos:  position{line: 704, col: 13, offset: 22597},
 						name: "NonEmptyUnionType",
 					},
 					&actionExpr{
-						pos: position{line: 683, col: 18, offset: 21716},
+						pos: position{line: 706, col: 18, offset: 22652},
 						run: (*parser).callonUnionType3,
 						expr: &litMatcher{
-							pos:        position{line: 683, col: 18, offset: 21716},
+							pos:        position{line: 706, col: 18, offset: 22652},
 							val:        "",
 							ignoreCase: false,
+							want:       "\"\"",
 						},
 					},
 				},
@@ -12554,44 +13515,45 @@ var g = &grammar{
 		},
 		{
 			name: "NonEmptyUnionType",
-			pos:  position{line: 685, col: 1, offset: 21748},
+			pos:  position{line: 708, col: 1, offset: 22684},
 			expr: &actionExpr{
-				pos: position{line: 685, col: 21, offset: 21770},
+				pos: position{line: 708, col: 21, offset: 22706},
 				run: (*parser).callonNonEmptyUnionType1,
 				expr: &seqExpr{
-					pos: position{line: 685, col: 21, offset: 21770},
+					pos: position{line: 708, col: 21, offset: 22706},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 685, col: 21, offset: 21770},
+							pos:   position{line: 708, col: 21, offset: 22706},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 685, col: 27, offset: 21776},
+								pos:  position{line: 708, col: 27, offset: 22712},
 								name: "UnionVariant",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 685, col: 40, offset: 21789},
+							pos:   position{line: 708, col: 40, offset: 22725},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 685, col: 45, offset: 21794},
+								pos: position{line: 708, col: 45, offset: 22730},
 								expr: &seqExpr{
-									pos: position{line: 685, col: 46, offset: 21795},
+									pos: position{line: 708, col: 46, offset: 22731},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 685, col: 46, offset: 21795},
+											pos:  position{line: 708, col: 46, offset: 22731},
 											name: "_",
 										},
 										&litMatcher{
-											pos:        position{line: 685, col: 48, offset: 21797},
+											pos:        position{line: 708, col: 48, offset: 22733},
 											val:        "|",
 											ignoreCase: false,
+											want:       "\"|\"",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 685, col: 52, offset: 21801},
+											pos:  position{line: 708, col: 52, offset: 22737},
 											name: "_",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 685, col: 54, offset: 21803},
+											pos:  position{line: 708, col: 54, offset: 22739},
 											name: "UnionVariant",
 										},
 									},
@@ -12604,9 +13566,9 @@ var g = &grammar{
 		},
 		{
 			name: "UnionVariant",
-			pos:  position{line: 710, col: 1, offset: 22644},
+			pos:  position{line: 733, col: 1, offset: 23580},
 			expr: &seqExpr{
-				pos: position{line: 710, col: 16, offset: 22661},
+				pos: position{line: 733, col: 16, offset: 23597},
 				exprs: []interface{}{
 					&choiceExpr{
 						pos: position{line: 121, col: 9, offset: 2802},
@@ -12621,6 +13583,7 @@ var g = &grammar{
 											pos:        position{line: 121, col: 9, offset: 2802},
 											val:        "`",
 											ignoreCase: false,
+											want:       "\"`\"",
 										},
 										&labeledExpr{
 											pos:   position{line: 121, col: 13, offset: 2806},
@@ -12644,6 +13607,7 @@ var g = &grammar{
 											pos:        position{line: 121, col: 31, offset: 2824},
 											val:        "`",
 											ignoreCase: false,
+											want:       "\"`\"",
 										},
 									},
 								},
@@ -12664,37 +13628,43 @@ var g = &grammar{
 													pos: position{line: 112, col: 15, offset: 2499},
 													exprs: []interface{}{
 														&choiceExpr{
-															pos: position{line: 274, col: 5, offset: 7396},
+															pos: position{line: 276, col: 5, offset: 7537},
 															alternatives: []interface{}{
 																&litMatcher{
 																	pos:        position{line: 256, col: 6, offset: 7094},
 																	val:        "if",
 																	ignoreCase: false,
+																	want:       "\"if\"",
 																},
 																&litMatcher{
 																	pos:        position{line: 257, col: 8, offset: 7108},
 																	val:        "then",
 																	ignoreCase: false,
+																	want:       "\"then\"",
 																},
 																&litMatcher{
 																	pos:        position{line: 258, col: 8, offset: 7124},
 																	val:        "else",
 																	ignoreCase: false,
+																	want:       "\"else\"",
 																},
 																&litMatcher{
 																	pos:        position{line: 259, col: 7, offset: 7139},
 																	val:        "let",
 																	ignoreCase: false,
+																	want:       "\"let\"",
 																},
 																&litMatcher{
 																	pos:        position{line: 260, col: 6, offset: 7152},
 																	val:        "in",
 																	ignoreCase: false,
+																	want:       "\"in\"",
 																},
 																&litMatcher{
 																	pos:        position{line: 262, col: 9, offset: 7179},
 																	val:        "using",
 																	ignoreCase: false,
+																	want:       "\"using\"",
 																},
 																&actionExpr{
 																	pos: position{line: 264, col: 11, offset: 7217},
@@ -12703,52 +13673,62 @@ var g = &grammar{
 																		pos:        position{line: 264, col: 11, offset: 7217},
 																		val:        "missing",
 																		ignoreCase: false,
+																		want:       "\"missing\"",
 																	},
 																},
+																&litMatcher{
+																	pos:        position{line: 271, col: 10, offset: 7370},
+																	val:        "assert",
+																	ignoreCase: false,
+																	want:       "\"assert\"",
+																},
 																&litMatcher{
 																	pos:        position{line: 261, col: 6, offset: 7164},
 																	val:        "as",
 																	ignoreCase: false,
+																	want:       "\"as\"",
 																},
 																&litMatcher{
 																	pos:        position{line: 265, col: 8, offset: 7262},
 																	val:        "True",
 																	ignoreCase: false,
+																	want:       "\"True\"",
 																},
 																&litMatcher{
 																	pos:        position{line: 266, col: 9, offset: 7279},
 																	val:        "False",
 																	ignoreCase: false,
+																	want:       "\"False\"",
 																},
 																&litMatcher{
 																	pos:        position{line: 267, col: 12, offset: 7300},
 																	val:        "Infinity",
 																	ignoreCase: false,
+																	want:       "\"Infinity\"",
 																},
 																&litMatcher{
 																	pos:        position{line: 268, col: 7, offset: 7319},
 																	val:        "NaN",
 																	ignoreCase: false,
+																	want:       "\"NaN\"",
 																},
 																&litMatcher{
 																	pos:        position{line: 263, col: 9, offset: 7197},
 																	val:        "merge",
 																	ignoreCase: false,
+																	want:       "\"merge\"",
 																},
 																&litMatcher{
 																	pos:        position{line: 269, col: 8, offset: 7334},
 																	val:        "Some",
 																	ignoreCase: false,
+																	want:       "\"Some\"",
 																},
 																&litMatcher{
 																	pos:        position{line: 270, col: 9, offset: 7351},
 																	val:        "toMap",
 																	ignoreCase: false,
-																},
-																&litMatcher{
-																	pos:        position{line: 271, col: 10, offset: 7370},
-																	val:        "assert",
-																	ignoreCase: false,
+																	want:       "\"toMap\"",
 																},
 															},
 														},
@@ -12775,37 +13755,43 @@ var g = &grammar{
 														&notExpr{
 															pos: position{line: 113, col: 13, offset: 2571},
 															expr: &choiceExpr{
-																pos: position{line: 274, col: 5, offset: 7396},
+																pos: position{line: 276, col: 5, offset: 7537},
 																alternatives: []interface{}{
 																	&litMatcher{
 																		pos:        position{line: 256, col: 6, offset: 7094},
 																		val:        "if",
 																		ignoreCase: false,
+																		want:       "\"if\"",
 																	},
 																	&litMatcher{
 																		pos:        position{line: 257, col: 8, offset: 7108},
 																		val:        "then",
 																		ignoreCase: false,
+																		want:       "\"then\"",
 																	},
 																	&litMatcher{
 																		pos:        position{line: 258, col: 8, offset: 7124},
 																		val:        "else",
 																		ignoreCase: false,
+																		want:       "\"else\"",
 																	},
 																	&litMatcher{
 																		pos:        position{line: 259, col: 7, offset: 7139},
 																		val:        "let",
 																		ignoreCase: false,
+																		want:       "\"let\"",
 																	},
 																	&litMatcher{
 																		pos:        position{line: 260, col: 6, offset: 7152},
 																		val:        "in",
 																		ignoreCase: false,
+																		want:       "\"in\"",
 																	},
 																	&litMatcher{
 																		pos:        position{line: 262, col: 9, offset: 7179},
 																		val:        "using",
 																		ignoreCase: false,
+																		want:       "\"using\"",
 																	},
 																	&actionExpr{
 																		pos: position{line: 264, col: 11, offset: 7217},
@@ -12814,52 +13800,62 @@ var g = &grammar{
 																			pos:        position{line: 264, col: 11, offset: 7217},
 																			val:        "missing",
 																			ignoreCase: false,
+																			want:       "\"missing\"",
 																		},
 																	},
+																	&litMatcher{
+																		pos:        position{line: 271, col: 10, offset: 7370},
+																		val:        "assert",
+																		ignoreCase: false,
+																		want:       "\"assert\"",
+																	},
 																	&litMatcher{
 																		pos:        position{line: 261, col: 6, offset: 7164},
 																		val:        "as",
 																		ignoreCase: false,
+																		want:       "\"as\"",
 																	},
 																	&litMatcher{
 																		pos:        position{line: 265, col: 8, offset: 7262},
 																		val:        "True",
 																		ignoreCase: false,
+																		want:       "\"True\"",
 																	},
 																	&litMatcher{
 																		pos:        position{line: 266, col: 9, offset: 7279},
 																		val:        "False",
 																		ignoreCase: false,
+																		want:       "\"False\"",
 																	},
 																	&litMatcher{
 																		pos:        position{line: 267, col: 12, offset: 7300},
 																		val:        "Infinity",
 																		ignoreCase: false,
+																		want:       "\"Infinity\"",
 																	},
 																	&litMatcher{
 																		pos:        position{line: 268, col: 7, offset: 7319},
 																		val:        "NaN",
 																		ignoreCase: false,
+																		want:       "\"NaN\"",
 																	},
 																	&litMatcher{
 																		pos:        position{line: 263, col: 9, offset: 7197},
 																		val:        "merge",
 																		ignoreCase: false,
+																		want:       "\"merge\"",
 																	},
 																	&litMatcher{
 																		pos:        position{line: 269, col: 8, offset: 7334},
 																		val:        "Some",
 																		ignoreCase: false,
+																		want:       "\"Some\"",
 																	},
 																	&litMatcher{
 																		pos:        position{line: 270, col: 9, offset: 7351},
 																		val:        "toMap",
 																		ignoreCase: false,
-																	},
-																	&litMatcher{
-																		pos:        position{line: 271, col: 10, offset: 7370},
-																		val:        "assert",
-																		ignoreCase: false,
+																		want:       "\"toMap\"",
 																	},
 																},
 															},
@@ -12893,25 +13889,26 @@ var g = &grammar{
 						},
 					},
 					&zeroOrOneExpr{
-						pos: position{line: 710, col: 25, offset: 22670},
+						pos: position{line: 733, col: 25, offset: 23606},
 						expr: &seqExpr{
-							pos: position{line: 710, col: 26, offset: 22671},
+							pos: position{line: 733, col: 26, offset: 23607},
 							exprs: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 710, col: 26, offset: 22671},
+									pos:  position{line: 733, col: 26, offset: 23607},
 									name: "_",
 								},
 								&litMatcher{
-									pos:        position{line: 710, col: 28, offset: 22673},
+									pos:        position{line: 733, col: 28, offset: 23609},
 									val:        ":",
 									ignoreCase: false,
+									want:       "\":\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 710, col: 32, offset: 22677},
+									pos:  position{line: 733, col: 32, offset: 23613},
 									name: "_1",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 710, col: 35, offset: 22680},
+									pos:  position{line: 733, col: 35, offset: 23616},
 									name: "Expression",
 								},
 							},
@@ -12922,32 +13919,33 @@ var g = &grammar{
 		},
 		{
 			name: "MoreList",
-			pos:  position{line: 712, col: 1, offset: 22694},
+			pos:  position{line: 735, col: 1, offset: 23630},
 			expr: &actionExpr{
-				pos: position{line: 712, col: 12, offset: 22707},
+				pos: position{line: 735, col: 12, offset: 23643},
 				run: (*parser).callonMoreList1,
 				expr: &seqExpr{
-					pos: position{line: 712, col: 12, offset: 22707},
+					pos: position{line: 735, col: 12, offset: 23643},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 712, col: 12, offset: 22707},
+							pos:        position{line: 735, col: 12, offset: 23643},
 							val:        ",",
 							ignoreCase: false,
+							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 712, col: 16, offset: 22711},
+							pos:  position{line: 735, col: 16, offset: 23647},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 712, col: 18, offset: 22713},
+							pos:   position{line: 735, col: 18, offset: 23649},
 							label: "e",
 							expr: &ruleRefExpr{
-								pos:  position{line: 712, col: 20, offset: 22715},
+								pos:  position{line: 735, col: 20, offset: 23651},
 								name: "Expression",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 712, col: 31, offset: 22726},
+							pos:  position{line: 735, col: 31, offset: 23662},
 							name: "_",
 						},
 					},
@@ -12956,66 +13954,69 @@ var g = &grammar{
 		},
 		{
 			name: "NonEmptyListLiteral",
-			pos:  position{line: 714, col: 1, offset: 22745},
+			pos:  position{line: 737, col: 1, offset: 23681},
 			expr: &actionExpr{
-				pos: position{line: 715, col: 7, offset: 22775},
+				pos: position{line: 738, col: 7, offset: 23711},
 				run: (*parser).callonNonEmptyListLiteral1,
 				expr: &seqExpr{
-					pos: position{line: 715, col: 7, offset: 22775},
+					pos: position{line: 738, col: 7, offset: 23711},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 715, col: 7, offset: 22775},
+							pos:        position{line: 738, col: 7, offset: 23711},
 							val:        "[",
 							ignoreCase: false,
+							want:       "\"[\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 715, col: 11, offset: 22779},
+							pos:  position{line: 738, col: 11, offset: 23715},
 							name: "_",
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 715, col: 13, offset: 22781},
+							pos: position{line: 738, col: 13, offset: 23717},
 							expr: &seqExpr{
-								pos: position{line: 715, col: 14, offset: 22782},
+								pos: position{line: 738, col: 14, offset: 23718},
 								exprs: []interface{}{
 									&litMatcher{
-										pos:        position{line: 715, col: 14, offset: 22782},
+										pos:        position{line: 738, col: 14, offset: 23718},
 										val:        ",",
 										ignoreCase: false,
+										want:       "\",\"",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 715, col: 18, offset: 22786},
+										pos:  position{line: 738, col: 18, offset: 23722},
 										name: "_",
 									},
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 715, col: 22, offset: 22790},
+							pos:   position{line: 738, col: 22, offset: 23726},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 715, col: 28, offset: 22796},
+								pos:  position{line: 738, col: 28, offset: 23732},
 								name: "Expression",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 715, col: 39, offset: 22807},
+							pos:  position{line: 738, col: 39, offset: 23743},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 715, col: 41, offset: 22809},
+							pos:   position{line: 738, col: 41, offset: 23745},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 715, col: 46, offset: 22814},
+								pos: position{line: 738, col: 46, offset: 23750},
 								expr: &ruleRefExpr{
-									pos:  position{line: 715, col: 46, offset: 22814},
+									pos:  position{line: 738, col: 46, offset: 23750},
 									name: "MoreList",
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 715, col: 56, offset: 22824},
+							pos:        position{line: 738, col: 56, offset: 23760},
 							val:        "]",
 							ignoreCase: false,
+							want:       "\"]\"",
 						},
 					},
 				},
@@ -13034,6 +14035,16 @@ func (p *parser) callonDhallFile1() (interface{}, error) {
 	return p.cur.onDhallFile1(stack["e"])
 }
 
+func (c *current) onDhallFile3() (bool, error) {
+	return c.checkInputLength()
+}
+
+func (p *parser) callonDhallFile3() (bool, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDhallFile3()
+}
+
 func (c *current) onCompleteExpression1(e interface{}) (interface{}, error) {
 	return e, nil
 }
@@ -14500,7 +15511,7 @@ func (p *parser) callonIdentifier69() (interface{}, error) {
 	return p.cur.onIdentifier69()
 }
 
-func (c *current) onHttp28() (interface{}, error) {
+func (c *current) onHttp31() (interface{}, error) {
 	addr := net.ParseIP(string(c.text))
 	if addr == nil {
 		return nil, errors.New("Malformed IPv6 address")
@@ -14508,60 +15519,108 @@ func (c *current) onHttp28() (interface{}, error) {
 	return string(c.text), nil
 }
 
-func (p *parser) callonHttp28() (interface{}, error) {
+func (p *parser) callonHttp31() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onHttp28()
+	return p.cur.onHttp31()
 }
 
-func (c *current) onHttp64() (interface{}, error) {
+func (c *current) onHttp7() (interface{}, error) {
 	return string(c.text), nil
 }
 
-func (p *parser) callonHttp64() (interface{}, error) {
+func (p *parser) callonHttp7() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onHttp64()
+	return p.cur.onHttp7()
 }
 
-func (c *current) onHttp60(u interface{}) (interface{}, error) {
-	return u, nil
+func (c *current) onHttp69() (interface{}, error) {
+	return string(c.text), nil
 }
 
-func (p *parser) callonHttp60() (interface{}, error) {
+func (p *parser) callonHttp69() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onHttp60(stack["u"])
+	return p.cur.onHttp69()
 }
 
-func (c *current) onHttp71() (interface{}, error) {
+func (c *current) onHttp66() (interface{}, error) {
 	return string(c.text), nil
 }
 
-func (p *parser) callonHttp71() (interface{}, error) {
+func (p *parser) callonHttp66() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onHttp71()
+	return p.cur.onHttp66()
 }
 
-func (c *current) onHttp67(q interface{}) (interface{}, error) {
-	return q, nil
+func (c *current) onHttp76() (interface{}, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonHttp76() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onHttp76()
+}
+
+func (c *current) onHttp72(q interface{}) (interface{}, error) {
+	return "/" + url.PathEscape(q.(string)), nil
+}
+
+func (p *parser) callonHttp72() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onHttp72(stack["q"])
+}
+
+func (c *current) onHttp80() (interface{}, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonHttp80() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onHttp80()
 }
 
-func (p *parser) callonHttp67() (interface{}, error) {
+func (c *current) onHttp62(cs interface{}) (interface{}, error) {
+	var path strings.Builder
+	for _, component := range cs.([]interface{}) {
+		path.WriteString(component.(string))
+	}
+	return path.String(), nil
+}
+
+func (p *parser) callonHttp62() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onHttp62(stack["cs"])
+}
+
+func (c *current) onHttp97() (interface{}, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonHttp97() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onHttp67(stack["q"])
+	return p.cur.onHttp97()
 }
 
-func (c *current) onHttp4() (interface{}, error) {
-	return url.ParseRequestURI(string(c.text))
+func (c *current) onHttp4(prefix, path, query interface{}) (interface{}, error) {
+	raw := prefix.(string) + path.(string)
+	if query != nil {
+		raw += query.(string)
+	}
+	return url.ParseRequestURI(raw)
 }
 
 func (p *parser) callonHttp4() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onHttp4()
+	return p.cur.onHttp4(stack["prefix"], stack["path"], stack["query"])
 }
 
 func (c *current) onHttp1(u, usingClause interface{}) (interface{}, error) {
@@ -14643,7 +15702,7 @@ func (p *parser) callonImportType8() (interface{}, error) {
 }
 
 func (c *current) onImportType4(p interface{}) (interface{}, error) {
-	return Local(path.Join("..", p.(string))), nil
+	return NewLocal(LocalParent, p.(string)), nil
 }
 
 func (p *parser) callonImportType4() (interface{}, error) {
@@ -14708,7 +15767,7 @@ func (p *parser) callonImportType31() (interface{}, error) {
 }
 
 func (c *current) onImportType27(p interface{}) (interface{}, error) {
-	return Local(p.(string)), nil
+	return NewLocal(LocalHere, p.(string)), nil
 }
 
 func (p *parser) callonImportType27() (interface{}, error) {
@@ -14773,7 +15832,7 @@ func (p *parser) callonImportType54() (interface{}, error) {
 }
 
 func (c *current) onImportType50(p interface{}) (interface{}, error) {
-	return Local(path.Join("~", p.(string))), nil
+	return NewLocal(LocalHome, p.(string)), nil
 }
 
 func (p *parser) callonImportType50() (interface{}, error) {
@@ -14838,7 +15897,7 @@ func (p *parser) callonImportType75() (interface{}, error) {
 }
 
 func (c *current) onImportType73(p interface{}) (interface{}, error) {
-	return Local(path.Join("/", p.(string))), nil
+	return NewLocal(LocalAbsolute, p.(string)), nil
 }
 
 func (p *parser) callonImportType73() (interface{}, error) {
@@ -15916,28 +16975,18 @@ func (p *parser) callonLetBinding1() (interface{}, error) {
 	return p.cur.onLetBinding1(stack["label"], stack["a"], stack["v"])
 }
 
-func (c *current) onExpression15() (interface{}, error) {
-	return NaturalBuild, nil
-}
-
-func (p *parser) callonExpression15() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression15()
-}
-
-func (c *current) onExpression17() (interface{}, error) {
-	return NaturalFold, nil
+func (c *current) onExpression3() (bool, error) {
+	return c.checkLimits()
 }
 
-func (p *parser) callonExpression17() (interface{}, error) {
+func (p *parser) callonExpression3() (bool, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onExpression17()
+	return p.cur.onExpression3()
 }
 
 func (c *current) onExpression19() (interface{}, error) {
-	return NaturalIsZero, nil
+	return NaturalBuild, nil
 }
 
 func (p *parser) callonExpression19() (interface{}, error) {
@@ -15947,7 +16996,7 @@ func (p *parser) callonExpression19() (interface{}, error) {
 }
 
 func (c *current) onExpression21() (interface{}, error) {
-	return NaturalEven, nil
+	return NaturalFold, nil
 }
 
 func (p *parser) callonExpression21() (interface{}, error) {
@@ -15957,7 +17006,7 @@ func (p *parser) callonExpression21() (interface{}, error) {
 }
 
 func (c *current) onExpression23() (interface{}, error) {
-	return NaturalOdd, nil
+	return NaturalIsZero, nil
 }
 
 func (p *parser) callonExpression23() (interface{}, error) {
@@ -15967,7 +17016,7 @@ func (p *parser) callonExpression23() (interface{}, error) {
 }
 
 func (c *current) onExpression25() (interface{}, error) {
-	return NaturalToInteger, nil
+	return NaturalEven, nil
 }
 
 func (p *parser) callonExpression25() (interface{}, error) {
@@ -15977,7 +17026,7 @@ func (p *parser) callonExpression25() (interface{}, error) {
 }
 
 func (c *current) onExpression27() (interface{}, error) {
-	return NaturalShow, nil
+	return NaturalOdd, nil
 }
 
 func (p *parser) callonExpression27() (interface{}, error) {
@@ -15987,7 +17036,7 @@ func (p *parser) callonExpression27() (interface{}, error) {
 }
 
 func (c *current) onExpression29() (interface{}, error) {
-	return NaturalSubtract, nil
+	return NaturalToInteger, nil
 }
 
 func (p *parser) callonExpression29() (interface{}, error) {
@@ -15997,7 +17046,7 @@ func (p *parser) callonExpression29() (interface{}, error) {
 }
 
 func (c *current) onExpression31() (interface{}, error) {
-	return IntegerToDouble, nil
+	return NaturalShow, nil
 }
 
 func (p *parser) callonExpression31() (interface{}, error) {
@@ -16007,7 +17056,7 @@ func (p *parser) callonExpression31() (interface{}, error) {
 }
 
 func (c *current) onExpression33() (interface{}, error) {
-	return IntegerShow, nil
+	return NaturalSubtract, nil
 }
 
 func (p *parser) callonExpression33() (interface{}, error) {
@@ -16017,7 +17066,7 @@ func (p *parser) callonExpression33() (interface{}, error) {
 }
 
 func (c *current) onExpression35() (interface{}, error) {
-	return DoubleShow, nil
+	return IntegerToDouble, nil
 }
 
 func (p *parser) callonExpression35() (interface{}, error) {
@@ -16027,7 +17076,7 @@ func (p *parser) callonExpression35() (interface{}, error) {
 }
 
 func (c *current) onExpression37() (interface{}, error) {
-	return ListBuild, nil
+	return IntegerShow, nil
 }
 
 func (p *parser) callonExpression37() (interface{}, error) {
@@ -16037,7 +17086,7 @@ func (p *parser) callonExpression37() (interface{}, error) {
 }
 
 func (c *current) onExpression39() (interface{}, error) {
-	return ListFold, nil
+	return DoubleShow, nil
 }
 
 func (p *parser) callonExpression39() (interface{}, error) {
@@ -16047,7 +17096,7 @@ func (p *parser) callonExpression39() (interface{}, error) {
 }
 
 func (c *current) onExpression41() (interface{}, error) {
-	return ListLength, nil
+	return ListBuild, nil
 }
 
 func (p *parser) callonExpression41() (interface{}, error) {
@@ -16057,7 +17106,7 @@ func (p *parser) callonExpression41() (interface{}, error) {
 }
 
 func (c *current) onExpression43() (interface{}, error) {
-	return ListHead, nil
+	return ListFold, nil
 }
 
 func (p *parser) callonExpression43() (interface{}, error) {
@@ -16067,7 +17116,7 @@ func (p *parser) callonExpression43() (interface{}, error) {
 }
 
 func (c *current) onExpression45() (interface{}, error) {
-	return ListLast, nil
+	return ListLength, nil
 }
 
 func (p *parser) callonExpression45() (interface{}, error) {
@@ -16077,7 +17126,7 @@ func (p *parser) callonExpression45() (interface{}, error) {
 }
 
 func (c *current) onExpression47() (interface{}, error) {
-	return ListIndexed, nil
+	return ListHead, nil
 }
 
 func (p *parser) callonExpression47() (interface{}, error) {
@@ -16087,7 +17136,7 @@ func (p *parser) callonExpression47() (interface{}, error) {
 }
 
 func (c *current) onExpression49() (interface{}, error) {
-	return ListReverse, nil
+	return ListLast, nil
 }
 
 func (p *parser) callonExpression49() (interface{}, error) {
@@ -16097,7 +17146,7 @@ func (p *parser) callonExpression49() (interface{}, error) {
 }
 
 func (c *current) onExpression51() (interface{}, error) {
-	return OptionalBuild, nil
+	return ListIndexed, nil
 }
 
 func (p *parser) callonExpression51() (interface{}, error) {
@@ -16107,7 +17156,7 @@ func (p *parser) callonExpression51() (interface{}, error) {
 }
 
 func (c *current) onExpression53() (interface{}, error) {
-	return OptionalFold, nil
+	return ListReverse, nil
 }
 
 func (p *parser) callonExpression53() (interface{}, error) {
@@ -16117,7 +17166,7 @@ func (p *parser) callonExpression53() (interface{}, error) {
 }
 
 func (c *current) onExpression55() (interface{}, error) {
-	return TextShow, nil
+	return OptionalBuild, nil
 }
 
 func (p *parser) callonExpression55() (interface{}, error) {
@@ -16127,7 +17176,7 @@ func (p *parser) callonExpression55() (interface{}, error) {
 }
 
 func (c *current) onExpression57() (interface{}, error) {
-	return Bool, nil
+	return OptionalFold, nil
 }
 
 func (p *parser) callonExpression57() (interface{}, error) {
@@ -16137,7 +17186,7 @@ func (p *parser) callonExpression57() (interface{}, error) {
 }
 
 func (c *current) onExpression59() (interface{}, error) {
-	return True, nil
+	return TextShow, nil
 }
 
 func (p *parser) callonExpression59() (interface{}, error) {
@@ -16147,7 +17196,7 @@ func (p *parser) callonExpression59() (interface{}, error) {
 }
 
 func (c *current) onExpression61() (interface{}, error) {
-	return False, nil
+	return Bool, nil
 }
 
 func (p *parser) callonExpression61() (interface{}, error) {
@@ -16157,7 +17206,7 @@ func (p *parser) callonExpression61() (interface{}, error) {
 }
 
 func (c *current) onExpression63() (interface{}, error) {
-	return Optional, nil
+	return True, nil
 }
 
 func (p *parser) callonExpression63() (interface{}, error) {
@@ -16167,7 +17216,7 @@ func (p *parser) callonExpression63() (interface{}, error) {
 }
 
 func (c *current) onExpression65() (interface{}, error) {
-	return Natural, nil
+	return False, nil
 }
 
 func (p *parser) callonExpression65() (interface{}, error) {
@@ -16177,7 +17226,7 @@ func (p *parser) callonExpression65() (interface{}, error) {
 }
 
 func (c *current) onExpression67() (interface{}, error) {
-	return Integer, nil
+	return Optional, nil
 }
 
 func (p *parser) callonExpression67() (interface{}, error) {
@@ -16187,7 +17236,7 @@ func (p *parser) callonExpression67() (interface{}, error) {
 }
 
 func (c *current) onExpression69() (interface{}, error) {
-	return Double, nil
+	return Natural, nil
 }
 
 func (p *parser) callonExpression69() (interface{}, error) {
@@ -16197,7 +17246,7 @@ func (p *parser) callonExpression69() (interface{}, error) {
 }
 
 func (c *current) onExpression71() (interface{}, error) {
-	return Text, nil
+	return Integer, nil
 }
 
 func (p *parser) callonExpression71() (interface{}, error) {
@@ -16207,7 +17256,7 @@ func (p *parser) callonExpression71() (interface{}, error) {
 }
 
 func (c *current) onExpression73() (interface{}, error) {
-	return List, nil
+	return Double, nil
 }
 
 func (p *parser) callonExpression73() (interface{}, error) {
@@ -16217,7 +17266,7 @@ func (p *parser) callonExpression73() (interface{}, error) {
 }
 
 func (c *current) onExpression75() (interface{}, error) {
-	return None, nil
+	return Text, nil
 }
 
 func (p *parser) callonExpression75() (interface{}, error) {
@@ -16227,7 +17276,7 @@ func (p *parser) callonExpression75() (interface{}, error) {
 }
 
 func (c *current) onExpression77() (interface{}, error) {
-	return Type, nil
+	return List, nil
 }
 
 func (p *parser) callonExpression77() (interface{}, error) {
@@ -16237,7 +17286,7 @@ func (p *parser) callonExpression77() (interface{}, error) {
 }
 
 func (c *current) onExpression79() (interface{}, error) {
-	return Kind, nil
+	return None, nil
 }
 
 func (p *parser) callonExpression79() (interface{}, error) {
@@ -16247,7 +17296,7 @@ func (p *parser) callonExpression79() (interface{}, error) {
 }
 
 func (c *current) onExpression81() (interface{}, error) {
-	return Sort, nil
+	return Type, nil
 }
 
 func (p *parser) callonExpression81() (interface{}, error) {
@@ -16256,109 +17305,109 @@ func (p *parser) callonExpression81() (interface{}, error) {
 	return p.cur.onExpression81()
 }
 
-func (c *current) onExpression90() (interface{}, error) {
-	return string(c.text), nil
+func (c *current) onExpression83() (interface{}, error) {
+	return Kind, nil
 }
 
-func (p *parser) callonExpression90() (interface{}, error) {
+func (p *parser) callonExpression83() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onExpression90()
+	return p.cur.onExpression83()
 }
 
-func (c *current) onExpression86(label interface{}) (interface{}, error) {
-	return label, nil
+func (c *current) onExpression85() (interface{}, error) {
+	return Sort, nil
 }
 
-func (p *parser) callonExpression86() (interface{}, error) {
+func (p *parser) callonExpression85() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onExpression86(stack["label"])
+	return p.cur.onExpression85()
 }
 
-func (c *current) onExpression106() (interface{}, error) {
-	return Missing{}, nil
+func (c *current) onExpression94() (interface{}, error) {
+	return string(c.text), nil
 }
 
-func (p *parser) callonExpression106() (interface{}, error) {
+func (p *parser) callonExpression94() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onExpression106()
+	return p.cur.onExpression94()
 }
 
-func (c *current) onExpression97() (interface{}, error) {
-	return string(c.text), nil
+func (c *current) onExpression90(label interface{}) (interface{}, error) {
+	return label, nil
 }
 
-func (p *parser) callonExpression97() (interface{}, error) {
+func (p *parser) callonExpression90() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onExpression97()
+	return p.cur.onExpression90(stack["label"])
 }
 
-func (c *current) onExpression129() (interface{}, error) {
+func (c *current) onExpression110() (interface{}, error) {
 	return Missing{}, nil
 }
 
-func (p *parser) callonExpression129() (interface{}, error) {
+func (p *parser) callonExpression110() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onExpression129()
+	return p.cur.onExpression110()
 }
 
-func (c *current) onExpression119() (interface{}, error) {
+func (c *current) onExpression101() (interface{}, error) {
 	return string(c.text), nil
-
 }
 
-func (p *parser) callonExpression119() (interface{}, error) {
+func (p *parser) callonExpression101() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onExpression119()
+	return p.cur.onExpression101()
 }
 
-func (c *current) onExpression94(label interface{}) (interface{}, error) {
-	return label, nil
+func (c *current) onExpression133() (interface{}, error) {
+	return Missing{}, nil
 }
 
-func (p *parser) callonExpression94() (interface{}, error) {
+func (p *parser) callonExpression133() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onExpression94(stack["label"])
+	return p.cur.onExpression133()
 }
 
-func (c *current) onExpression10(label interface{}) (interface{}, error) {
-	return label, nil
+func (c *current) onExpression123() (interface{}, error) {
+	return string(c.text), nil
+
 }
 
-func (p *parser) callonExpression10() (interface{}, error) {
+func (p *parser) callonExpression123() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onExpression10(stack["label"])
+	return p.cur.onExpression123()
 }
 
-func (c *current) onExpression147() (interface{}, error) {
-	return NaturalBuild, nil
+func (c *current) onExpression98(label interface{}) (interface{}, error) {
+	return label, nil
 }
 
-func (p *parser) callonExpression147() (interface{}, error) {
+func (p *parser) callonExpression98() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onExpression147()
+	return p.cur.onExpression98(stack["label"])
 }
 
-func (c *current) onExpression149() (interface{}, error) {
-	return NaturalFold, nil
+func (c *current) onExpression14(label interface{}) (interface{}, error) {
+	return label, nil
 }
 
-func (p *parser) callonExpression149() (interface{}, error) {
+func (p *parser) callonExpression14() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onExpression149()
+	return p.cur.onExpression14(stack["label"])
 }
 
 func (c *current) onExpression151() (interface{}, error) {
-	return NaturalIsZero, nil
+	return NaturalBuild, nil
 }
 
 func (p *parser) callonExpression151() (interface{}, error) {
@@ -16368,7 +17417,7 @@ func (p *parser) callonExpression151() (interface{}, error) {
 }
 
 func (c *current) onExpression153() (interface{}, error) {
-	return NaturalEven, nil
+	return NaturalFold, nil
 }
 
 func (p *parser) callonExpression153() (interface{}, error) {
@@ -16378,7 +17427,7 @@ func (p *parser) callonExpression153() (interface{}, error) {
 }
 
 func (c *current) onExpression155() (interface{}, error) {
-	return NaturalOdd, nil
+	return NaturalIsZero, nil
 }
 
 func (p *parser) callonExpression155() (interface{}, error) {
@@ -16388,7 +17437,7 @@ func (p *parser) callonExpression155() (interface{}, error) {
 }
 
 func (c *current) onExpression157() (interface{}, error) {
-	return NaturalToInteger, nil
+	return NaturalEven, nil
 }
 
 func (p *parser) callonExpression157() (interface{}, error) {
@@ -16398,7 +17447,7 @@ func (p *parser) callonExpression157() (interface{}, error) {
 }
 
 func (c *current) onExpression159() (interface{}, error) {
-	return NaturalShow, nil
+	return NaturalOdd, nil
 }
 
 func (p *parser) callonExpression159() (interface{}, error) {
@@ -16408,7 +17457,7 @@ func (p *parser) callonExpression159() (interface{}, error) {
 }
 
 func (c *current) onExpression161() (interface{}, error) {
-	return NaturalSubtract, nil
+	return NaturalToInteger, nil
 }
 
 func (p *parser) callonExpression161() (interface{}, error) {
@@ -16418,7 +17467,7 @@ func (p *parser) callonExpression161() (interface{}, error) {
 }
 
 func (c *current) onExpression163() (interface{}, error) {
-	return IntegerToDouble, nil
+	return NaturalShow, nil
 }
 
 func (p *parser) callonExpression163() (interface{}, error) {
@@ -16428,7 +17477,7 @@ func (p *parser) callonExpression163() (interface{}, error) {
 }
 
 func (c *current) onExpression165() (interface{}, error) {
-	return IntegerShow, nil
+	return NaturalSubtract, nil
 }
 
 func (p *parser) callonExpression165() (interface{}, error) {
@@ -16438,7 +17487,7 @@ func (p *parser) callonExpression165() (interface{}, error) {
 }
 
 func (c *current) onExpression167() (interface{}, error) {
-	return DoubleShow, nil
+	return IntegerToDouble, nil
 }
 
 func (p *parser) callonExpression167() (interface{}, error) {
@@ -16448,7 +17497,7 @@ func (p *parser) callonExpression167() (interface{}, error) {
 }
 
 func (c *current) onExpression169() (interface{}, error) {
-	return ListBuild, nil
+	return IntegerShow, nil
 }
 
 func (p *parser) callonExpression169() (interface{}, error) {
@@ -16458,7 +17507,7 @@ func (p *parser) callonExpression169() (interface{}, error) {
 }
 
 func (c *current) onExpression171() (interface{}, error) {
-	return ListFold, nil
+	return DoubleShow, nil
 }
 
 func (p *parser) callonExpression171() (interface{}, error) {
@@ -16468,7 +17517,7 @@ func (p *parser) callonExpression171() (interface{}, error) {
 }
 
 func (c *current) onExpression173() (interface{}, error) {
-	return ListLength, nil
+	return ListBuild, nil
 }
 
 func (p *parser) callonExpression173() (interface{}, error) {
@@ -16478,7 +17527,7 @@ func (p *parser) callonExpression173() (interface{}, error) {
 }
 
 func (c *current) onExpression175() (interface{}, error) {
-	return ListHead, nil
+	return ListFold, nil
 }
 
 func (p *parser) callonExpression175() (interface{}, error) {
@@ -16488,7 +17537,7 @@ func (p *parser) callonExpression175() (interface{}, error) {
 }
 
 func (c *current) onExpression177() (interface{}, error) {
-	return ListLast, nil
+	return ListLength, nil
 }
 
 func (p *parser) callonExpression177() (interface{}, error) {
@@ -16498,7 +17547,7 @@ func (p *parser) callonExpression177() (interface{}, error) {
 }
 
 func (c *current) onExpression179() (interface{}, error) {
-	return ListIndexed, nil
+	return ListHead, nil
 }
 
 func (p *parser) callonExpression179() (interface{}, error) {
@@ -16508,7 +17557,7 @@ func (p *parser) callonExpression179() (interface{}, error) {
 }
 
 func (c *current) onExpression181() (interface{}, error) {
-	return ListReverse, nil
+	return ListLast, nil
 }
 
 func (p *parser) callonExpression181() (interface{}, error) {
@@ -16518,7 +17567,7 @@ func (p *parser) callonExpression181() (interface{}, error) {
 }
 
 func (c *current) onExpression183() (interface{}, error) {
-	return OptionalBuild, nil
+	return ListIndexed, nil
 }
 
 func (p *parser) callonExpression183() (interface{}, error) {
@@ -16528,7 +17577,7 @@ func (p *parser) callonExpression183() (interface{}, error) {
 }
 
 func (c *current) onExpression185() (interface{}, error) {
-	return OptionalFold, nil
+	return ListReverse, nil
 }
 
 func (p *parser) callonExpression185() (interface{}, error) {
@@ -16538,7 +17587,7 @@ func (p *parser) callonExpression185() (interface{}, error) {
 }
 
 func (c *current) onExpression187() (interface{}, error) {
-	return TextShow, nil
+	return OptionalBuild, nil
 }
 
 func (p *parser) callonExpression187() (interface{}, error) {
@@ -16548,7 +17597,7 @@ func (p *parser) callonExpression187() (interface{}, error) {
 }
 
 func (c *current) onExpression189() (interface{}, error) {
-	return Bool, nil
+	return OptionalFold, nil
 }
 
 func (p *parser) callonExpression189() (interface{}, error) {
@@ -16558,7 +17607,7 @@ func (p *parser) callonExpression189() (interface{}, error) {
 }
 
 func (c *current) onExpression191() (interface{}, error) {
-	return True, nil
+	return TextShow, nil
 }
 
 func (p *parser) callonExpression191() (interface{}, error) {
@@ -16568,7 +17617,7 @@ func (p *parser) callonExpression191() (interface{}, error) {
 }
 
 func (c *current) onExpression193() (interface{}, error) {
-	return False, nil
+	return Bool, nil
 }
 
 func (p *parser) callonExpression193() (interface{}, error) {
@@ -16578,7 +17627,7 @@ func (p *parser) callonExpression193() (interface{}, error) {
 }
 
 func (c *current) onExpression195() (interface{}, error) {
-	return Optional, nil
+	return True, nil
 }
 
 func (p *parser) callonExpression195() (interface{}, error) {
@@ -16588,7 +17637,7 @@ func (p *parser) callonExpression195() (interface{}, error) {
 }
 
 func (c *current) onExpression197() (interface{}, error) {
-	return Natural, nil
+	return False, nil
 }
 
 func (p *parser) callonExpression197() (interface{}, error) {
@@ -16598,7 +17647,7 @@ func (p *parser) callonExpression197() (interface{}, error) {
 }
 
 func (c *current) onExpression199() (interface{}, error) {
-	return Integer, nil
+	return Optional, nil
 }
 
 func (p *parser) callonExpression199() (interface{}, error) {
@@ -16608,7 +17657,7 @@ func (p *parser) callonExpression199() (interface{}, error) {
 }
 
 func (c *current) onExpression201() (interface{}, error) {
-	return Double, nil
+	return Natural, nil
 }
 
 func (p *parser) callonExpression201() (interface{}, error) {
@@ -16618,7 +17667,7 @@ func (p *parser) callonExpression201() (interface{}, error) {
 }
 
 func (c *current) onExpression203() (interface{}, error) {
-	return Text, nil
+	return Integer, nil
 }
 
 func (p *parser) callonExpression203() (interface{}, error) {
@@ -16628,7 +17677,7 @@ func (p *parser) callonExpression203() (interface{}, error) {
 }
 
 func (c *current) onExpression205() (interface{}, error) {
-	return List, nil
+	return Double, nil
 }
 
 func (p *parser) callonExpression205() (interface{}, error) {
@@ -16638,7 +17687,7 @@ func (p *parser) callonExpression205() (interface{}, error) {
 }
 
 func (c *current) onExpression207() (interface{}, error) {
-	return None, nil
+	return Text, nil
 }
 
 func (p *parser) callonExpression207() (interface{}, error) {
@@ -16648,7 +17697,7 @@ func (p *parser) callonExpression207() (interface{}, error) {
 }
 
 func (c *current) onExpression209() (interface{}, error) {
-	return Type, nil
+	return List, nil
 }
 
 func (p *parser) callonExpression209() (interface{}, error) {
@@ -16658,7 +17707,7 @@ func (p *parser) callonExpression209() (interface{}, error) {
 }
 
 func (c *current) onExpression211() (interface{}, error) {
-	return Kind, nil
+	return None, nil
 }
 
 func (p *parser) callonExpression211() (interface{}, error) {
@@ -16668,7 +17717,7 @@ func (p *parser) callonExpression211() (interface{}, error) {
 }
 
 func (c *current) onExpression213() (interface{}, error) {
-	return Sort, nil
+	return Type, nil
 }
 
 func (p *parser) callonExpression213() (interface{}, error) {
@@ -16677,110 +17726,130 @@ func (p *parser) callonExpression213() (interface{}, error) {
 	return p.cur.onExpression213()
 }
 
-func (c *current) onExpression221() (interface{}, error) {
+func (c *current) onExpression215() (interface{}, error) {
+	return Kind, nil
+}
+
+func (p *parser) callonExpression215() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onExpression215()
+}
+
+func (c *current) onExpression217() (interface{}, error) {
+	return Sort, nil
+}
+
+func (p *parser) callonExpression217() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onExpression217()
+}
+
+func (c *current) onExpression225() (interface{}, error) {
 	return string(c.text), nil
 }
 
-func (p *parser) callonExpression221() (interface{}, error) {
+func (p *parser) callonExpression225() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onExpression221()
+	return p.cur.onExpression225()
 }
 
-func (c *current) onExpression217(label interface{}) (interface{}, error) {
+func (c *current) onExpression221(label interface{}) (interface{}, error) {
 	return label, nil
 }
 
-func (p *parser) callonExpression217() (interface{}, error) {
+func (p *parser) callonExpression221() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onExpression217(stack["label"])
+	return p.cur.onExpression221(stack["label"])
 }
 
-func (c *current) onExpression237() (interface{}, error) {
+func (c *current) onExpression241() (interface{}, error) {
 	return Missing{}, nil
 }
 
-func (p *parser) callonExpression237() (interface{}, error) {
+func (p *parser) callonExpression241() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onExpression237()
+	return p.cur.onExpression241()
 }
 
-func (c *current) onExpression228() (interface{}, error) {
+func (c *current) onExpression232() (interface{}, error) {
 	return string(c.text), nil
 }
 
-func (p *parser) callonExpression228() (interface{}, error) {
+func (p *parser) callonExpression232() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onExpression228()
+	return p.cur.onExpression232()
 }
 
-func (c *current) onExpression260() (interface{}, error) {
+func (c *current) onExpression264() (interface{}, error) {
 	return Missing{}, nil
 }
 
-func (p *parser) callonExpression260() (interface{}, error) {
+func (p *parser) callonExpression264() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onExpression260()
+	return p.cur.onExpression264()
 }
 
-func (c *current) onExpression250() (interface{}, error) {
+func (c *current) onExpression254() (interface{}, error) {
 	return string(c.text), nil
 
 }
 
-func (p *parser) callonExpression250() (interface{}, error) {
+func (p *parser) callonExpression254() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onExpression250()
+	return p.cur.onExpression254()
 }
 
-func (c *current) onExpression225(label interface{}) (interface{}, error) {
+func (c *current) onExpression229(label interface{}) (interface{}, error) {
 	return label, nil
 }
 
-func (p *parser) callonExpression225() (interface{}, error) {
+func (p *parser) callonExpression229() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onExpression225(stack["label"])
+	return p.cur.onExpression229(stack["label"])
 }
 
-func (c *current) onExpression143(label interface{}) (interface{}, error) {
+func (c *current) onExpression147(label interface{}) (interface{}, error) {
 	return label, nil
 }
 
-func (p *parser) callonExpression143() (interface{}, error) {
+func (p *parser) callonExpression147() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onExpression143(stack["label"])
+	return p.cur.onExpression147(stack["label"])
 }
 
-func (c *current) onExpression2(label, t, body interface{}) (interface{}, error) {
+func (c *current) onExpression6(label, t, body interface{}) (interface{}, error) {
 	return LambdaTerm{Label: label.(string), Type: t.(Term), Body: body.(Term)}, nil
 
 }
 
-func (p *parser) callonExpression2() (interface{}, error) {
+func (p *parser) callonExpression6() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onExpression2(stack["label"], stack["t"], stack["body"])
+	return p.cur.onExpression6(stack["label"], stack["t"], stack["body"])
 }
 
-func (c *current) onExpression288(cond, t, f interface{}) (interface{}, error) {
+func (c *current) onExpression292(cond, t, f interface{}) (interface{}, error) {
 	return IfTerm{cond.(Term), t.(Term), f.(Term)}, nil
 
 }
 
-func (p *parser) callonExpression288() (interface{}, error) {
+func (p *parser) callonExpression292() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onExpression288(stack["cond"], stack["t"], stack["f"])
+	return p.cur.onExpression292(stack["cond"], stack["t"], stack["f"])
 }
 
-func (c *current) onExpression304(bindings, b interface{}) (interface{}, error) {
+func (c *current) onExpression308(bindings, b interface{}) (interface{}, error) {
 	bs := make([]Binding, len(bindings.([]interface{})))
 	for i, binding := range bindings.([]interface{}) {
 		bs[i] = binding.(Binding)
@@ -16789,34 +17858,14 @@ func (c *current) onExpression304(bindings, b interface{}) (interface{}, error)
 
 }
 
-func (p *parser) callonExpression304() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression304(stack["bindings"], stack["b"])
-}
-
-func (c *current) onExpression328() (interface{}, error) {
-	return NaturalBuild, nil
-}
-
-func (p *parser) callonExpression328() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onExpression328()
-}
-
-func (c *current) onExpression330() (interface{}, error) {
-	return NaturalFold, nil
-}
-
-func (p *parser) callonExpression330() (interface{}, error) {
+func (p *parser) callonExpression308() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onExpression330()
+	return p.cur.onExpression308(stack["bindings"], stack["b"])
 }
 
 func (c *current) onExpression332() (interface{}, error) {
-	return NaturalIsZero, nil
+	return NaturalBuild, nil
 }
 
 func (p *parser) callonExpression332() (interface{}, error) {
@@ -16826,7 +17875,7 @@ func (p *parser) callonExpression332() (interface{}, error) {
 }
 
 func (c *current) onExpression334() (interface{}, error) {
-	return NaturalEven, nil
+	return NaturalFold, nil
 }
 
 func (p *parser) callonExpression334() (interface{}, error) {
@@ -16836,7 +17885,7 @@ func (p *parser) callonExpression334() (interface{}, error) {
 }
 
 func (c *current) onExpression336() (interface{}, error) {
-	return NaturalOdd, nil
+	return NaturalIsZero, nil
 }
 
 func (p *parser) callonExpression336() (interface{}, error) {
@@ -16846,7 +17895,7 @@ func (p *parser) callonExpression336() (interface{}, error) {
 }
 
 func (c *current) onExpression338() (interface{}, error) {
-	return NaturalToInteger, nil
+	return NaturalEven, nil
 }
 
 func (p *parser) callonExpression338() (interface{}, error) {
@@ -16856,7 +17905,7 @@ func (p *parser) callonExpression338() (interface{}, error) {
 }
 
 func (c *current) onExpression340() (interface{}, error) {
-	return NaturalShow, nil
+	return NaturalOdd, nil
 }
 
 func (p *parser) callonExpression340() (interface{}, error) {
@@ -16866,7 +17915,7 @@ func (p *parser) callonExpression340() (interface{}, error) {
 }
 
 func (c *current) onExpression342() (interface{}, error) {
-	return NaturalSubtract, nil
+	return NaturalToInteger, nil
 }
 
 func (p *parser) callonExpression342() (interface{}, error) {
@@ -16876,7 +17925,7 @@ func (p *parser) callonExpression342() (interface{}, error) {
 }
 
 func (c *current) onExpression344() (interface{}, error) {
-	return IntegerToDouble, nil
+	return NaturalShow, nil
 }
 
 func (p *parser) callonExpression344() (interface{}, error) {
@@ -16886,7 +17935,7 @@ func (p *parser) callonExpression344() (interface{}, error) {
 }
 
 func (c *current) onExpression346() (interface{}, error) {
-	return IntegerShow, nil
+	return NaturalSubtract, nil
 }
 
 func (p *parser) callonExpression346() (interface{}, error) {
@@ -16896,7 +17945,7 @@ func (p *parser) callonExpression346() (interface{}, error) {
 }
 
 func (c *current) onExpression348() (interface{}, error) {
-	return DoubleShow, nil
+	return IntegerToDouble, nil
 }
 
 func (p *parser) callonExpression348() (interface{}, error) {
@@ -16906,7 +17955,7 @@ func (p *parser) callonExpression348() (interface{}, error) {
 }
 
 func (c *current) onExpression350() (interface{}, error) {
-	return ListBuild, nil
+	return IntegerShow, nil
 }
 
 func (p *parser) callonExpression350() (interface{}, error) {
@@ -16916,7 +17965,7 @@ func (p *parser) callonExpression350() (interface{}, error) {
 }
 
 func (c *current) onExpression352() (interface{}, error) {
-	return ListFold, nil
+	return DoubleShow, nil
 }
 
 func (p *parser) callonExpression352() (interface{}, error) {
@@ -16926,7 +17975,7 @@ func (p *parser) callonExpression352() (interface{}, error) {
 }
 
 func (c *current) onExpression354() (interface{}, error) {
-	return ListLength, nil
+	return ListBuild, nil
 }
 
 func (p *parser) callonExpression354() (interface{}, error) {
@@ -16936,7 +17985,7 @@ func (p *parser) callonExpression354() (interface{}, error) {
 }
 
 func (c *current) onExpression356() (interface{}, error) {
-	return ListHead, nil
+	return ListFold, nil
 }
 
 func (p *parser) callonExpression356() (interface{}, error) {
@@ -16946,7 +17995,7 @@ func (p *parser) callonExpression356() (interface{}, error) {
 }
 
 func (c *current) onExpression358() (interface{}, error) {
-	return ListLast, nil
+	return ListLength, nil
 }
 
 func (p *parser) callonExpression358() (interface{}, error) {
@@ -16956,7 +18005,7 @@ func (p *parser) callonExpression358() (interface{}, error) {
 }
 
 func (c *current) onExpression360() (interface{}, error) {
-	return ListIndexed, nil
+	return ListHead, nil
 }
 
 func (p *parser) callonExpression360() (interface{}, error) {
@@ -16966,7 +18015,7 @@ func (p *parser) callonExpression360() (interface{}, error) {
 }
 
 func (c *current) onExpression362() (interface{}, error) {
-	return ListReverse, nil
+	return ListLast, nil
 }
 
 func (p *parser) callonExpression362() (interface{}, error) {
@@ -16976,7 +18025,7 @@ func (p *parser) callonExpression362() (interface{}, error) {
 }
 
 func (c *current) onExpression364() (interface{}, error) {
-	return OptionalBuild, nil
+	return ListIndexed, nil
 }
 
 func (p *parser) callonExpression364() (interface{}, error) {
@@ -16986,7 +18035,7 @@ func (p *parser) callonExpression364() (interface{}, error) {
 }
 
 func (c *current) onExpression366() (interface{}, error) {
-	return OptionalFold, nil
+	return ListReverse, nil
 }
 
 func (p *parser) callonExpression366() (interface{}, error) {
@@ -16996,7 +18045,7 @@ func (p *parser) callonExpression366() (interface{}, error) {
 }
 
 func (c *current) onExpression368() (interface{}, error) {
-	return TextShow, nil
+	return OptionalBuild, nil
 }
 
 func (p *parser) callonExpression368() (interface{}, error) {
@@ -17006,7 +18055,7 @@ func (p *parser) callonExpression368() (interface{}, error) {
 }
 
 func (c *current) onExpression370() (interface{}, error) {
-	return Bool, nil
+	return OptionalFold, nil
 }
 
 func (p *parser) callonExpression370() (interface{}, error) {
@@ -17016,7 +18065,7 @@ func (p *parser) callonExpression370() (interface{}, error) {
 }
 
 func (c *current) onExpression372() (interface{}, error) {
-	return True, nil
+	return TextShow, nil
 }
 
 func (p *parser) callonExpression372() (interface{}, error) {
@@ -17026,7 +18075,7 @@ func (p *parser) callonExpression372() (interface{}, error) {
 }
 
 func (c *current) onExpression374() (interface{}, error) {
-	return False, nil
+	return Bool, nil
 }
 
 func (p *parser) callonExpression374() (interface{}, error) {
@@ -17036,7 +18085,7 @@ func (p *parser) callonExpression374() (interface{}, error) {
 }
 
 func (c *current) onExpression376() (interface{}, error) {
-	return Optional, nil
+	return True, nil
 }
 
 func (p *parser) callonExpression376() (interface{}, error) {
@@ -17046,7 +18095,7 @@ func (p *parser) callonExpression376() (interface{}, error) {
 }
 
 func (c *current) onExpression378() (interface{}, error) {
-	return Natural, nil
+	return False, nil
 }
 
 func (p *parser) callonExpression378() (interface{}, error) {
@@ -17056,7 +18105,7 @@ func (p *parser) callonExpression378() (interface{}, error) {
 }
 
 func (c *current) onExpression380() (interface{}, error) {
-	return Integer, nil
+	return Optional, nil
 }
 
 func (p *parser) callonExpression380() (interface{}, error) {
@@ -17066,7 +18115,7 @@ func (p *parser) callonExpression380() (interface{}, error) {
 }
 
 func (c *current) onExpression382() (interface{}, error) {
-	return Double, nil
+	return Natural, nil
 }
 
 func (p *parser) callonExpression382() (interface{}, error) {
@@ -17076,7 +18125,7 @@ func (p *parser) callonExpression382() (interface{}, error) {
 }
 
 func (c *current) onExpression384() (interface{}, error) {
-	return Text, nil
+	return Integer, nil
 }
 
 func (p *parser) callonExpression384() (interface{}, error) {
@@ -17086,7 +18135,7 @@ func (p *parser) callonExpression384() (interface{}, error) {
 }
 
 func (c *current) onExpression386() (interface{}, error) {
-	return List, nil
+	return Double, nil
 }
 
 func (p *parser) callonExpression386() (interface{}, error) {
@@ -17096,7 +18145,7 @@ func (p *parser) callonExpression386() (interface{}, error) {
 }
 
 func (c *current) onExpression388() (interface{}, error) {
-	return None, nil
+	return Text, nil
 }
 
 func (p *parser) callonExpression388() (interface{}, error) {
@@ -17106,7 +18155,7 @@ func (p *parser) callonExpression388() (interface{}, error) {
 }
 
 func (c *current) onExpression390() (interface{}, error) {
-	return Type, nil
+	return List, nil
 }
 
 func (p *parser) callonExpression390() (interface{}, error) {
@@ -17116,7 +18165,7 @@ func (p *parser) callonExpression390() (interface{}, error) {
 }
 
 func (c *current) onExpression392() (interface{}, error) {
-	return Kind, nil
+	return None, nil
 }
 
 func (p *parser) callonExpression392() (interface{}, error) {
@@ -17126,7 +18175,7 @@ func (p *parser) callonExpression392() (interface{}, error) {
 }
 
 func (c *current) onExpression394() (interface{}, error) {
-	return Sort, nil
+	return Type, nil
 }
 
 func (p *parser) callonExpression394() (interface{}, error) {
@@ -17135,109 +18184,109 @@ func (p *parser) callonExpression394() (interface{}, error) {
 	return p.cur.onExpression394()
 }
 
-func (c *current) onExpression403() (interface{}, error) {
-	return string(c.text), nil
+func (c *current) onExpression396() (interface{}, error) {
+	return Kind, nil
 }
 
-func (p *parser) callonExpression403() (interface{}, error) {
+func (p *parser) callonExpression396() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onExpression403()
+	return p.cur.onExpression396()
 }
 
-func (c *current) onExpression399(label interface{}) (interface{}, error) {
-	return label, nil
+func (c *current) onExpression398() (interface{}, error) {
+	return Sort, nil
 }
 
-func (p *parser) callonExpression399() (interface{}, error) {
+func (p *parser) callonExpression398() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onExpression399(stack["label"])
+	return p.cur.onExpression398()
 }
 
-func (c *current) onExpression419() (interface{}, error) {
-	return Missing{}, nil
+func (c *current) onExpression407() (interface{}, error) {
+	return string(c.text), nil
 }
 
-func (p *parser) callonExpression419() (interface{}, error) {
+func (p *parser) callonExpression407() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onExpression419()
+	return p.cur.onExpression407()
 }
 
-func (c *current) onExpression410() (interface{}, error) {
-	return string(c.text), nil
+func (c *current) onExpression403(label interface{}) (interface{}, error) {
+	return label, nil
 }
 
-func (p *parser) callonExpression410() (interface{}, error) {
+func (p *parser) callonExpression403() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onExpression410()
+	return p.cur.onExpression403(stack["label"])
 }
 
-func (c *current) onExpression442() (interface{}, error) {
+func (c *current) onExpression423() (interface{}, error) {
 	return Missing{}, nil
 }
 
-func (p *parser) callonExpression442() (interface{}, error) {
+func (p *parser) callonExpression423() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onExpression442()
+	return p.cur.onExpression423()
 }
 
-func (c *current) onExpression432() (interface{}, error) {
+func (c *current) onExpression414() (interface{}, error) {
 	return string(c.text), nil
-
 }
 
-func (p *parser) callonExpression432() (interface{}, error) {
+func (p *parser) callonExpression414() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onExpression432()
+	return p.cur.onExpression414()
 }
 
-func (c *current) onExpression407(label interface{}) (interface{}, error) {
-	return label, nil
+func (c *current) onExpression446() (interface{}, error) {
+	return Missing{}, nil
 }
 
-func (p *parser) callonExpression407() (interface{}, error) {
+func (p *parser) callonExpression446() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onExpression407(stack["label"])
+	return p.cur.onExpression446()
 }
 
-func (c *current) onExpression323(label interface{}) (interface{}, error) {
-	return label, nil
+func (c *current) onExpression436() (interface{}, error) {
+	return string(c.text), nil
+
 }
 
-func (p *parser) callonExpression323() (interface{}, error) {
+func (p *parser) callonExpression436() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onExpression323(stack["label"])
+	return p.cur.onExpression436()
 }
 
-func (c *current) onExpression460() (interface{}, error) {
-	return NaturalBuild, nil
+func (c *current) onExpression411(label interface{}) (interface{}, error) {
+	return label, nil
 }
 
-func (p *parser) callonExpression460() (interface{}, error) {
+func (p *parser) callonExpression411() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onExpression460()
+	return p.cur.onExpression411(stack["label"])
 }
 
-func (c *current) onExpression462() (interface{}, error) {
-	return NaturalFold, nil
+func (c *current) onExpression327(label interface{}) (interface{}, error) {
+	return label, nil
 }
 
-func (p *parser) callonExpression462() (interface{}, error) {
+func (p *parser) callonExpression327() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onExpression462()
+	return p.cur.onExpression327(stack["label"])
 }
 
 func (c *current) onExpression464() (interface{}, error) {
-	return NaturalIsZero, nil
+	return NaturalBuild, nil
 }
 
 func (p *parser) callonExpression464() (interface{}, error) {
@@ -17247,7 +18296,7 @@ func (p *parser) callonExpression464() (interface{}, error) {
 }
 
 func (c *current) onExpression466() (interface{}, error) {
-	return NaturalEven, nil
+	return NaturalFold, nil
 }
 
 func (p *parser) callonExpression466() (interface{}, error) {
@@ -17257,7 +18306,7 @@ func (p *parser) callonExpression466() (interface{}, error) {
 }
 
 func (c *current) onExpression468() (interface{}, error) {
-	return NaturalOdd, nil
+	return NaturalIsZero, nil
 }
 
 func (p *parser) callonExpression468() (interface{}, error) {
@@ -17267,7 +18316,7 @@ func (p *parser) callonExpression468() (interface{}, error) {
 }
 
 func (c *current) onExpression470() (interface{}, error) {
-	return NaturalToInteger, nil
+	return NaturalEven, nil
 }
 
 func (p *parser) callonExpression470() (interface{}, error) {
@@ -17277,7 +18326,7 @@ func (p *parser) callonExpression470() (interface{}, error) {
 }
 
 func (c *current) onExpression472() (interface{}, error) {
-	return NaturalShow, nil
+	return NaturalOdd, nil
 }
 
 func (p *parser) callonExpression472() (interface{}, error) {
@@ -17287,7 +18336,7 @@ func (p *parser) callonExpression472() (interface{}, error) {
 }
 
 func (c *current) onExpression474() (interface{}, error) {
-	return NaturalSubtract, nil
+	return NaturalToInteger, nil
 }
 
 func (p *parser) callonExpression474() (interface{}, error) {
@@ -17297,7 +18346,7 @@ func (p *parser) callonExpression474() (interface{}, error) {
 }
 
 func (c *current) onExpression476() (interface{}, error) {
-	return IntegerToDouble, nil
+	return NaturalShow, nil
 }
 
 func (p *parser) callonExpression476() (interface{}, error) {
@@ -17307,7 +18356,7 @@ func (p *parser) callonExpression476() (interface{}, error) {
 }
 
 func (c *current) onExpression478() (interface{}, error) {
-	return IntegerShow, nil
+	return NaturalSubtract, nil
 }
 
 func (p *parser) callonExpression478() (interface{}, error) {
@@ -17317,7 +18366,7 @@ func (p *parser) callonExpression478() (interface{}, error) {
 }
 
 func (c *current) onExpression480() (interface{}, error) {
-	return DoubleShow, nil
+	return IntegerToDouble, nil
 }
 
 func (p *parser) callonExpression480() (interface{}, error) {
@@ -17327,7 +18376,7 @@ func (p *parser) callonExpression480() (interface{}, error) {
 }
 
 func (c *current) onExpression482() (interface{}, error) {
-	return ListBuild, nil
+	return IntegerShow, nil
 }
 
 func (p *parser) callonExpression482() (interface{}, error) {
@@ -17337,7 +18386,7 @@ func (p *parser) callonExpression482() (interface{}, error) {
 }
 
 func (c *current) onExpression484() (interface{}, error) {
-	return ListFold, nil
+	return DoubleShow, nil
 }
 
 func (p *parser) callonExpression484() (interface{}, error) {
@@ -17347,7 +18396,7 @@ func (p *parser) callonExpression484() (interface{}, error) {
 }
 
 func (c *current) onExpression486() (interface{}, error) {
-	return ListLength, nil
+	return ListBuild, nil
 }
 
 func (p *parser) callonExpression486() (interface{}, error) {
@@ -17357,7 +18406,7 @@ func (p *parser) callonExpression486() (interface{}, error) {
 }
 
 func (c *current) onExpression488() (interface{}, error) {
-	return ListHead, nil
+	return ListFold, nil
 }
 
 func (p *parser) callonExpression488() (interface{}, error) {
@@ -17367,7 +18416,7 @@ func (p *parser) callonExpression488() (interface{}, error) {
 }
 
 func (c *current) onExpression490() (interface{}, error) {
-	return ListLast, nil
+	return ListLength, nil
 }
 
 func (p *parser) callonExpression490() (interface{}, error) {
@@ -17377,7 +18426,7 @@ func (p *parser) callonExpression490() (interface{}, error) {
 }
 
 func (c *current) onExpression492() (interface{}, error) {
-	return ListIndexed, nil
+	return ListHead, nil
 }
 
 func (p *parser) callonExpression492() (interface{}, error) {
@@ -17387,7 +18436,7 @@ func (p *parser) callonExpression492() (interface{}, error) {
 }
 
 func (c *current) onExpression494() (interface{}, error) {
-	return ListReverse, nil
+	return ListLast, nil
 }
 
 func (p *parser) callonExpression494() (interface{}, error) {
@@ -17397,7 +18446,7 @@ func (p *parser) callonExpression494() (interface{}, error) {
 }
 
 func (c *current) onExpression496() (interface{}, error) {
-	return OptionalBuild, nil
+	return ListIndexed, nil
 }
 
 func (p *parser) callonExpression496() (interface{}, error) {
@@ -17407,7 +18456,7 @@ func (p *parser) callonExpression496() (interface{}, error) {
 }
 
 func (c *current) onExpression498() (interface{}, error) {
-	return OptionalFold, nil
+	return ListReverse, nil
 }
 
 func (p *parser) callonExpression498() (interface{}, error) {
@@ -17417,7 +18466,7 @@ func (p *parser) callonExpression498() (interface{}, error) {
 }
 
 func (c *current) onExpression500() (interface{}, error) {
-	return TextShow, nil
+	return OptionalBuild, nil
 }
 
 func (p *parser) callonExpression500() (interface{}, error) {
@@ -17427,7 +18476,7 @@ func (p *parser) callonExpression500() (interface{}, error) {
 }
 
 func (c *current) onExpression502() (interface{}, error) {
-	return Bool, nil
+	return OptionalFold, nil
 }
 
 func (p *parser) callonExpression502() (interface{}, error) {
@@ -17437,7 +18486,7 @@ func (p *parser) callonExpression502() (interface{}, error) {
 }
 
 func (c *current) onExpression504() (interface{}, error) {
-	return True, nil
+	return TextShow, nil
 }
 
 func (p *parser) callonExpression504() (interface{}, error) {
@@ -17447,7 +18496,7 @@ func (p *parser) callonExpression504() (interface{}, error) {
 }
 
 func (c *current) onExpression506() (interface{}, error) {
-	return False, nil
+	return Bool, nil
 }
 
 func (p *parser) callonExpression506() (interface{}, error) {
@@ -17457,7 +18506,7 @@ func (p *parser) callonExpression506() (interface{}, error) {
 }
 
 func (c *current) onExpression508() (interface{}, error) {
-	return Optional, nil
+	return True, nil
 }
 
 func (p *parser) callonExpression508() (interface{}, error) {
@@ -17467,7 +18516,7 @@ func (p *parser) callonExpression508() (interface{}, error) {
 }
 
 func (c *current) onExpression510() (interface{}, error) {
-	return Natural, nil
+	return False, nil
 }
 
 func (p *parser) callonExpression510() (interface{}, error) {
@@ -17477,7 +18526,7 @@ func (p *parser) callonExpression510() (interface{}, error) {
 }
 
 func (c *current) onExpression512() (interface{}, error) {
-	return Integer, nil
+	return Optional, nil
 }
 
 func (p *parser) callonExpression512() (interface{}, error) {
@@ -17487,7 +18536,7 @@ func (p *parser) callonExpression512() (interface{}, error) {
 }
 
 func (c *current) onExpression514() (interface{}, error) {
-	return Double, nil
+	return Natural, nil
 }
 
 func (p *parser) callonExpression514() (interface{}, error) {
@@ -17497,7 +18546,7 @@ func (p *parser) callonExpression514() (interface{}, error) {
 }
 
 func (c *current) onExpression516() (interface{}, error) {
-	return Text, nil
+	return Integer, nil
 }
 
 func (p *parser) callonExpression516() (interface{}, error) {
@@ -17507,7 +18556,7 @@ func (p *parser) callonExpression516() (interface{}, error) {
 }
 
 func (c *current) onExpression518() (interface{}, error) {
-	return List, nil
+	return Double, nil
 }
 
 func (p *parser) callonExpression518() (interface{}, error) {
@@ -17517,7 +18566,7 @@ func (p *parser) callonExpression518() (interface{}, error) {
 }
 
 func (c *current) onExpression520() (interface{}, error) {
-	return None, nil
+	return Text, nil
 }
 
 func (p *parser) callonExpression520() (interface{}, error) {
@@ -17527,7 +18576,7 @@ func (p *parser) callonExpression520() (interface{}, error) {
 }
 
 func (c *current) onExpression522() (interface{}, error) {
-	return Type, nil
+	return List, nil
 }
 
 func (p *parser) callonExpression522() (interface{}, error) {
@@ -17537,7 +18586,7 @@ func (p *parser) callonExpression522() (interface{}, error) {
 }
 
 func (c *current) onExpression524() (interface{}, error) {
-	return Kind, nil
+	return None, nil
 }
 
 func (p *parser) callonExpression524() (interface{}, error) {
@@ -17547,7 +18596,7 @@ func (p *parser) callonExpression524() (interface{}, error) {
 }
 
 func (c *current) onExpression526() (interface{}, error) {
-	return Sort, nil
+	return Type, nil
 }
 
 func (p *parser) callonExpression526() (interface{}, error) {
@@ -17556,137 +18605,167 @@ func (p *parser) callonExpression526() (interface{}, error) {
 	return p.cur.onExpression526()
 }
 
-func (c *current) onExpression534() (interface{}, error) {
+func (c *current) onExpression528() (interface{}, error) {
+	return Kind, nil
+}
+
+func (p *parser) callonExpression528() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onExpression528()
+}
+
+func (c *current) onExpression530() (interface{}, error) {
+	return Sort, nil
+}
+
+func (p *parser) callonExpression530() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onExpression530()
+}
+
+func (c *current) onExpression538() (interface{}, error) {
 	return string(c.text), nil
 }
 
-func (p *parser) callonExpression534() (interface{}, error) {
+func (p *parser) callonExpression538() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onExpression534()
+	return p.cur.onExpression538()
 }
 
-func (c *current) onExpression530(label interface{}) (interface{}, error) {
+func (c *current) onExpression534(label interface{}) (interface{}, error) {
 	return label, nil
 }
 
-func (p *parser) callonExpression530() (interface{}, error) {
+func (p *parser) callonExpression534() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onExpression530(stack["label"])
+	return p.cur.onExpression534(stack["label"])
 }
 
-func (c *current) onExpression550() (interface{}, error) {
+func (c *current) onExpression554() (interface{}, error) {
 	return Missing{}, nil
 }
 
-func (p *parser) callonExpression550() (interface{}, error) {
+func (p *parser) callonExpression554() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onExpression550()
+	return p.cur.onExpression554()
 }
 
-func (c *current) onExpression541() (interface{}, error) {
+func (c *current) onExpression545() (interface{}, error) {
 	return string(c.text), nil
 }
 
-func (p *parser) callonExpression541() (interface{}, error) {
+func (p *parser) callonExpression545() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onExpression541()
+	return p.cur.onExpression545()
 }
 
-func (c *current) onExpression573() (interface{}, error) {
+func (c *current) onExpression577() (interface{}, error) {
 	return Missing{}, nil
 }
 
-func (p *parser) callonExpression573() (interface{}, error) {
+func (p *parser) callonExpression577() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onExpression573()
+	return p.cur.onExpression577()
 }
 
-func (c *current) onExpression563() (interface{}, error) {
+func (c *current) onExpression567() (interface{}, error) {
 	return string(c.text), nil
 
 }
 
-func (p *parser) callonExpression563() (interface{}, error) {
+func (p *parser) callonExpression567() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onExpression563()
+	return p.cur.onExpression567()
 }
 
-func (c *current) onExpression538(label interface{}) (interface{}, error) {
+func (c *current) onExpression542(label interface{}) (interface{}, error) {
 	return label, nil
 }
 
-func (p *parser) callonExpression538() (interface{}, error) {
+func (p *parser) callonExpression542() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onExpression538(stack["label"])
+	return p.cur.onExpression542(stack["label"])
 }
 
-func (c *current) onExpression456(label interface{}) (interface{}, error) {
+func (c *current) onExpression460(label interface{}) (interface{}, error) {
 	return label, nil
 }
 
-func (p *parser) callonExpression456() (interface{}, error) {
+func (p *parser) callonExpression460() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onExpression456(stack["label"])
+	return p.cur.onExpression460(stack["label"])
 }
 
-func (c *current) onExpression313(label, t, body interface{}) (interface{}, error) {
+func (c *current) onExpression317(label, t, body interface{}) (interface{}, error) {
 	return PiTerm{Label: label.(string), Type: t.(Term), Body: body.(Term)}, nil
 
 }
 
-func (p *parser) callonExpression313() (interface{}, error) {
+func (p *parser) callonExpression317() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onExpression313(stack["label"], stack["t"], stack["body"])
+	return p.cur.onExpression317(stack["label"], stack["t"], stack["body"])
 }
 
-func (c *current) onExpression601(o, e interface{}) (interface{}, error) {
+func (c *current) onExpression605(o, e interface{}) (interface{}, error) {
 	return NewAnonPi(o.(Term), e.(Term)), nil
 }
 
-func (p *parser) callonExpression601() (interface{}, error) {
+func (p *parser) callonExpression605() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onExpression601(stack["o"], stack["e"])
+	return p.cur.onExpression605(stack["o"], stack["e"])
 }
 
-func (c *current) onExpression612(h, u, a interface{}) (interface{}, error) {
+func (c *current) onExpression616(h, u, a interface{}) (interface{}, error) {
 	return Merge{Handler: h.(Term), Union: u.(Term), Annotation: a.(Term)}, nil
 
 }
 
-func (p *parser) callonExpression612() (interface{}, error) {
+func (p *parser) callonExpression616() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onExpression612(stack["h"], stack["u"], stack["a"])
+	return p.cur.onExpression616(stack["h"], stack["u"], stack["a"])
 }
 
-func (c *current) onExpression627(e, t interface{}) (interface{}, error) {
+func (c *current) onExpression631(e, t interface{}) (interface{}, error) {
 	return ToMap{e.(Term), t.(Term)}, nil
 }
 
-func (p *parser) callonExpression627() (interface{}, error) {
+func (p *parser) callonExpression631() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onExpression627(stack["e"], stack["t"])
+	return p.cur.onExpression631(stack["e"], stack["t"])
 }
 
-func (c *current) onExpression638(a interface{}) (interface{}, error) {
+func (c *current) onExpression642(a interface{}) (interface{}, error) {
 	return Assert{Annotation: a.(Term)}, nil
 }
 
-func (p *parser) callonExpression638() (interface{}, error) {
+func (p *parser) callonExpression642() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onExpression642(stack["a"])
+}
+
+func (c *current) onExpression1(inner interface{}) (interface{}, error) {
+	return inner, nil
+}
+
+func (p *parser) callonExpression1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onExpression638(stack["a"])
+	return p.cur.onExpression1(stack["inner"])
 }
 
 func (c *current) onAnnotation1(a interface{}) (interface{}, error) {
@@ -18906,6 +19985,7 @@ type litMatcher struct {
 	pos        position
 	val        string
 	ignoreCase bool
+	want       string
 }
 
 type charClassMatcher struct {
@@ -19498,11 +20578,6 @@ func (p *parser) parseLabeledExpr(lab *labeledExpr) (interface{}, bool) {
 }
 
 func (p *parser) parseLitMatcher(lit *litMatcher) (interface{}, bool) {
-	ignoreCase := ""
-	if lit.ignoreCase {
-		ignoreCase = "i"
-	}
-	val := string(strconv.AppendQuote([]byte{}, lit.val)) + ignoreCase // wrap 'lit.val' with double quotes
 	start := p.pt
 	for _, want := range lit.val {
 		cur := p.pt.rn
@@ -19510,13 +20585,13 @@ func (p *parser) parseLitMatcher(lit *litMatcher) (interface{}, bool) {
 			cur = unicode.ToLower(cur)
 		}
 		if cur != want {
-			p.failAt(false, start.position, val)
+			p.failAt(false, start.position, lit.want)
 			p.restore(start)
 			return nil, false
 		}
 		p.read()
 	}
-	p.failAt(true, start.position, val)
+	p.failAt(true, start.position, lit.want)
 	return p.sliceFrom(start), true
 }
 
@@ -0,0 +1,115 @@
+package parser
+
+import "fmt"
+
+// Generous limits used by SafeMode.  Real-world Dhall files are
+// nowhere near either of these.
+const (
+	DefaultMaxNestingDepth = 500
+	DefaultMaxInputLength  = 16 * 1024 * 1024
+)
+
+const limitsKey = "github.com/philandstuff/dhall-golang/parser.limits"
+
+// limits is kept in the parser's global store so that grammar code
+// can check it.  It holds on to the parser itself because the rule
+// stack and input are not otherwise visible from grammar code.
+type limits struct {
+	p               *parser
+	maxNestingDepth int
+	maxInputLength  int
+	// expressions holds the positions in the rule stack of the
+	// Expression rules being parsed, as of the last check.
+	expressions []int
+}
+
+func limitsOf(p *parser) *limits {
+	l, ok := p.cur.globalStore[limitsKey].(*limits)
+	if !ok {
+		l = &limits{p: p}
+		p.cur.globalStore[limitsKey] = l
+	}
+	return l
+}
+
+// MaxNestingDepth creates an Option to stop parsing with an error
+// once expressions are nested more than depth levels deep.  Without
+// it, pathologically nested input can exhaust the stack.  A depth of
+// 0 means no limit.
+func MaxNestingDepth(depth int) Option {
+	return func(p *parser) Option {
+		l := limitsOf(p)
+		old := l.maxNestingDepth
+		l.maxNestingDepth = depth
+		return MaxNestingDepth(old)
+	}
+}
+
+// MaxInputLength creates an Option to refuse to parse input longer
+// than length bytes.  A length of 0 means no limit.
+func MaxInputLength(length int) Option {
+	return func(p *parser) Option {
+		l := limitsOf(p)
+		old := l.maxInputLength
+		l.maxInputLength = length
+		return MaxInputLength(old)
+	}
+}
+
+// SafeMode creates an Option which applies DefaultMaxNestingDepth and
+// DefaultMaxInputLength, for parsing untrusted input.
+func SafeMode() Option {
+	return func(p *parser) Option {
+		l := limitsOf(p)
+		oldDepth, oldLength := l.maxNestingDepth, l.maxInputLength
+		l.maxNestingDepth = DefaultMaxNestingDepth
+		l.maxInputLength = DefaultMaxInputLength
+		return func(p *parser) Option {
+			l := limitsOf(p)
+			l.maxNestingDepth, l.maxInputLength = oldDepth, oldLength
+			return SafeMode()
+		}
+	}
+}
+
+// nestingDepth returns the number of Expression rules being parsed,
+// the innermost of which is on top of the rule stack.  It keeps
+// l.expressions up to date rather than scanning the whole stack:
+// each Expression pushed since the last call was itself checked, so
+// only entries which have since been popped need discarding.
+func (l *limits) nestingDepth() int {
+	top := len(l.p.rstack) - 1
+	marks := l.expressions
+	for len(marks) > 0 {
+		i := marks[len(marks)-1]
+		if i < top && l.p.rstack[i].name == "Expression" {
+			break
+		}
+		marks = marks[:len(marks)-1]
+	}
+	l.expressions = append(marks, top)
+	return len(l.expressions)
+}
+
+// checkInputLength is called once, before anything is parsed.
+func (c *current) checkInputLength() (bool, error) {
+	l, ok := c.globalStore[limitsKey].(*limits)
+	if ok && l.maxInputLength > 0 && len(l.p.data) > l.maxInputLength {
+		return false, fmt.Errorf("input length %d exceeds maximum of %d bytes", len(l.p.data), l.maxInputLength)
+	}
+	return true, nil
+}
+
+// checkLimits is called on entry to every Expression.  It panics
+// rather than returning an error so that parsing stops immediately
+// instead of backtracking into the same failure again.
+func (c *current) checkLimits() (bool, error) {
+	l, ok := c.globalStore[limitsKey].(*limits)
+	if !ok || l.maxNestingDepth <= 0 {
+		return true, nil
+	}
+	if l.nestingDepth() > l.maxNestingDepth {
+		panic(fmt.Errorf("expression nesting exceeds maximum depth of %d", l.maxNestingDepth))
+	}
+	return true, nil
+}
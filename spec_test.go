@@ -5,7 +5,6 @@ import (
 	"encoding/hex"
 	"io/ioutil"
 	"os"
-	"os/exec"
 	"path/filepath"
 	"reflect"
 	"regexp"
@@ -16,7 +15,6 @@ import (
 	"github.com/philandstuff/dhall-golang/core"
 	"github.com/philandstuff/dhall-golang/imports"
 	"github.com/philandstuff/dhall-golang/parser"
-	"github.com/pkg/errors"
 )
 
 var slowTests = []string{
@@ -39,9 +37,14 @@ var expectedFailures = []string{
 	"TestNormalization/simple/integerToDoubleA.dhall",
 	"TestSemanticHash/simple/integerToDouble",
 
-	// needs quoted paths in URLs
-	"TestParserAccepts/unit/import/quotedPaths",     // needs.. quoted paths
-	"TestParserAccepts/unit/import/urls/quotedPath", // needs quotedPaths
+	// Quoted path segments now parse: a quoted local segment is kept
+	// as written, and a quoted URL segment is percent-encoded, so
+	// https://example.com/"a b" has the path component "a%20b".
+	// These two haven't been run against the dhall-lang fixtures
+	// since, as they weren't checked out.  pass() fails them if
+	// they now succeed; remove them then.
+	"TestParserAccepts/unit/import/quotedPaths",
+	"TestParserAccepts/unit/import/urls/quotedPath",
 
 	// urgh IEEE floating point; NaN != NaN :(
 	"TestTypeInference/unit/AssertNaNA",
@@ -113,35 +116,14 @@ func expectEqualTerms(t *testing.T, expected, actual core.Term) {
 	}
 }
 
-func cbor2Pretty(cbor []byte) (string, error) {
-	// cbor2pretty.rb comes from the cbor-diag gem
-	cmd := exec.Command("cbor2pretty.rb")
-	stdin, err := cmd.StdinPipe()
-	if err != nil {
-		return "", err
-	}
-	go func() {
-		defer stdin.Close()
-		stdin.Write(cbor)
-	}()
-	out, err := cmd.Output()
-	if err != nil {
-		if exitError, ok := err.(*exec.ExitError); ok {
-			return "", errors.Wrap(err, "error calling cbor2pretty.rb. stderr was: "+string(exitError.Stderr))
-		}
-		return "", errors.Wrap(err, "error calling cbor2pretty.rb")
-	}
-	return string(out), nil
-}
-
 func expectEqualCbor(t *testing.T, expected, actual []byte) {
 	t.Helper()
 	if !reflect.DeepEqual(expected, actual) {
-		actualPretty, err := cbor2Pretty(actual)
+		actualPretty, err := binary.CborDiag(actual)
 		if err != nil {
 			failf(t, "Couldn't decode actual CBOR: %v", err)
 		}
-		expectedPretty, err := cbor2Pretty(expected)
+		expectedPretty, err := binary.CborDiag(expected)
 		if err != nil {
 			failf(t, "Couldn't decode expected CBOR: %v", err)
 		}
@@ -253,6 +235,15 @@ func BenchmarkParserLargeExpression(b *testing.B) {
 	}
 }
 
+func BenchmarkParserLargeExpressionSafeMode(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		_, err := parser.ParseFile("dhall-lang/tests/parser/success/largeExpressionA.dhall", parser.SafeMode())
+		if err != nil {
+			b.Fatalf("Parse error: %v", err)
+		}
+	}
+}
+
 func isSimpleTest(testName string) bool {
 	return strings.Contains(testName, "/unit/") ||
 		strings.Contains(testName, "/simple/")
@@ -277,13 +268,31 @@ func TestTypeInference(t *testing.T) {
 				expectNoError(t, err)
 			}
 
-			inferredType, err := core.TypeOf(resolvedA)
+			inferredType, err := core.TypeOfTerm(resolvedA)
 			expectNoError(t, err)
 
-			expectEqualTerms(t, parsedB.(core.Term), core.Quote(inferredType))
+			expectEqualTerms(t, parsedB.(core.Term), inferredType)
 		})
 }
 
+func BenchmarkTypeOfPrelude(b *testing.B) {
+	const preludePath = "dhall-lang/tests/type-inference/success/preludeA.dhall"
+	parsed, err := parser.ParseFile(preludePath)
+	if err != nil {
+		b.Fatalf("Parse error: %v", err)
+	}
+	resolved, err := imports.LoadWith(imports.NoCache{}, parsed.(core.Term), core.Local(preludePath))
+	if err != nil {
+		b.Fatalf("Import resolve error: %v", err)
+	}
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		if _, err := core.TypeOf(resolved); err != nil {
+			b.Fatalf("Type error: %v", err)
+		}
+	}
+}
+
 func TestTypeInferenceFails(t *testing.T) {
 	t.Parallel()
 	runTestOnEachFile(t, "dhall-lang/tests/type-inference/failure/", func(t *testing.T, testPath string) {
@@ -0,0 +1,97 @@
+package dhall
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/philandstuff/dhall-golang/core"
+)
+
+// A Union is how ToGo represents a value of a union type: the name
+// of its alternative, and its payload, which is nil for an empty
+// alternative.
+type Union struct {
+	Tag   string
+	Value interface{}
+}
+
+// ToGo converts a normalized Dhall value to Go values, without a
+// schema.  It is the dynamic counterpart to Decode, for consumers
+// which don't know the shape of their input in advance:
+//
+//   - Naturals, Integers, Doubles, Bools and Text become uint, int,
+//     float64, bool and string, as when decoding into an interface{}
+//   - records become map[string]interface{}
+//   - lists become []interface{}
+//   - `Some x` becomes a pointer to the conversion of x, and `None T`
+//     becomes nil
+//   - union values become a Union
+//
+// It returns an error for values with no Go equivalent, such as
+// functions and types.
+func ToGo(v core.Value) (interface{}, error) {
+	if some, ok := core.MatchOptional(v); ok {
+		if some == nil {
+			return nil, nil
+		}
+		contents, err := ToGo(some)
+		if err != nil {
+			return nil, err
+		}
+		if contents == nil {
+			// Some (None T) has no concrete type to point to
+			return new(interface{}), nil
+		}
+		ptr := reflect.New(reflect.TypeOf(contents))
+		ptr.Elem().Set(reflect.ValueOf(contents))
+		return ptr.Interface(), nil
+	}
+	switch v := v.(type) {
+	case core.NaturalLit:
+		return uint(v), nil
+	case core.IntegerLit:
+		return int(v), nil
+	case core.DoubleLit:
+		return float64(v), nil
+	case core.BoolLit:
+		return bool(v), nil
+	case core.TextLitVal:
+		if len(v.Chunks) != 0 {
+			break
+		}
+		return v.Suffix, nil
+	case core.EmptyListVal:
+		return []interface{}{}, nil
+	case core.NonEmptyListVal:
+		list := make([]interface{}, len(v))
+		for i, item := range v {
+			converted, err := ToGo(item)
+			if err != nil {
+				return nil, err
+			}
+			list[i] = converted
+		}
+		return list, nil
+	case core.RecordLitVal:
+		record := make(map[string]interface{}, len(v))
+		for k, field := range v {
+			converted, err := ToGo(field)
+			if err != nil {
+				return nil, err
+			}
+			record[k] = converted
+		}
+		return record, nil
+	}
+	if alt, payload, ok := core.MatchUnion(v); ok {
+		if payload == nil {
+			return Union{Tag: alt}, nil
+		}
+		converted, err := ToGo(payload)
+		if err != nil {
+			return nil, err
+		}
+		return Union{Tag: alt, Value: converted}, nil
+	}
+	return nil, fmt.Errorf("can't convert %v to Go", core.Quote(v))
+}
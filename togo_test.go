@@ -0,0 +1,64 @@
+package dhall_test
+
+import (
+	. "github.com/philandstuff/dhall-golang"
+	"github.com/philandstuff/dhall-golang/core"
+	"github.com/philandstuff/dhall-golang/parser"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func evalSource(source string) core.Value {
+	term, err := parser.Parse("-", []byte(source))
+	Expect(err).ToNot(HaveOccurred())
+	_, err = core.TypeOf(term.(core.Term))
+	Expect(err).ToNot(HaveOccurred())
+	return core.Eval(term.(core.Term))
+}
+
+var _ = Describe("ToGo", func() {
+	It("converts a nested record with an optional and a union", func() {
+		actual, err := ToGo(evalSource(`
+let Protocol = < TCP : Natural | UDP >
+in  { name = "db"
+    , ratio = 0.5
+    , offset = -3
+    , enabled = True
+    , owner = Some "alice"
+    , group = None Text
+    , ports = [ Protocol.TCP 5432, Protocol.UDP ]
+    , limits = { cpu = 2, tags = [] : List Text }
+    }`))
+		Expect(err).ToNot(HaveOccurred())
+
+		owner := "alice"
+		Expect(actual).To(Equal(map[string]interface{}{
+			"name":    "db",
+			"ratio":   0.5,
+			"offset":  -3,
+			"enabled": true,
+			"owner":   &owner,
+			"group":   nil,
+			"ports": []interface{}{
+				Union{Tag: "TCP", Value: uint(5432)},
+				Union{Tag: "UDP"},
+			},
+			"limits": map[string]interface{}{
+				"cpu":  uint(2),
+				"tags": []interface{}{},
+			},
+		}))
+	})
+	It("converts Some of a record to a pointer to a map", func() {
+		actual, err := ToGo(evalSource(`Some { a = 1 }`))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(Equal(&map[string]interface{}{"a": uint(1)}))
+	})
+	It("rejects values with no Go equivalent", func() {
+		_, err := ToGo(evalSource(`λ(x : Natural) → x`))
+		Expect(err).To(HaveOccurred())
+		_, err = ToGo(core.Natural)
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,142 @@
+package dhall
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/philandstuff/dhall-golang/core"
+)
+
+// ToJSONOptions configures how a Dhall value is rendered as JSON.  The
+// zero value behaves the same as ToJSON.
+type ToJSONOptions struct {
+	// NaturalAsString, if set, renders Naturals and Integers as
+	// JSON strings, such as "9007199254740993", instead of numbers.
+	// JavaScript reads every JSON number as a double, so it can't
+	// represent integers beyond 2^53 exactly.
+	NaturalAsString bool
+}
+
+// ToJSON renders a normalized Dhall value as JSON, in the same way as
+// dhall-to-json:
+//
+//   - Optional values are rendered as their contents, or null
+//   - union values are rendered as their payload, or as the name of
+//     the alternative if it has none
+//   - lists of `{ mapKey : Text, mapValue : T }` records are
+//     rendered as JSON objects
+//
+// It returns an error for values with no JSON equivalent, such as
+// functions, types, and Doubles which are NaN or infinite.
+func ToJSON(e core.Value) ([]byte, error) {
+	return ToJSONOptions{}.ToJSON(e)
+}
+
+// ToJSON renders a normalized Dhall value as JSON, according to the
+// options in o.
+func (o ToJSONOptions) ToJSON(e core.Value) ([]byte, error) {
+	j, err := o.toJSON(e)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(j)
+}
+
+// toJSON converts e to a tree of values which encoding/json marshals
+// as the JSON rendering of e.  Numbers are kept as json.Numbers so
+// that they aren't rounded through float64.
+func (o ToJSONOptions) toJSON(e core.Value) (interface{}, error) {
+	if some, ok := core.MatchOptional(e); ok {
+		if some == nil {
+			return nil, nil
+		}
+		return o.toJSON(some)
+	}
+	switch e := e.(type) {
+	case core.BoolLit:
+		return bool(e), nil
+	case core.NaturalLit:
+		return o.integer(strconv.FormatUint(uint64(e), 10)), nil
+	case core.IntegerLit:
+		return o.integer(strconv.FormatInt(int64(e), 10)), nil
+	case core.DoubleLit:
+		if math.IsNaN(float64(e)) || math.IsInf(float64(e), 0) {
+			return nil, fmt.Errorf("can't render %s as JSON", e)
+		}
+		return json.Number(e.String()), nil
+	case core.TextLitVal:
+		if len(e.Chunks) != 0 {
+			break
+		}
+		return e.Suffix, nil
+	case core.EmptyListVal:
+		if list, ok := e.Type.(core.AppValue); ok {
+			if entry, ok := list.Arg.(core.RecordTypeVal); ok && isMapEntryType(entry) {
+				return map[string]interface{}{}, nil
+			}
+		}
+		return []interface{}{}, nil
+	case core.NonEmptyListVal:
+		if obj, ok, err := o.mapToJSON(e); ok || err != nil {
+			return obj, err
+		}
+		arr := make([]interface{}, len(e))
+		for i, item := range e {
+			j, err := o.toJSON(item)
+			if err != nil {
+				return nil, err
+			}
+			arr[i] = j
+		}
+		return arr, nil
+	case core.RecordLitVal:
+		obj := make(map[string]interface{}, len(e))
+		for k, v := range e {
+			j, err := o.toJSON(v)
+			if err != nil {
+				return nil, err
+			}
+			obj[k] = j
+		}
+		return obj, nil
+	}
+	if alt, payload, ok := core.MatchUnion(e); ok {
+		if payload == nil {
+			return alt, nil
+		}
+		return o.toJSON(payload)
+	}
+	return nil, fmt.Errorf("can't render %v as JSON", core.Quote(e))
+}
+
+// integer renders a Natural or Integer, given in decimal.
+func (o ToJSONOptions) integer(decimal string) interface{} {
+	if o.NaturalAsString {
+		return decimal
+	}
+	return json.Number(decimal)
+}
+
+// mapToJSON renders a list of mapKey/mapValue records as a JSON
+// object.  ok is false if l is some other kind of list.
+func (o ToJSONOptions) mapToJSON(l core.NonEmptyListVal) (obj map[string]interface{}, ok bool, err error) {
+	obj = make(map[string]interface{}, len(l))
+	for _, item := range l {
+		entry, isRecord := item.(core.RecordLitVal)
+		if !isRecord || len(entry) != 2 || entry["mapValue"] == nil {
+			return nil, false, nil
+		}
+		key, isText := entry["mapKey"].(core.TextLitVal)
+		if !isText || len(key.Chunks) != 0 {
+			return nil, false, nil
+		}
+		j, err := o.toJSON(entry["mapValue"])
+		if err != nil {
+			return nil, true, err
+		}
+		obj[key.Suffix] = j
+	}
+	return obj, true, nil
+}
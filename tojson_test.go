@@ -0,0 +1,63 @@
+package dhall_test
+
+import (
+	"math"
+
+	. "github.com/philandstuff/dhall-golang"
+	"github.com/philandstuff/dhall-golang/core"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+func renderAndCompare(opts ToJSONOptions, input core.Value, expected string) {
+	actual, err := opts.ToJSON(input)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(string(actual)).To(Equal(expected))
+}
+
+var _ = DescribeTable("ToJSON", renderAndCompare,
+	Entry("Bool", ToJSONOptions{}, core.True, `true`),
+	Entry("Natural", ToJSONOptions{}, core.NaturalLit(9007199254740993), `9007199254740993`),
+	Entry("Integer", ToJSONOptions{}, core.IntegerLit(-3), `-3`),
+	Entry("Double", ToJSONOptions{}, core.DoubleLit(1.5), `1.5`),
+	Entry("Text", ToJSONOptions{}, core.TextLitVal{Suffix: "a\"b"}, `"a\"b"`),
+	Entry("Some", ToJSONOptions{}, core.SomeVal{Val: core.NaturalLit(1)}, `1`),
+	Entry("None", ToJSONOptions{}, core.AppValue{Fn: core.None, Arg: core.Natural}, `null`),
+	Entry("empty List", ToJSONOptions{}, core.EmptyListVal{Type: core.AppValue{Fn: core.List, Arg: core.Natural}}, `[]`),
+	Entry("List", ToJSONOptions{}, core.NonEmptyListVal{core.NaturalLit(1), core.NaturalLit(2)}, `[1,2]`),
+	Entry("record", ToJSONOptions{},
+		core.RecordLitVal{"b": core.NaturalLit(1), "a": core.TextLitVal{Suffix: "x"}},
+		`{"a":"x","b":1}`),
+	Entry("map", ToJSONOptions{},
+		core.NonEmptyListVal{
+			core.RecordLitVal{"mapKey": core.TextLitVal{Suffix: "foo"}, "mapValue": core.NaturalLit(1)},
+		},
+		`{"foo":1}`),
+	Entry("empty map", ToJSONOptions{},
+		core.EmptyListVal{Type: core.AppValue{Fn: core.List, Arg: core.RecordTypeVal{"mapKey": core.Text, "mapValue": core.Natural}}},
+		`{}`),
+	Entry("union alternative with a payload", ToJSONOptions{},
+		core.Eval(core.Apply(core.Field{Record: core.UnionType{"A": core.Natural, "B": nil}, FieldName: "A"}, core.NaturalLit(1))),
+		`1`),
+	Entry("empty union alternative", ToJSONOptions{},
+		core.Eval(core.Field{Record: core.UnionType{"A": core.Natural, "B": nil}, FieldName: "B"}),
+		`"B"`),
+
+	Entry("Natural with NaturalAsString", ToJSONOptions{NaturalAsString: true},
+		core.NaturalLit(9007199254740993), `"9007199254740993"`),
+	Entry("Integer with NaturalAsString", ToJSONOptions{NaturalAsString: true},
+		core.IntegerLit(-9007199254740993), `"-9007199254740993"`),
+	Entry("Double with NaturalAsString", ToJSONOptions{NaturalAsString: true},
+		core.DoubleLit(2), `2.0`),
+)
+
+var _ = Describe("ToJSON", func() {
+	It("rejects values with no JSON equivalent", func() {
+		_, err := ToJSON(core.Natural)
+		Expect(err).To(HaveOccurred())
+		_, err = ToJSON(core.DoubleLit(math.Inf(1)))
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -2,11 +2,16 @@ package dhall
 
 import (
 	"errors"
+	"fmt"
+	"math/big"
 	"reflect"
 	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/philandstuff/dhall-golang/core"
+	"github.com/philandstuff/dhall-golang/imports"
 	"github.com/philandstuff/dhall-golang/parser"
 )
 
@@ -20,28 +25,244 @@ func isMapEntryType(recordType core.RecordTypeVal) bool {
 }
 
 // Unmarshal takes dhall input as a byte array and parses it,
-// evaluates it, and unmarshals it into the given variable.
+// evaluates it, and unmarshals it into the given variable.  It
+// doesn't resolve imports; use UnmarshalOptions.Loader for that.
 func Unmarshal(b []byte, out interface{}) error {
+	return UnmarshalOptions{}.Unmarshal(b, out)
+}
+
+// UnmarshalOptions configures how dhall input is unmarshalled.  The
+// zero value behaves the same as Unmarshal.
+type UnmarshalOptions struct {
+	// Defaults, if set, is a schema record of the form
+	// `{ Type = ..., default = ... }`, as used by the `::` record
+	// completion operator.  The input is completed against it
+	// before decoding, so fields missing from the input record
+	// take their values from the schema's `default`.  The
+	// completed input is typechecked.
+	Defaults core.Term
+
+	// Loader, if set, resolves imports in the input before it is
+	// evaluated, with the Loader's cache.  For example,
+	// &imports.Loader{Cache: imports.StandardCache{}} fetches
+	// imports and caches them in the user's cache directory.
+	// Without a Loader, input containing imports fails to
+	// unmarshal.
+	Loader *imports.Loader
+
+	// Hooks, if set, are called to report on the progress of
+	// unmarshalling.
+	Hooks *Hooks
+
+	// CaseSensitive, if set, makes struct fields only match record
+	// fields of exactly the same name.  Otherwise a struct field
+	// with no exact match falls back to the record field whose name
+	// matches case-insensitively, as in encoding/json, so that
+	// `timeout` fills Timeout.
+	CaseSensitive bool
+
+	// OnUnknownAlternative says what to do when decoding a union
+	// into a sum struct which has no field for the union's
+	// alternative.
+	OnUnknownAlternative UnknownAlternativeMode
+
+	// NameFunc, if set, maps the name of each struct field without
+	// a name in its `dhall` tag to the record field name it is
+	// decoded from, so that a whole struct can follow a naming
+	// convention such as kebab-case without tagging every field.
+	NameFunc func(goName string) string
+
+	// MaxFoldSteps, if positive, limits the fold steps taken while
+	// typechecking the input, if it is, and again while evaluating
+	// it, as for core.EvalOptions.  Input which needs more fails with a
+	// *core.FoldLimitError.
+	MaxFoldSteps int
+
+	// MergeDefaultHandlers enables the non-standard default handler
+	// `_` in merge expressions in the input, as for
+	// core.EvalOptions.
+	MergeDefaultHandlers bool
+}
+
+// An UnknownAlternativeMode says how to decode a union alternative
+// which has no field in the sum struct it is decoded into, such as
+// one added to a config schema after the Go code was written.
+type UnknownAlternativeMode int
+
+const (
+	// UnknownAlternativeError fails to decode the value, returning
+	// an error.  This is the default.
+	UnknownAlternativeError UnknownAlternativeMode = iota
+	// UnknownAlternativeSkip leaves the sum struct zero, and reports
+	// the alternative to Hooks.Warning.
+	UnknownAlternativeSkip
+	// UnknownAlternativeFallback sets Tag to the name of the
+	// alternative and stores its payload, undecoded, in the sum
+	// struct's Unknown field, which must be a core.Value.
+	UnknownAlternativeFallback
+)
+
+// Hooks are callbacks for instrumenting Unmarshal, for example to
+// find slow remote imports.  Any of them may be nil.
+type Hooks struct {
+	// Phase is called at the end of each phase of processing, with
+	// its name ("parse", "imports", "typecheck" or "normalize") and
+	// how long it took.  There is no "imports" phase without
+	// UnmarshalOptions.Loader, and no "typecheck" phase unless the
+	// input is typechecked: by UnmarshalTyped, or to complete it
+	// against UnmarshalOptions.Defaults.
+	Phase func(phase string, elapsed time.Duration)
+	// Fetch is called after each import is fetched, with its
+	// location and how long fetching it took.
+	Fetch func(location string, elapsed time.Duration)
+	// Warning is called when decoding carries on past a value it
+	// can't represent, such as an unknown union alternative skipped
+	// under UnknownAlternativeSkip.
+	Warning func(message string)
+}
+
+func (h *Hooks) phase(name string, start time.Time) {
+	if h != nil && h.Phase != nil {
+		h.Phase(name, time.Since(start))
+	}
+}
+
+func (h *Hooks) warn(message string) {
+	if h != nil && h.Warning != nil {
+		h.Warning(message)
+	}
+}
+
+// onFetch returns a callback for imports.Loader.OnFetch which calls
+// next, if not nil, and then h.Fetch.
+func (h *Hooks) onFetch(next func(core.Fetchable, time.Duration)) func(core.Fetchable, time.Duration) {
+	if h == nil || h.Fetch == nil {
+		return next
+	}
+	return func(f core.Fetchable, elapsed time.Duration) {
+		if next != nil {
+			next(f, elapsed)
+		}
+		h.Fetch(f.String(), elapsed)
+	}
+}
+
+// Unmarshal takes dhall input as a byte array and parses it,
+// resolves imports if o.Loader is set, evaluates it, and unmarshals
+// it into the given variable, according to the options in o.
+func (o UnmarshalOptions) Unmarshal(b []byte, out interface{}) error {
+	_, err := o.unmarshal(b, out, o.Defaults != nil)
+	return err
+}
+
+// UnmarshalTyped is like Unmarshal, but also returns the type of the
+// dhall input, so that callers can check it against the schema they
+// expect.
+func UnmarshalTyped(b []byte, out interface{}) (core.Term, error) {
+	return UnmarshalOptions{}.UnmarshalTyped(b, out)
+}
+
+// UnmarshalTyped is like Unmarshal, but also returns the type of the
+// dhall input, so that callers can check it against the schema they
+// expect.
+func (o UnmarshalOptions) UnmarshalTyped(b []byte, out interface{}) (core.Term, error) {
+	return o.unmarshal(b, out, true)
+}
+
+// unmarshal is Unmarshal, also typechecking the input if typecheck is
+// set, in which case it returns the input's type.
+func (o UnmarshalOptions) unmarshal(b []byte, out interface{}, typecheck bool) (core.Term, error) {
+	start := time.Now()
 	parsed, err := parser.Parse("-", b)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	term, ok := parsed.(core.Term)
 	if !ok {
 		// shouldn't happen
-		return errors.New("Internal error: parsed non-term")
+		return nil, errors.New("Internal error: parsed non-term")
 	}
-	Decode(core.Eval(term), out)
-	return nil
+	if o.Defaults != nil {
+		term = core.OpTerm{OpCode: core.CompleteOp, L: o.Defaults, R: term}
+	}
+	o.Hooks.phase("parse", start)
+
+	if o.Loader != nil {
+		start = time.Now()
+		loader := *o.Loader
+		loader.OnFetch = o.Hooks.onFetch(loader.OnFetch)
+		if loader.MaxFoldSteps == 0 {
+			loader.MaxFoldSteps = o.MaxFoldSteps
+		}
+		loader.MergeDefaultHandlers = loader.MergeDefaultHandlers || o.MergeDefaultHandlers
+		term, err = loader.Load(term)
+		if err != nil {
+			return nil, err
+		}
+		o.Hooks.phase("imports", start)
+	}
+
+	evalOptions := core.EvalOptions{
+		MaxFoldSteps:         o.MaxFoldSteps,
+		MergeDefaultHandlers: o.MergeDefaultHandlers,
+	}
+	var typ core.Value
+	if typecheck {
+		start = time.Now()
+		typ, err = evalOptions.TypeOf(term)
+		if err != nil {
+			return nil, err
+		}
+		o.Hooks.phase("typecheck", start)
+	}
+
+	start = time.Now()
+	value, err := evalOptions.TryEval(term)
+	if err != nil {
+		return nil, err
+	}
+	o.Hooks.phase("normalize", start)
+
+	if err := o.Decode(value, out); err != nil {
+		return nil, err
+	}
+	if typ == nil {
+		return nil, nil
+	}
+	return core.Quote(typ), nil
 }
 
 // Decode takes a core.Value and unmarshals it into the given
 // variable.
-func Decode(e core.Value, out interface{}) {
+func Decode(e core.Value, out interface{}) error {
+	return UnmarshalOptions{}.Decode(e, out)
+}
+
+// Decode takes a core.Value and unmarshals it into the given
+// variable, according to the options in o.  It returns an error for
+// input which is valid Dhall but can't be decoded into out, such as
+// a record with two fields which both match a struct field
+// case-insensitively, or a union alternative which the sum struct
+// has no field for.
+func (o UnmarshalOptions) Decode(e core.Value, out interface{}) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if r, ok := r.(decodeError); ok {
+				err = r.err
+				return
+			}
+			panic(r)
+		}
+	}()
 	v := reflect.ValueOf(out)
-	decode(e, v.Elem())
+	o.decode(e, v.Elem())
+	return nil
 }
 
+// decodeError is panicked with by decode when the input can't be
+// decoded, to be returned as an error by Decode.
+type decodeError struct{ err error }
+
 func reflectValToDhallVal(val reflect.Value, typ core.Value) core.Value {
 	switch e := typ.(type) {
 	case core.Builtin:
@@ -88,7 +309,7 @@ func argNType(fn core.LambdaValue, n int) core.Value {
 	return argNType(fn.Call(core.Var{}).(core.LambdaValue), n-1)
 }
 
-func dhallShim(out reflect.Type, dhallFunc core.LambdaValue) func([]reflect.Value) []reflect.Value {
+func (o UnmarshalOptions) dhallShim(out reflect.Type, dhallFunc core.LambdaValue) func([]reflect.Value) []reflect.Value {
 	return func(args []reflect.Value) []reflect.Value {
 		var expr core.Value = dhallFunc
 		for i, arg := range args {
@@ -96,7 +317,10 @@ func dhallShim(out reflect.Type, dhallFunc core.LambdaValue) func([]reflect.Valu
 			expr = expr.(core.Callable).Call(dhallArg)
 		}
 		ptr := reflect.New(out)
-		decode(expr, ptr.Elem())
+		if err := o.Decode(expr, ptr.Interface()); err != nil {
+			// a Go function has no way to return it
+			panic(err)
+		}
 		return []reflect.Value{ptr.Elem()}
 	}
 }
@@ -108,13 +332,11 @@ func dhallShim(out reflect.Type, dhallFunc core.LambdaValue) func([]reflect.Valu
 // note that there may be options buried deeper in e; we just strip any outer
 // Optional layers.
 func flattenOptional(e core.Value) core.Value {
-	if some, ok := e.(core.SomeVal); ok {
-		return flattenOptional(some.Val)
-	}
-	if app, ok := e.(core.AppValue); ok {
-		if app.Fn == core.None {
+	if some, ok := core.MatchOptional(e); ok {
+		if some == nil {
 			return nil
 		}
+		return flattenOptional(some)
 	}
 	return e
 }
@@ -164,11 +386,218 @@ func dhallTypeToReflectType(e core.Value) reflect.Type {
 	panic("unknown type")
 }
 
-func decode(e core.Value, v reflect.Value) {
+var bigIntType = reflect.TypeOf(big.Int{})
+var coreValueType = reflect.TypeOf((*core.Value)(nil)).Elem()
+
+// decodeBigInt converts a NaturalLit or IntegerLit exactly, so that
+// large values don't overflow the way they can with sized int types.
+func decodeBigInt(e core.Value, out *big.Int) {
+	switch e := e.(type) {
+	case core.NaturalLit:
+		out.SetUint64(uint64(e))
+	case core.IntegerLit:
+		out.SetInt64(int64(e))
+	default:
+		panic("can only unmarshal Natural or Integer into big.Int")
+	}
+}
+
+// parseTag returns the record field name for a struct field, and
+// whether it has the number option.  The tag has the form
+// `dhall:"name,number"`; an empty name means the struct field's own
+// name, passed through o.NameFunc if it is set.
+func (o UnmarshalOptions) parseTag(field reflect.StructField) (name string, number bool) {
+	opts := strings.Split(field.Tag.Get("dhall"), ",")
+	name = opts[0]
+	if name == "" {
+		name = field.Name
+		if o.NameFunc != nil {
+			name = o.NameFunc(name)
+		}
+	}
+	for _, opt := range opts[1:] {
+		if opt == "number" {
+			number = true
+		}
+	}
+	return name, number
+}
+
+// decodeNumber renders a Double, Natural or Integer exactly into a
+// string, such as a json.Number, for struct fields with the number
+// option.  Doubles are rendered as by Double/show, Naturals and
+// Integers in decimal without a leading `+`.
+func decodeNumber(e core.Value, v reflect.Value) {
 	e = flattenOptional(e)
 	if e == nil {
 		return
 	}
+	if v.Kind() != reflect.String {
+		panic("can only unmarshal a number option into a string")
+	}
+	switch e := e.(type) {
+	case core.DoubleLit:
+		v.SetString(e.String())
+	case core.NaturalLit:
+		v.SetString(strconv.FormatUint(uint64(e), 10))
+	case core.IntegerLit:
+		v.SetString(strconv.FormatInt(int64(e), 10))
+	default:
+		panic("can only unmarshal Double, Natural or Integer with the number option")
+	}
+}
+
+// decodeSumStruct decodes a union value into a "sum struct": a struct
+// with a string field named Tag, which is set to the name of the
+// alternative, and a pointer field for each alternative with a
+// payload, of which only the active one is set.  Alternatives with a
+// payload but no field are handled according to
+// o.OnUnknownAlternative.
+func (o UnmarshalOptions) decodeSumStruct(alt string, payload core.Value, v reflect.Value) {
+	tag := v.FieldByName("Tag")
+	if !tag.IsValid() || tag.Kind() != reflect.String {
+		panic("can only unmarshal a union into a struct with a Tag string field")
+	}
+	v.Set(reflect.Zero(v.Type()))
+	if payload == nil {
+		tag.SetString(alt)
+		return
+	}
+	field := v.FieldByName(alt)
+	if !field.IsValid() || field.Kind() != reflect.Ptr {
+		switch o.OnUnknownAlternative {
+		case UnknownAlternativeSkip:
+			o.Hooks.warn("skipped unknown union alternative " + alt)
+		case UnknownAlternativeFallback:
+			unknown := v.FieldByName("Unknown")
+			if !unknown.IsValid() || unknown.Type() != coreValueType {
+				panic(decodeError{errors.New("can only fall back to an Unknown core.Value field for union alternative " + alt)})
+			}
+			tag.SetString(alt)
+			unknown.Set(reflect.ValueOf(&payload).Elem())
+		default:
+			panic(decodeError{errors.New("no pointer field for union alternative " + alt)})
+		}
+		return
+	}
+	tag.SetString(alt)
+	ptr := reflect.New(field.Type().Elem())
+	o.decode(payload, ptr.Elem())
+	field.Set(ptr)
+}
+
+// recordField returns the field of record which fills the struct
+// field called name, or nil if there is none.
+func (o UnmarshalOptions) recordField(record core.RecordLitVal, name string) core.Value {
+	if field, ok := record[name]; ok || o.CaseSensitive {
+		return field
+	}
+	var match string
+	for k := range record {
+		if strings.EqualFold(k, name) {
+			if match != "" {
+				first, second := match, k
+				if first > second {
+					first, second = second, first
+				}
+				panic(decodeError{fmt.Errorf("ambiguous fields %s and %s for struct field %s", first, second, name)})
+			}
+			match = k
+		}
+	}
+	if match == "" {
+		return nil
+	}
+	return record[match]
+}
+
+// pairFields returns the names of the key and value fields of a map
+// entry: a record `{ mapKey, mapValue }`, as built by toMap, or
+// `{ key, value }`.  ok is false for any other value.
+func pairFields(e core.Value) (names [2]string, ok bool) {
+	record, ok := e.(core.RecordLitVal)
+	if !ok || len(record) != 2 {
+		return names, false
+	}
+	for _, names := range [][2]string{{"mapKey", "mapValue"}, {"key", "value"}} {
+		if record[names[0]] != nil && record[names[1]] != nil {
+			return names, true
+		}
+	}
+	return names, false
+}
+
+// isPairList reports whether l is a list of map entries to be decoded
+// into elements of type t with decodePair.  This applies when the
+// entries are `{ key, value }` or `{ mapKey, mapValue }` records and
+// t is a struct with two exported fields; it keeps the entries in
+// list order, which a Go map would lose.
+func isPairList(l core.NonEmptyListVal, t reflect.Type) bool {
+	if t.Kind() != reflect.Struct || t.NumField() != 2 {
+		return false
+	}
+	if _, ok := pairFields(l[0]); !ok {
+		return false
+	}
+	return t.Field(0).PkgPath == "" && t.Field(1).PkgPath == ""
+}
+
+// decodePair decodes a map entry into the two fields of the struct v.
+// A struct field named after one of the entry's fields is filled from
+// it, and the other struct field from the remaining one; if neither
+// is, the first field is filled from the key and the second from the
+// value.
+func (o UnmarshalOptions) decodePair(e core.Value, v reflect.Value) {
+	names, ok := pairFields(e)
+	if !ok {
+		panic("can only unmarshal a list of map entries into a slice of key-value structs")
+	}
+	record := e.(core.RecordLitVal)
+	var fields [2]core.Value
+	var numbers [2]bool
+	matched := -1
+	for i := 0; i < 2; i++ {
+		var name string
+		name, numbers[i] = o.parseTag(v.Type().Field(i))
+		for j, field := range names {
+			if name == field || !o.CaseSensitive && strings.EqualFold(name, field) {
+				fields[i] = record[field]
+				matched = j
+			}
+		}
+	}
+	switch {
+	case fields[0] == nil && fields[1] == nil:
+		fields = [2]core.Value{record[names[0]], record[names[1]]}
+	case fields[0] == nil:
+		fields[0] = record[names[1-matched]]
+	case fields[1] == nil:
+		fields[1] = record[names[1-matched]]
+	}
+	for i, field := range fields {
+		if numbers[i] {
+			decodeNumber(field, v.Field(i))
+			continue
+		}
+		o.decode(field, v.Field(i))
+	}
+}
+
+func (o UnmarshalOptions) decode(e core.Value, v reflect.Value) {
+	e = flattenOptional(e)
+	if e == nil {
+		return
+	}
+	switch {
+	case v.Type() == bigIntType:
+		decodeBigInt(e, v.Addr().Interface().(*big.Int))
+		return
+	case v.Kind() == reflect.Ptr && v.Type().Elem() == bigIntType:
+		i := new(big.Int)
+		decodeBigInt(e, i)
+		v.Set(reflect.ValueOf(i))
+		return
+	}
 	switch v.Kind() {
 	case reflect.Interface:
 		switch e := e.(type) {
@@ -199,7 +628,7 @@ func decode(e core.Value, v reflect.Value) {
 		case core.NonEmptyListVal:
 			slice := reflect.MakeSlice(v.Type(), len(e), len(e))
 			for i, expr := range e {
-				decode(expr, slice.Index(i))
+				o.decode(expr, slice.Index(i))
 			}
 			v.Set(slice)
 		}
@@ -218,22 +647,31 @@ func decode(e core.Value, v reflect.Value) {
 			entry := r.(core.RecordLitVal)
 			key := reflect.New(v.Type().Key()).Elem()
 			val := reflect.New(v.Type().Elem()).Elem()
-			decode(entry["mapKey"], key)
-			decode(entry["mapValue"], val)
+			o.decode(entry["mapKey"], key)
+			o.decode(entry["mapValue"], val)
 			v.SetMapIndex(key, val)
 		}
 	case reflect.Struct:
+		if alt, payload, ok := core.MatchUnion(e); ok {
+			o.decodeSumStruct(alt, payload, v)
+			return
+		}
 		e := e.(core.RecordLitVal)
 		structType := v.Type()
 		for i := 0; i < structType.NumField(); i++ {
 			// FIXME ignores fields in RecordLit not in Struct
-			decode(e[structType.Field(i).Name], v.Field(i))
+			name, number := o.parseTag(structType.Field(i))
+			if number {
+				decodeNumber(o.recordField(e, name), v.Field(i))
+				continue
+			}
+			o.decode(o.recordField(e, name), v.Field(i))
 		}
 	case reflect.Func:
 		e := e.(core.LambdaValue)
 		fnType := v.Type()
 		returnType := fnType.Out(0)
-		fn := reflect.MakeFunc(fnType, dhallShim(returnType, e))
+		fn := reflect.MakeFunc(fnType, o.dhallShim(returnType, e))
 		v.Set(fn)
 	case reflect.Slice:
 		if _, ok := e.(core.EmptyListVal); ok {
@@ -242,8 +680,13 @@ func decode(e core.Value, v reflect.Value) {
 		}
 		e := e.(core.NonEmptyListVal)
 		slice := reflect.MakeSlice(v.Type(), len(e), len(e))
+		pairs := isPairList(e, v.Type().Elem())
 		for i, expr := range e {
-			decode(expr, slice.Index(i))
+			if pairs {
+				o.decodePair(expr, slice.Index(i))
+				continue
+			}
+			o.decode(expr, slice.Index(i))
 		}
 		v.Set(slice)
 	default:
@@ -253,7 +696,12 @@ func decode(e core.Value, v reflect.Value) {
 		case core.BoolLit:
 			v.SetBool(bool(e))
 		case core.NaturalLit:
-			v.SetInt(int64(e))
+			switch v.Kind() {
+			case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+				v.SetUint(uint64(e))
+			default:
+				v.SetInt(int64(e))
+			}
 		case core.IntegerLit:
 			v.SetInt(int64(e))
 		case core.TextLitVal:
@@ -1,10 +1,18 @@
 package dhall_test
 
 import (
+	"encoding/json"
+	"math"
+	"math/big"
+	"os"
 	"reflect"
+	"strings"
+	"time"
+	"unicode"
 
 	. "github.com/philandstuff/dhall-golang"
 	"github.com/philandstuff/dhall-golang/core"
+	"github.com/philandstuff/dhall-golang/imports"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
@@ -35,6 +43,8 @@ var _ = Describe("Decode", func() {
 			core.NaturalLit(5), new(int), 5),
 		Entry("unmarshals NaturalLit into int64",
 			core.NaturalLit(5), new(int64), int64(5)),
+		Entry("unmarshals NaturalLit into uint",
+			core.NaturalLit(5), new(uint), uint(5)),
 		Entry("unmarshals IntegerLit into int",
 			core.IntegerLit(5), new(int), 5),
 		Entry("unmarshals IntegerLit into int",
@@ -249,3 +259,478 @@ var _ = Describe("Decode", func() {
 		})
 	})
 })
+
+var _ = Describe("UnmarshalOptions", func() {
+	Describe("Defaults", func() {
+		// { Type = { Foo : Natural, Bar : Text }, default = { Bar = "default" } }
+		schema := core.RecordLit{
+			"Type": core.RecordType{"Foo": core.Natural, "Bar": core.Text},
+			"default": core.RecordLit{
+				"Bar": core.TextLitTerm{Suffix: "default"},
+			},
+		}
+		It("fills missing fields from the schema default", func() {
+			var actual testStruct
+			err := UnmarshalOptions{Defaults: schema}.
+				Unmarshal([]byte(`{ Foo = 1 }`), &actual)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(actual).To(Equal(testStruct{Foo: 1, Bar: "default"}))
+		})
+		It("prefers fields from the input", func() {
+			var actual testStruct
+			err := UnmarshalOptions{Defaults: schema}.
+				Unmarshal([]byte(`{ Foo = 1, Bar = "input" }`), &actual)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(actual).To(Equal(testStruct{Foo: 1, Bar: "input"}))
+		})
+		It("fails if a field without a default is missing", func() {
+			var actual testStruct
+			err := UnmarshalOptions{Defaults: schema}.
+				Unmarshal([]byte(`{ Bar = "input" }`), &actual)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+	Describe("MaxFoldSteps", func() {
+		opts := UnmarshalOptions{MaxFoldSteps: 100}
+		It("fails on a large fold while typechecking", func() {
+			var actual uint
+			err := opts.Unmarshal([]byte(
+				`let _ = assert : Natural/fold 1000000000 Natural (λ(x : Natural) → x + 1) 0 ≡ 0 in 1`),
+				&actual)
+			Expect(err).To(BeAssignableToTypeOf(&core.FoldLimitError{}))
+		})
+		It("fails on a large fold while evaluating", func() {
+			var actual uint
+			err := opts.Unmarshal([]byte(
+				`Natural/fold 1000000000 Natural (λ(x : Natural) → x + 1) 0`), &actual)
+			Expect(err).To(BeAssignableToTypeOf(&core.FoldLimitError{}))
+		})
+		It("decodes a fold within the limit", func() {
+			var actual uint
+			err := opts.Unmarshal([]byte(
+				`Natural/fold 100 Natural (λ(x : Natural) → x + 1) 0`), &actual)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(actual).To(Equal(uint(100)))
+		})
+	})
+	Describe("MergeDefaultHandlers", func() {
+		input := []byte(`merge { A = λ(n : Natural) → n, _ = 0 } < A : Natural | B >.B`)
+		It("decodes a merge with a default handler", func() {
+			var actual uint
+			err := UnmarshalOptions{MergeDefaultHandlers: true}.Unmarshal(input, &actual)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(actual).To(Equal(uint(0)))
+		})
+		It("rejects it by default when typechecking", func() {
+			var actual uint
+			_, err := UnmarshalTyped(input, &actual)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
+
+type bigIntStruct struct {
+	Natural big.Int
+	Integer *big.Int
+}
+
+var _ = Describe("Decoding into big.Int", func() {
+	It("decodes the largest Natural exactly", func() {
+		var actual big.Int
+		Decode(core.NaturalLit(math.MaxUint64), &actual)
+		expected, _ := new(big.Int).SetString("18446744073709551615", 10)
+		Expect(actual.Cmp(expected)).To(Equal(0))
+	})
+	It("decodes a negative Integer into *big.Int", func() {
+		var actual *big.Int
+		Decode(core.IntegerLit(math.MinInt64), &actual)
+		Expect(actual.Cmp(big.NewInt(math.MinInt64))).To(Equal(0))
+	})
+	It("unmarshals big.Int struct fields", func() {
+		var actual bigIntStruct
+		err := Unmarshal([]byte(`{ Natural = 9223372036854775807, Integer = -9223372036854775807 }`), &actual)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual.Natural.String()).To(Equal("9223372036854775807"))
+		Expect(actual.Integer.String()).To(Equal("-9223372036854775807"))
+	})
+})
+
+var _ = Describe("Decoding numbers into strings", func() {
+	type measurement struct {
+		Value json.Number `dhall:",number"`
+		Count string      `dhall:"count,number"`
+		Delta string      `dhall:",number"`
+	}
+	It("renders a Double as Double/show does", func() {
+		var actual measurement
+		err := Unmarshal([]byte(`{ Value = 0.1, count = 3, Delta = Some -2 }`), &actual)
+		Expect(err).ToNot(HaveOccurred())
+		shown := core.Eval(core.Apply(core.DoubleShow, core.DoubleLit(0.1))).(core.TextLitVal)
+		Expect(string(actual.Value)).To(Equal(shown.Suffix))
+		Expect(actual.Value).To(Equal(json.Number("0.1")))
+		Expect(actual.Count).To(Equal("3"))
+		Expect(actual.Delta).To(Equal("-2"))
+	})
+	It("renders a large Double as a valid number", func() {
+		var actual measurement
+		Decode(core.RecordLitVal{"Value": core.DoubleLit(1e100), "count": core.NaturalLit(0)}, &actual)
+		Expect(actual.Value).To(Equal(json.Number("1e+100")))
+	})
+	It("panics on a non-number", func() {
+		var actual measurement
+		Expect(func() {
+			Decode(core.RecordLitVal{"Value": core.TextLitVal{Suffix: "x"}}, &actual)
+		}).To(Panic())
+	})
+})
+
+type sumStruct struct {
+	Tag string
+	A   *uint
+	B   *string
+}
+
+var _ = Describe("Decoding unions into sum structs", func() {
+	union := core.UnionType{"A": core.Natural, "B": core.Text, "C": nil}
+	three := uint(3)
+	text := "text"
+	DescribeTable("alternatives", func(alternative core.Term, expected sumStruct) {
+		var actual sumStruct
+		Decode(core.Eval(alternative), &actual)
+		Expect(actual).To(Equal(expected))
+	},
+		Entry("A", core.Apply(core.Field{union, "A"}, core.NaturalLit(3)),
+			sumStruct{Tag: "A", A: &three}),
+		Entry("B", core.Apply(core.Field{union, "B"}, core.TextLitTerm{Suffix: "text"}),
+			sumStruct{Tag: "B", B: &text}),
+		Entry("empty alternative C", core.Field{union, "C"},
+			sumStruct{Tag: "C"}),
+	)
+	It("unmarshals a union from Dhall source", func() {
+		var actual sumStruct
+		err := Unmarshal([]byte(`< A : Natural | B : Text >.B "hello"`), &actual)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual.Tag).To(Equal("B"))
+		Expect(actual.A).To(BeNil())
+		Expect(*actual.B).To(Equal("hello"))
+	})
+	It("panics without a Tag field", func() {
+		var actual testStruct
+		Expect(func() {
+			Decode(core.Eval(core.Field{union, "C"}), &actual)
+		}).To(Panic())
+	})
+})
+
+var _ = Describe("Matching record fields to struct fields", func() {
+	type config struct {
+		Timeout int
+		Name    string
+	}
+	It("matches exact names", func() {
+		var actual config
+		Decode(core.RecordLitVal{
+			"Timeout": core.NaturalLit(5),
+			"Name":    core.TextLitVal{Suffix: "x"},
+		}, &actual)
+		Expect(actual).To(Equal(config{Timeout: 5, Name: "x"}))
+	})
+	It("falls back to a case-insensitive match", func() {
+		var actual config
+		err := Unmarshal([]byte(`{ timeout = 5, NAME = "x" }`), &actual)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(Equal(config{Timeout: 5, Name: "x"}))
+	})
+	It("prefers an exact match to a case-insensitive one", func() {
+		var actual config
+		Decode(core.RecordLitVal{
+			"Timeout": core.NaturalLit(5),
+			"timeout": core.NaturalLit(6),
+		}, &actual)
+		Expect(actual.Timeout).To(Equal(5))
+	})
+	It("fails on ambiguous case-insensitive matches", func() {
+		var actual config
+		err := Decode(core.RecordLitVal{
+			"timeout": core.NaturalLit(5),
+			"TIMEOUT": core.NaturalLit(6),
+		}, &actual)
+		Expect(err).To(MatchError("ambiguous fields TIMEOUT and timeout for struct field Timeout"))
+	})
+	It("fails to unmarshal ambiguous case-insensitive matches", func() {
+		var actual config
+		_, err := UnmarshalTyped([]byte(`{ timeout = 5, TIMEOUT = 6 }`), &actual)
+		Expect(err).To(MatchError("ambiguous fields TIMEOUT and timeout for struct field Timeout"))
+	})
+	It("only matches exact names when CaseSensitive is set", func() {
+		var actual config
+		err := UnmarshalOptions{CaseSensitive: true}.Unmarshal(
+			[]byte(`{ timeout = 5, Name = "x" }`), &actual)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(Equal(config{Name: "x"}))
+	})
+})
+
+var _ = Describe("Decoding lists of map entries into slices", func() {
+	type entry struct {
+		Key   string
+		Value uint
+	}
+	type service struct {
+		Name string
+		Port uint
+	}
+	type services []service
+	fiveServices := services{
+		{"web", 80}, {"db", 5432}, {"cache", 6379}, {"api", 8080}, {"admin", 9000},
+	}
+
+	It("fills key/value structs in list order", func() {
+		var actual []entry
+		err := Unmarshal([]byte(`[ { key = "web", value = 80 }, { key = "db", value = 5432 }, { key = "cache", value = 6379 }, { key = "api", value = 8080 }, { key = "admin", value = 9000 } ]`), &actual)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(Equal([]entry{
+			{"web", 80}, {"db", 5432}, {"cache", 6379}, {"api", 8080}, {"admin", 9000},
+		}))
+	})
+	It("fills other two-field structs by position", func() {
+		var actual services
+		err := Unmarshal([]byte(`[ { key = "web", value = 80 }, { key = "db", value = 5432 }, { key = "cache", value = 6379 }, { key = "api", value = 8080 }, { key = "admin", value = 9000 } ]`), &actual)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(Equal(fiveServices))
+	})
+	It("accepts mapKey/mapValue entries", func() {
+		var actual services
+		err := Unmarshal([]byte(`[ { mapKey = "web", mapValue = 80 }, { mapKey = "db", mapValue = 5432 }, { mapKey = "cache", mapValue = 6379 }, { mapKey = "api", mapValue = 8080 }, { mapKey = "admin", mapValue = 9000 } ]`), &actual)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(Equal(fiveServices))
+	})
+	It("fills the doc.go Header struct in list order", func() {
+		type Header struct {
+			Name  string
+			Value string
+		}
+		var actual []Header
+		err := Unmarshal([]byte(`[ { key = "a", value = "1" }, { key = "b", value = "2" }, { key = "c", value = "3" }, { key = "d", value = "4" }, { key = "e", value = "5" } ]`), &actual)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(Equal([]Header{
+			{"a", "1"}, {"b", "2"}, {"c", "3"}, {"d", "4"}, {"e", "5"},
+		}))
+	})
+	It("fills the unnamed field from the other entry field", func() {
+		var actual []struct {
+			Value uint
+			Name  string
+		}
+		err := Unmarshal([]byte(`[ { key = "web", value = 80 } ]`), &actual)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual[0].Name).To(Equal("web"))
+		Expect(actual[0].Value).To(Equal(uint(80)))
+	})
+	It("matches struct fields by name when it can", func() {
+		var actual []struct {
+			Value uint
+			Key   string
+		}
+		err := Unmarshal([]byte(`[ { key = "web", value = 80 } ]`), &actual)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual[0].Key).To(Equal("web"))
+		Expect(actual[0].Value).To(Equal(uint(80)))
+	})
+})
+
+// kebabCase turns a Go name such as MaxIdleConns into max-idle-conns
+func kebabCase(goName string) string {
+	var b strings.Builder
+	for i, r := range goName {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('-')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+var _ = Describe("NameFunc", func() {
+	type pool struct {
+		MaxIdleConns uint
+		DialTimeout  uint
+		HostName     string `dhall:"host"`
+	}
+	opts := UnmarshalOptions{NameFunc: kebabCase, CaseSensitive: true}
+
+	It("maps untagged struct fields to record fields", func() {
+		var actual pool
+		err := opts.Unmarshal(
+			[]byte(`{ max-idle-conns = 3, dial-timeout = 30, host = "db" }`), &actual)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(Equal(pool{MaxIdleConns: 3, DialTimeout: 30, HostName: "db"}))
+	})
+	It("is overridden by tags", func() {
+		var actual pool
+		opts.Decode(core.RecordLitVal{
+			"host-name": core.TextLitVal{Suffix: "wrong"},
+			"host":      core.TextLitVal{Suffix: "db"},
+		}, &actual)
+		Expect(actual.HostName).To(Equal("db"))
+	})
+	It("applies to nested structs", func() {
+		var actual struct{ ConnPool pool }
+		opts.Decode(core.RecordLitVal{
+			"conn-pool": core.RecordLitVal{"max-idle-conns": core.NaturalLit(3)},
+		}, &actual)
+		Expect(actual.ConnPool.MaxIdleConns).To(Equal(uint(3)))
+	})
+})
+
+var _ = Describe("OnUnknownAlternative", func() {
+	type fallbackStruct struct {
+		Tag     string
+		A       *uint
+		Unknown core.Value
+	}
+	// D is not known to sumStruct or fallbackStruct
+	unexpected := core.Eval(core.Apply(
+		core.Field{core.UnionType{"A": core.Natural, "D": core.Bool}, "D"},
+		core.True))
+
+	It("fails by default", func() {
+		var actual sumStruct
+		Expect(Decode(unexpected, &actual)).To(MatchError("no pointer field for union alternative D"))
+	})
+	It("fails in error mode", func() {
+		var actual sumStruct
+		err := UnmarshalOptions{OnUnknownAlternative: UnknownAlternativeError}.Decode(unexpected, &actual)
+		Expect(err).To(MatchError("no pointer field for union alternative D"))
+	})
+	It("fails to unmarshal an unknown alternative", func() {
+		var actual sumStruct
+		err := Unmarshal([]byte(`< A : Natural | D : Bool >.D True`), &actual)
+		Expect(err).To(MatchError("no pointer field for union alternative D"))
+	})
+	It("leaves the value zero and warns in skip mode", func() {
+		var warnings []string
+		actual := sumStruct{Tag: "C"}
+		UnmarshalOptions{
+			OnUnknownAlternative: UnknownAlternativeSkip,
+			Hooks:                &Hooks{Warning: func(message string) { warnings = append(warnings, message) }},
+		}.Decode(unexpected, &actual)
+		Expect(actual).To(Equal(sumStruct{}))
+		Expect(warnings).To(Equal([]string{"skipped unknown union alternative D"}))
+	})
+	It("stores the payload in Unknown in fallback mode", func() {
+		var actual fallbackStruct
+		UnmarshalOptions{OnUnknownAlternative: UnknownAlternativeFallback}.Decode(unexpected, &actual)
+		Expect(actual).To(Equal(fallbackStruct{Tag: "D", Unknown: core.True}))
+	})
+	It("still decodes known alternatives in fallback mode", func() {
+		var actual fallbackStruct
+		three := uint(3)
+		UnmarshalOptions{OnUnknownAlternative: UnknownAlternativeFallback}.Decode(
+			core.Eval(core.Apply(core.Field{core.UnionType{"A": core.Natural}, "A"}, core.NaturalLit(3))),
+			&actual)
+		Expect(actual).To(Equal(fallbackStruct{Tag: "A", A: &three}))
+	})
+	It("fails in fallback mode without an Unknown field", func() {
+		var actual sumStruct
+		err := UnmarshalOptions{OnUnknownAlternative: UnknownAlternativeFallback}.Decode(unexpected, &actual)
+		Expect(err).To(MatchError("can only fall back to an Unknown core.Value field for union alternative D"))
+	})
+})
+
+var _ = Describe("UnmarshalTyped", func() {
+	It("returns the type of the decoded input", func() {
+		var actual testStruct
+		typ, err := UnmarshalTyped([]byte(`{ Foo = +1, Bar = "x" }`), &actual)
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(Equal(testStruct{Foo: 1, Bar: "x"}))
+		Expect(typ).To(Equal(core.RecordType{"Foo": core.Integer, "Bar": core.Text}))
+	})
+	It("returns type errors without decoding", func() {
+		actual := testStruct{Foo: 3}
+		typ, err := UnmarshalTyped([]byte(`{ Foo = 1 + True }`), &actual)
+
+		Expect(err).To(HaveOccurred())
+		Expect(typ).To(BeNil())
+		Expect(actual).To(Equal(testStruct{Foo: 3}))
+	})
+})
+
+var _ = Describe("Hooks", func() {
+	var restoreEnv func()
+	BeforeEach(func() {
+		old, ok := os.LookupEnv("DHALL_GOLANG_HOOKS_TEST")
+		os.Setenv("DHALL_GOLANG_HOOKS_TEST", "{ Foo = 1, Bar = \"bar\" }")
+		restoreEnv = func() {
+			if ok {
+				os.Setenv("DHALL_GOLANG_HOOKS_TEST", old)
+			} else {
+				os.Unsetenv("DHALL_GOLANG_HOOKS_TEST")
+			}
+		}
+	})
+	AfterEach(func() { restoreEnv() })
+
+	It("reports each phase and fetched import", func() {
+		var phases, fetched, loaderFetched []string
+		hooks := &Hooks{
+			Phase: func(phase string, elapsed time.Duration) {
+				Expect(elapsed).To(BeNumerically(">=", 0))
+				phases = append(phases, phase)
+			},
+			Fetch: func(location string, elapsed time.Duration) {
+				Expect(elapsed).To(BeNumerically(">=", 0))
+				fetched = append(fetched, location)
+			},
+		}
+		loader := &imports.Loader{
+			OnFetch: func(f core.Fetchable, elapsed time.Duration) {
+				loaderFetched = append(loaderFetched, f.String())
+			},
+		}
+		var actual testStruct
+
+		err := UnmarshalOptions{Hooks: hooks, Loader: loader}.
+			Unmarshal([]byte(`env:DHALL_GOLANG_HOOKS_TEST`), &actual)
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(Equal(testStruct{Foo: 1, Bar: "bar"}))
+		Expect(phases).To(Equal([]string{"parse", "imports", "normalize"}))
+		Expect(fetched).To(Equal([]string{"env:DHALL_GOLANG_HOOKS_TEST"}))
+		Expect(loaderFetched).To(Equal(fetched))
+	})
+	It("reports typechecking only when the input is typechecked", func() {
+		var phases []string
+		hooks := &Hooks{
+			Phase: func(phase string, elapsed time.Duration) {
+				phases = append(phases, phase)
+			},
+		}
+		var actual testStruct
+
+		_, err := UnmarshalOptions{Hooks: hooks}.
+			UnmarshalTyped([]byte(`{ Foo = 1, Bar = "bar" }`), &actual)
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(phases).To(Equal([]string{"parse", "typecheck", "normalize"}))
+	})
+	It("doesn't resolve imports without a Loader", func() {
+		var phases []string
+		hooks := &Hooks{
+			Phase: func(phase string, elapsed time.Duration) {
+				phases = append(phases, phase)
+			},
+		}
+		var actual testStruct
+
+		err := UnmarshalOptions{Hooks: hooks}.
+			Unmarshal([]byte(`env:DHALL_GOLANG_HOOKS_TEST`), &actual)
+
+		Expect(err).To(MatchError("unresolved import env:DHALL_GOLANG_HOOKS_TEST"))
+		Expect(phases).To(Equal([]string{"parse"}))
+	})
+})